@@ -0,0 +1,114 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package applycmd
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/l1spec"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/spf13/cobra"
+)
+
+// avalanche apply
+func NewApplyCmd(injectedApp *application.Avalanche) *cobra.Command {
+	app = injectedApp
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Take an L1 to the state described on an L1 spec file",
+		Long: `The apply command takes an L1 to the state described on an L1 spec file: its VM and
+genesis, validator manager, validator set, ICM and relayer setup.
+
+The spec is compared against the blockchain configuration and the on-chain state,
+and only the missing steps are run, as the equivalent blockchain create, blockchain
+deploy, contract initValidatorManager, interchain messenger deploy, interchain
+relayer deploy and blockchain addValidator commands. Applying the same spec again
+is a no-op. Use 'avalanche plan -f' to see the steps without running them.
+
+On public networks, the spec validators present at deploy time become the L1
+bootstrap validators. Once their nodes track the L1, run apply again to initialize
+the validator manager and continue.`,
+		RunE: apply,
+		Args: cobrautils.ExactArgs(0),
+	}
+	addSpecFlag(cmd)
+	return cmd
+}
+
+func apply(cmd *cobra.Command, _ []string) error {
+	spec, err := l1spec.LoadSpec(specPath)
+	if err != nil {
+		return err
+	}
+	applied := 0
+	var lastStep *l1spec.Step
+	for {
+		state, err := getState(spec)
+		if err != nil {
+			return err
+		}
+		steps, err := l1spec.Plan(spec, state)
+		if err != nil {
+			return err
+		}
+		if len(steps) == 0 {
+			ux.Logger.PrintToUser("")
+			ux.Logger.GreenCheckmarkToUser("%s is up to date with %s (%d steps applied)", spec.Name, specPath, applied)
+			return nil
+		}
+		step := steps[0]
+		// a step that succeeds but is still needed would make apply loop forever
+		if lastStep != nil && reflect.DeepEqual(*lastStep, step) {
+			return fmt.Errorf("step %q did not take effect", step.Description)
+		}
+		ux.Logger.PrintToUser("")
+		ux.Logger.PrintToUser(logging.Blue.Wrap("==> %s"), step.Description)
+		ux.Logger.PrintToUser("    %s", step.CommandLine())
+		if err := runStep(cmd.Root(), step); err != nil {
+			return fmt.Errorf("step %q failed: %w", step.Description, err)
+		}
+		applied++
+		lastStep = &step
+		if step.Kind == l1spec.StepDeploy && spec.Network != l1spec.NetworkLocal {
+			ux.Logger.PrintToUser("")
+			ux.Logger.PrintToUser("Once the bootstrap validators track %s, run 'avalanche apply -f %s' again to continue", spec.Name, specPath)
+			return nil
+		}
+	}
+}
+
+// runs the CLI command of [step], generating its files on a temporary dir
+func runStep(root *cobra.Command, step l1spec.Step) error {
+	cmd, remaining, err := root.Find(step.Command)
+	if err != nil {
+		return err
+	}
+	if len(remaining) != 0 || cmd.Name() != step.Command[len(step.Command)-1] {
+		return fmt.Errorf("unknown command %q", strings.Join(step.Command, " "))
+	}
+	flagValues := maps.Clone(step.Flags)
+	if len(step.Files) > 0 {
+		filesDir, err := os.MkdirTemp("", "avalanche-apply")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(filesDir)
+		for flagName, fileBytes := range step.Files {
+			filePath := filepath.Join(filesDir, flagName)
+			if err := os.WriteFile(filePath, fileBytes, constants.WriteReadReadPerms); err != nil {
+				return err
+			}
+			flagValues[flagName] = filePath
+		}
+	}
+	return cobrautils.RunCommand(cmd, step.Args, flagValues)
+}
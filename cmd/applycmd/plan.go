@@ -0,0 +1,53 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package applycmd
+
+import (
+	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/l1spec"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/spf13/cobra"
+)
+
+// avalanche plan
+func NewPlanCmd(injectedApp *application.Avalanche) *cobra.Command {
+	app = injectedApp
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Show the steps needed to apply an L1 spec file",
+		Long: `The plan command compares an L1 spec file against the blockchain configuration and
+the on-chain state, and shows the steps 'avalanche apply -f' would run, together
+with their equivalent commands, without running them.`,
+		RunE: plan,
+		Args: cobrautils.ExactArgs(0),
+	}
+	addSpecFlag(cmd)
+	return cmd
+}
+
+func plan(_ *cobra.Command, _ []string) error {
+	spec, err := l1spec.LoadSpec(specPath)
+	if err != nil {
+		return err
+	}
+	state, err := getState(spec)
+	if err != nil {
+		return err
+	}
+	steps, err := l1spec.Plan(spec, state)
+	if err != nil {
+		return err
+	}
+	if len(steps) == 0 {
+		ux.Logger.GreenCheckmarkToUser("%s is up to date with %s", spec.Name, specPath)
+		return nil
+	}
+	ux.Logger.PrintToUser("Applying %s requires %d steps:", specPath, len(steps))
+	for i, step := range steps {
+		ux.Logger.PrintToUser("")
+		ux.Logger.PrintToUser("%d. %s", i+1, step.Description)
+		ux.Logger.PrintToUser("   %s", step.CommandLine())
+	}
+	return nil
+}
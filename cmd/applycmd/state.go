@@ -0,0 +1,92 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package applycmd
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/interchain/relayer"
+	"github.com/ava-labs/avalanche-cli/pkg/l1spec"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	validatorsdk "github.com/ava-labs/avalanche-cli/sdk/validator"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var (
+	app      *application.Avalanche
+	specPath string
+)
+
+func addSpecFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&specPath, "file", "f", "", "L1 spec file")
+	_ = cmd.MarkFlagRequired("file")
+}
+
+// getState observes the configuration and on-chain state of the [spec] L1
+func getState(spec l1spec.Spec) (l1spec.State, error) {
+	state := l1spec.State{}
+	if !app.SidecarExists(spec.Name) {
+		return state, nil
+	}
+	sc, err := app.LoadSidecar(spec.Name)
+	if err != nil {
+		return state, err
+	}
+	state.Sidecar = &sc
+	network := spec.NetworkModel()
+	networkData, ok := sc.Networks[network.Name()]
+	if !ok || networkData.BlockchainID == ids.Empty {
+		return state, nil
+	}
+	state.ValidatorManagerInitialized = validatorManagerInitialized(network, networkData)
+	if spec.Relayer.Enabled {
+		state.RelayerRunning, _, _, err = relayer.RelayerIsUp(app.GetLocalRelayerRunPath(network.Kind))
+		if err != nil {
+			return state, err
+		}
+	}
+	for _, validator := range spec.Validators {
+		nodeID, err := ids.NodeIDFromString(validator.NodeID)
+		if err != nil {
+			return state, err
+		}
+		isValidator, err := validatorsdk.IsValidator(network.SDKNetwork(), networkData.SubnetID, nodeID)
+		if err != nil {
+			return state, fmt.Errorf("failed to get validators of %s: %w", spec.Name, err)
+		}
+		if isValidator {
+			state.Validators = append(state.Validators, nodeID)
+		}
+	}
+	return state, nil
+}
+
+// the validator manager is considered initialized once its bootstrap validators are
+// registered on it. Unreachable L1 RPCs are taken as not initialized
+func validatorManagerInitialized(network models.Network, networkData models.NetworkData) bool {
+	if networkData.ValidatorManagerAddress == "" || len(networkData.BootstrapValidators) == 0 {
+		return false
+	}
+	nodeID, err := ids.NodeIDFromString(networkData.BootstrapValidators[0].NodeID)
+	if err != nil {
+		return false
+	}
+	rpcURL := network.BlockchainEndpoint(networkData.BlockchainID.String())
+	if len(networkData.RPCEndpoints) > 0 {
+		rpcURL = networkData.RPCEndpoints[0]
+	}
+	validationID, err := validatorsdk.GetValidationID(
+		rpcURL,
+		common.HexToAddress(networkData.ValidatorManagerAddress),
+		nodeID,
+	)
+	if err != nil {
+		app.Log.Debug("failed to get bootstrap validator registration", zap.String("rpc", rpcURL), zap.Error(err))
+		return false
+	}
+	return validationID != ids.Empty
+}
@@ -429,6 +429,9 @@ func CallAddValidator(
 	} else {
 		ux.Logger.PrintToUser(logging.Yellow.Wrap("Validator Manager Protocol: v1.0.0"))
 	}
+	if pos && sc.StakingTokenAddress != "" {
+		ux.Logger.PrintToUser(logging.Yellow.Wrap("Staking Token (ERC20): %s"), sc.StakingTokenAddress)
+	}
 
 	ux.Logger.PrintToUser(logging.Yellow.Wrap("Validation manager owner %s pays for the initialization of the validator's registration (Blockchain gas token)"), validatorManagerOwner)
 
@@ -539,6 +542,7 @@ func CallAddValidator(
 		extraAggregatorPeers,
 		aggregatorLogger,
 		pos,
+		sc.StakingTokenAddress,
 		delegationFee,
 		duration,
 		validatorManagerAddress,
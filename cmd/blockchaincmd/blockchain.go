@@ -3,7 +3,10 @@
 package blockchaincmd
 
 import (
+	"github.com/ava-labs/avalanche-cli/cmd/blockchaincmd/templatecmd"
 	"github.com/ava-labs/avalanche-cli/cmd/blockchaincmd/upgradecmd"
+	"github.com/ava-labs/avalanche-cli/cmd/blockchaincmd/validatormanagercmd"
+	"github.com/ava-labs/avalanche-cli/cmd/blockchaincmd/vmcmd"
 	"github.com/ava-labs/avalanche-cli/pkg/application"
 	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
 	"github.com/spf13/cobra"
@@ -34,6 +37,8 @@ manage your Blockchain configurations and live deployments.`,
 	cmd.AddCommand(newDeployCmd())
 	// blockchain describe
 	cmd.AddCommand(newDescribeCmd())
+	// blockchain diff
+	cmd.AddCommand(newDiffCmd())
 	// blockchain list
 	cmd.AddCommand(newListCmd())
 	// blockchain join
@@ -64,5 +69,15 @@ manage your Blockchain configurations and live deployments.`,
 	cmd.AddCommand(newChangeWeightCmd())
 	// blockchain convert
 	cmd.AddCommand(newConvertCmd())
+	// blockchain migrateToL1
+	cmd.AddCommand(newMigrateToL1Cmd())
+	// blockchain template
+	cmd.AddCommand(templatecmd.NewCmd(app))
+	// blockchain vm
+	cmd.AddCommand(vmcmd.NewCmd(app))
+	// blockchain verify
+	cmd.AddCommand(newVerifyCmd())
+	// blockchain validatorManager
+	cmd.AddCommand(validatormanagercmd.NewCmd(app))
 	return cmd
 }
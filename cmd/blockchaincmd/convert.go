@@ -109,6 +109,7 @@ Sovereign L1s require bootstrap validators. avalanche blockchain convert command
 	cmd.Flags().Uint64Var(&createFlags.rewardBasisPoints, "reward-basis-points", 100, "(PoS only) reward basis points for PoS Reward Calculator")
 	cmd.Flags().StringVar(&validatorManagerAddress, "validator-manager-address", "", "validator manager address")
 	cmd.Flags().BoolVar(&doStrongInputChecks, "verify-input", true, "check for input confirmation")
+	addDeployPlanFlags(cmd)
 	return cmd
 }
 
@@ -144,8 +145,8 @@ func StartLocalMachine(
 	if numLocalNodes > 0 {
 		useLocalMachine = true
 	}
-	// ask user if we want to use local machine if cluster is not provided
-	if !useLocalMachine && clusterNameFlagValue == "" {
+	// ask user if we want to use local machine if neither cluster nor bootstrap validators are provided
+	if !useLocalMachine && clusterNameFlagValue == "" && bootstrapValidatorsJSONFilePath == "" {
 		ux.Logger.PrintToUser("You can use your local machine as a bootstrap validator on the blockchain")
 		ux.Logger.PrintToUser("This means that you don't have to to set up a remote server on a cloud service (e.g. AWS / GCP) to be a validator on the blockchain.")
 
@@ -272,6 +273,7 @@ func InitializeValidatorManager(
 	network models.Network,
 	avaGoBootstrapValidators []*txs.ConvertSubnetToL1Validator,
 	pos bool,
+	stakingTokenAddress string,
 	validatorManagerAddrStr string,
 	proxyContractOwner string,
 	useACP99 bool,
@@ -287,11 +289,16 @@ func InitializeValidatorManager(
 	clusterName := clusterNameFlagValue
 	switch {
 	case useLocalMachine:
+		vmBinaryPath, err := vm.SetupBlockchainVMBinary(app, blockchainName)
+		if err != nil {
+			return false, err
+		}
 		if err := localnet.LocalClusterTrackSubnet(
 			app,
 			ux.Logger.PrintToUser,
 			clusterName,
 			blockchainName,
+			vmBinaryPath,
 		); err != nil {
 			return false, err
 		}
@@ -360,6 +367,7 @@ func InitializeValidatorManager(
 				rpcURL,
 				genesisPrivateKey,
 				proxyOwnerPrivateKey,
+				stakingTokenAddress,
 			); err != nil {
 				return tracked, err
 			}
@@ -390,7 +398,11 @@ func InitializeValidatorManager(
 	aggregatorCtx, aggregatorCancel := sdkutils.GetTimedContext(constants.SignatureAggregatorTimeout)
 	defer aggregatorCancel()
 	if pos {
-		ux.Logger.PrintToUser("Initializing Native Token Proof of Stake Validator Manager contract on blockchain %s ...", blockchainName)
+		if stakingTokenAddress != "" {
+			ux.Logger.PrintToUser("Initializing ERC20 Token Proof of Stake Validator Manager contract on blockchain %s ...", blockchainName)
+		} else {
+			ux.Logger.PrintToUser("Initializing Native Token Proof of Stake Validator Manager contract on blockchain %s ...", blockchainName)
+		}
 		if err := subnetSDK.InitializeProofOfStake(
 			aggregatorCtx,
 			app.Log,
@@ -407,6 +419,7 @@ func InitializeValidatorManager(
 				WeightToValueFactor:     big.NewInt(int64(poSWeightToValueFactor)),
 				RewardCalculatorAddress: validatorManagerSDK.RewardCalculatorAddress,
 				UptimeBlockchainID:      blockchainID,
+				StakingTokenAddress:     stakingTokenAddress,
 			},
 			validatorManagerAddrStr,
 		); err != nil {
@@ -493,6 +506,13 @@ func convertSubnetToL1(
 		return avaGoBootstrapValidators, false, false, err
 	}
 
+	if deployer.DryRun() {
+		if !isFullySigned {
+			return avaGoBootstrapValidators, false, false, errDryRunNotFullySigned
+		}
+		return avaGoBootstrapValidators, false, false, nil
+	}
+
 	savePartialTx := !isFullySigned && err == nil
 
 	if savePartialTx {
@@ -578,6 +598,13 @@ func convertBlockchain(_ *cobra.Command, args []string) error {
 	}
 	clusterNameFlagValue = globalNetworkFlags.ClusterName
 
+	if err := validateDeployPlanFlags(network); err != nil {
+		return err
+	}
+	if deployPlanPath != "" && !dryRun {
+		return executeDeployPlan(blockchainName, sidecar, network, nil)
+	}
+
 	subnetID := sidecar.Networks[network.Name()].SubnetID
 	blockchainID := sidecar.Networks[network.Name()].BlockchainID
 
@@ -650,6 +677,10 @@ func convertBlockchain(_ *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	payingAddresses, err := kc.PChainFormattedStrAddresses()
+	if err != nil {
+		return err
+	}
 
 	deployBalance := uint64(deployBalanceAVAX * float64(units.Avax))
 
@@ -661,7 +692,7 @@ func convertBlockchain(_ *cobra.Command, args []string) error {
 				ux.Logger.PrintToUser("Using [%s] to be set as a change owner for leftover AVAX", changeOwnerAddress)
 			}
 		}
-		if !generateNodeID {
+		if !generateNodeID && !dryRun {
 			if cancel, err := StartLocalMachine(
 				network,
 				sidecar,
@@ -760,6 +791,9 @@ func convertBlockchain(_ *cobra.Command, args []string) error {
 
 	// deploy to public network
 	deployer := subnet.NewPublicDeployer(app, kc, network)
+	if dryRun {
+		deployer = subnet.NewDryRunPublicDeployer(app, kc, network)
+	}
 
 	avaGoBootstrapValidators, cancel, savePartialTx, err := convertSubnetToL1(
 		bootstrapValidators,
@@ -781,6 +815,19 @@ func convertBlockchain(_ *cobra.Command, args []string) error {
 		return nil
 	}
 
+	if dryRun {
+		return finishDryRun("convert", network, deployer, payingAddresses, availableBalance, models.DeployPlan{
+			BlockchainName:          blockchainName,
+			Network:                 network.Name(),
+			SubnetID:                subnetID,
+			BlockchainID:            blockchainID,
+			ValidatorManagerAddress: validatorManagerAddress,
+			ValidatorManagement:     sidecar.ValidatorManagement,
+			ValidatorManagerOwner:   sidecar.ValidatorManagerOwner,
+			ProxyContractOwner:      sidecar.ProxyContractOwner,
+			BootstrapValidators:     bootstrapValidators,
+		})
+	}
 	if savePartialTx {
 		return nil
 	}
@@ -794,6 +841,7 @@ func convertBlockchain(_ *cobra.Command, args []string) error {
 			network,
 			avaGoBootstrapValidators,
 			sidecar.ValidatorManagement == validatormanagertypes.ProofOfStake,
+			sidecar.StakingTokenAddress,
 			validatorManagerAddress,
 			sidecar.ProxyContractOwner,
 			sidecar.UseACP99,
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"os"
 	"sort"
 	"strconv"
@@ -15,14 +16,17 @@ import (
 	"github.com/ava-labs/avalanche-cli/cmd/flags"
 	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
 	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
 	"github.com/ava-labs/avalanche-cli/pkg/interchain"
 	"github.com/ava-labs/avalanche-cli/pkg/key"
 	"github.com/ava-labs/avalanche-cli/pkg/metrics"
 	"github.com/ava-labs/avalanche-cli/pkg/models"
 	"github.com/ava-labs/avalanche-cli/pkg/utils"
 	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/pkg/validatormanager"
 	"github.com/ava-labs/avalanche-cli/pkg/vm"
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/subnet-evm/core"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
@@ -38,6 +42,7 @@ const (
 type CreateFlags struct {
 	useSubnetEvm                  bool
 	useCustomVM                   bool
+	vmType                        string
 	chainID                       uint64
 	tokenSymbol                   string
 	useTestDefaults               bool
@@ -52,10 +57,16 @@ type CreateFlags struct {
 	proofOfStake                  bool
 	proofOfAuthority              bool
 	rewardBasisPoints             uint64
+	rewardCalculator              string
+	stakingToken                  string
 	validatorManagerOwner         string
 	proxyContractOwner            string
 	enableDebugging               bool
 	useACP99                      bool
+	template                      string
+	allocations                   map[string]string
+	allocationsFile               string
+	maxSupply                     uint64
 }
 
 var (
@@ -70,6 +81,9 @@ var (
 	errIllegalNameCharacter                       = errors.New("illegal name character: only letters, no special characters allowed")
 	errMutuallyExlusiveVersionOptions             = errors.New("version flags --latest,--pre-release,vm-version are mutually exclusive")
 	errMutuallyExclusiveVMConfigOptions           = errors.New("--genesis flag disables --evm-chain-id,--evm-defaults,--production-defaults,--test-defaults")
+	errMutuallyExclusiveTemplateOptions           = errors.New("--template flag disables --genesis,--evm-defaults,--production-defaults,--test-defaults")
+	errAllocationsWithoutTemplate                 = errors.New("--allocation flag is only applicable together with --template")
+	errAllocationsFileWithGenesis                 = errors.New("--allocations-file flag is not applicable together with --genesis")
 	errMutuallyExlusiveValidatorManagementOptions = errors.New("validator management type flags --proof-of-authority,--proof-of-stake are mutually exclusive")
 	errSOVFlagsOnly                               = errors.New("flags --proof-of-authority, --proof-of-stake, --poa-manager-owner --proxy-contract-owner are only applicable to Subnet Only Validator (SOV) blockchains")
 )
@@ -87,6 +101,20 @@ The tool supports deploying Subnet-EVM, and custom VMs. You
 can create a custom, user-generated genesis with a custom VM by providing
 the path to your genesis and VM binaries with the --genesis and --vm flags.
 
+Third party VMs can also be registered by placing a VM descriptor manifest
+under ~/.avalanche-cli/vm-descriptors, and then selected with --vm-type. Their
+genesis is prompted for and validated, and their binary is downloaded from
+the VM releases, as specified by the manifest.
+
+A Subnet-EVM genesis can also be generated from a named template with the
+--template flag, setting its chain ID, token symbol and initial allocations.
+Use 'avalanche blockchain template list' to see the available templates.
+
+Initial token allocations can be imported in bulk with --allocations-file, a CSV
+file with address,amount[,unit] rows where unit is either tokens (default) or wei.
+A summary of the genesis supply is printed before writing the genesis, and
+--max-supply makes the command fail if the supply exceeds the given amount.
+
 By default, running the command with a blockchainName that already exists
 causes the command to fail. If you'd like to overwrite an existing
 configuration, pass the -f flag.`,
@@ -95,8 +123,13 @@ configuration, pass the -f flag.`,
 		PersistentPostRun: handlePostRun,
 	}
 	cmd.Flags().StringVar(&genesisPath, "genesis", "", "file path of genesis to use")
+	cmd.Flags().StringVar(&createFlags.template, "template", "", "name of the genesis template to use")
+	cmd.Flags().StringToStringVar(&createFlags.allocations, "allocation", nil, "initial token allocations for the genesis template, as address=amount pairs in whole token units")
+	cmd.Flags().StringVar(&createFlags.allocationsFile, "allocations-file", "", "CSV file with initial token allocations, as address,amount[,unit] rows")
+	cmd.Flags().Uint64Var(&createFlags.maxSupply, "max-supply", 0, "fail if the genesis token supply exceeds this amount, in whole token units")
 	cmd.Flags().BoolVar(&createFlags.useSubnetEvm, "evm", false, "use the Subnet-EVM as the base template")
 	cmd.Flags().BoolVar(&createFlags.useCustomVM, "custom", false, "use a custom VM template")
+	cmd.Flags().StringVar(&createFlags.vmType, "vm-type", "", "use a VM registered through a VM descriptor manifest")
 	cmd.Flags().StringVar(&createFlags.vmVersion, "vm-version", "", "version of Subnet-EVM template to use")
 	cmd.Flags().BoolVar(&createFlags.useLatestPreReleasedVMVersion, preRelease, false, "use latest Subnet-EVM pre-released version, takes precedence over --vm-version")
 	cmd.Flags().BoolVar(&createFlags.useLatestReleasedVMVersion, latest, false, "use latest Subnet-EVM released version, takes precedence over --vm-version")
@@ -123,6 +156,8 @@ configuration, pass the -f flag.`,
 	cmd.Flags().StringVar(&createFlags.proxyContractOwner, "proxy-contract-owner", "", "EVM address that controls ProxyAdmin for TransparentProxy of ValidatorManager contract")
 	cmd.Flags().BoolVar(&sovereign, "sovereign", true, "set to false if creating non-sovereign blockchain")
 	cmd.Flags().Uint64Var(&createFlags.rewardBasisPoints, "reward-basis-points", 100, "(PoS only) reward basis points for PoS Reward Calculator")
+	cmd.Flags().StringVar(&createFlags.rewardCalculator, "reward-calculator", "", "(PoS only) path to a forge or hardhat artifact of a custom IRewardCalculator to use instead of the example one. It is set on genesis, so it must be constructor free")
+	cmd.Flags().StringVar(&createFlags.stakingToken, "staking-token", "", "(PoS only) address of the ERC20 token to stake instead of the native token. It must allow the validator manager to mint rewards")
 	cmd.Flags().BoolVar(&createFlags.enableDebugging, "debug", true, "enable blockchain debugging")
 	cmd.Flags().BoolVar(&createFlags.useACP99, "acp99", true, "use ACP99 contracts instead of v1.0.0 for validator managers")
 	return cmd
@@ -199,14 +234,28 @@ func createBlockchainConfig(cmd *cobra.Command, args []string) error {
 		return errMutuallyExclusiveVMConfigOptions
 	}
 
+	// template flags exclusiveness
+	if createFlags.template != "" {
+		if genesisPath != "" || defaultsKind != vm.NoDefaults {
+			return errMutuallyExclusiveTemplateOptions
+		}
+		// templates are Subnet-EVM genesis
+		createFlags.useSubnetEvm = true
+	} else if len(createFlags.allocations) > 0 {
+		return errAllocationsWithoutTemplate
+	}
+	if createFlags.allocationsFile != "" && genesisPath != "" {
+		return errAllocationsFileWithGenesis
+	}
+
 	// if given custom repo info, assumes custom VM
 	if vmFile != "" || customVMRepoURL != "" || customVMBranch != "" || customVMBuildScript != "" {
 		createFlags.useCustomVM = true
 	}
 
 	// vm type exclusiveness
-	if !flags.EnsureMutuallyExclusive([]bool{createFlags.useSubnetEvm, createFlags.useCustomVM}) {
-		return errors.New("flags --evm,--custom are mutually exclusive")
+	if !flags.EnsureMutuallyExclusive([]bool{createFlags.useSubnetEvm, createFlags.useCustomVM, createFlags.vmType != ""}) {
+		return errors.New("flags --evm,--custom,--vm-type are mutually exclusive")
 	}
 
 	if !sovereign {
@@ -223,10 +272,53 @@ func createBlockchainConfig(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("reward basis points cannot be zero")
 	}
 
+	var rewardCalculator *validatormanager.RewardCalculatorArtifact
+	if createFlags.rewardCalculator != "" {
+		if !createFlags.proofOfStake {
+			return fmt.Errorf("--reward-calculator is only applicable to proof of stake blockchains")
+		}
+		if cmd.Flags().Changed("reward-basis-points") {
+			return fmt.Errorf("--reward-basis-points is only applicable to the example reward calculator, not to --reward-calculator")
+		}
+		artifact, err := validatormanager.LoadRewardCalculatorArtifact(utils.ExpandHome(createFlags.rewardCalculator))
+		if err != nil {
+			return err
+		}
+		// the reward calculator is set on genesis
+		if err := artifact.CheckConstructorFree(); err != nil {
+			return err
+		}
+		rewardCalculator = &artifact
+	}
+
+	if createFlags.stakingToken != "" {
+		if !createFlags.proofOfStake {
+			return fmt.Errorf("--staking-token is only applicable to proof of stake blockchains")
+		}
+		if !common.IsHexAddress(createFlags.stakingToken) {
+			return fmt.Errorf("staking token has to be an EVM address (in 0x format)")
+		}
+	}
+
 	// get vm kind
-	vmType, err := vm.PromptVMType(app, createFlags.useSubnetEvm, createFlags.useCustomVM)
-	if err != nil {
-		return err
+	var (
+		vmType models.VMType
+		err    error
+	)
+	if createFlags.vmType != "" {
+		descriptor, err := vm.GetDescriptor(app, models.VMType(createFlags.vmType))
+		if err != nil {
+			return err
+		}
+		vmType = descriptor.Type()
+	} else {
+		vmType, err = vm.PromptVMType(app, createFlags.useSubnetEvm, createFlags.useCustomVM)
+		if err != nil {
+			return err
+		}
+	}
+	if vmType != models.SubnetEvm && createFlags.allocationsFile != "" {
+		return fmt.Errorf("--allocations-file flag is only applicable to Subnet-EVM blockchains")
 	}
 
 	var (
@@ -258,6 +350,7 @@ func createBlockchainConfig(cmd *cobra.Command, args []string) error {
 		if err = promptValidatorManagementType(app, sc); err != nil {
 			return err
 		}
+		sc.StakingTokenAddress = createFlags.stakingToken
 	}
 
 	if vmType == models.SubnetEvm {
@@ -267,7 +360,23 @@ func createBlockchainConfig(cmd *cobra.Command, args []string) error {
 			}
 		}
 
-		if genesisPath == "" {
+		var fileAllocations core.GenesisAlloc
+		if createFlags.allocationsFile != "" {
+			fileAllocations, err = vm.LoadGenesisAllocationsFile(createFlags.allocationsFile)
+			if err != nil {
+				return fmt.Errorf("failed to load allocations file %s: %w", createFlags.allocationsFile, err)
+			}
+		}
+
+		var genesisTemplate vm.GenesisTemplate
+		if createFlags.template != "" {
+			genesisTemplate, err = vm.GetGenesisTemplate(app, createFlags.template)
+			if err != nil {
+				return err
+			}
+		}
+
+		if genesisPath == "" && createFlags.template == "" {
 			// Default
 			defaultsKind, err = vm.PromptDefaults(app, defaultsKind)
 			if err != nil {
@@ -277,6 +386,9 @@ func createBlockchainConfig(cmd *cobra.Command, args []string) error {
 
 		// get vm version
 		vmVersion := createFlags.vmVersion
+		if vmVersion == "" && !createFlags.useLatestReleasedVMVersion && !createFlags.useLatestPreReleasedVMVersion {
+			vmVersion = genesisTemplate.VMVersion
+		}
 		if vmVersion == "" && (createFlags.useLatestReleasedVMVersion || defaultsKind != vm.NoDefaults) {
 			vmVersion = latest
 		}
@@ -312,6 +424,38 @@ func createBlockchainConfig(cmd *cobra.Command, args []string) error {
 			if err != nil {
 				return err
 			}
+		} else if createFlags.template != "" {
+			allocations, err := vm.ParseGenesisAllocations(createFlags.allocations)
+			if err != nil {
+				return err
+			}
+			for addr, account := range fileAllocations {
+				if existing, ok := allocations[addr]; ok {
+					account.Balance = new(big.Int).Add(existing.Balance, account.Balance)
+				}
+				allocations[addr] = account
+			}
+			var params vm.GenesisTemplateParams
+			params, tokenSymbol, err = vm.PromptGenesisTemplateParams(
+				app,
+				genesisTemplate,
+				createFlags.chainID,
+				createFlags.tokenSymbol,
+				allocations,
+				blockchainName,
+			)
+			if err != nil {
+				return err
+			}
+			deployICM, err = vm.PromptInterop(app, useICMFlag, defaultsKind, false)
+			if err != nil {
+				return err
+			}
+			ux.Logger.PrintToUser("creating genesis for blockchain %s from template %s", blockchainName, genesisTemplate.Name)
+			genesisBytes, err = genesisTemplate.Apply(params)
+			if err != nil {
+				return err
+			}
 		} else {
 			var params vm.SubnetEVMGenesisParams
 			params, tokenSymbol, err = vm.PromptSubnetEVMGenesisParams(
@@ -325,6 +469,7 @@ func createBlockchainConfig(cmd *cobra.Command, args []string) error {
 				defaultsKind,
 				createFlags.useWarp,
 				createFlags.useExternalGasToken,
+				fileAllocations,
 			)
 			if err != nil {
 				return err
@@ -338,6 +483,7 @@ func createBlockchainConfig(cmd *cobra.Command, args []string) error {
 				createFlags.addICMRegistryToGenesis,
 				sc.ProxyContractOwner,
 				createFlags.rewardBasisPoints,
+				rewardCalculator,
 				createFlags.useACP99,
 			)
 			if err != nil {
@@ -356,6 +502,44 @@ func createBlockchainConfig(cmd *cobra.Command, args []string) error {
 		); err != nil {
 			return err
 		}
+	} else if vmType != models.CustomVM {
+		// VM registered through a descriptor manifest
+		descriptor, err := vm.GetDescriptor(app, vmType)
+		if err != nil {
+			return err
+		}
+		if genesisPath != "" {
+			ux.Logger.PrintToUser("importing genesis for blockchain %s", blockchainName)
+			genesisBytes, err = os.ReadFile(genesisPath)
+		} else if prompter, ok := descriptor.(vm.GenesisPrompter); ok {
+			genesisBytes, err = prompter.PromptGenesis(app, sc, blockchainName)
+		} else {
+			err = fmt.Errorf("%s blockchains require a genesis file to be given with --genesis", vmType)
+		}
+		if err != nil {
+			return err
+		}
+		if err := descriptor.ValidateGenesis(genesisBytes); err != nil {
+			return err
+		}
+		vmVersion := createFlags.vmVersion
+		if vmVersion == "" || createFlags.useLatestReleasedVMVersion {
+			vmVersion = constants.LatestReleaseVersionTag
+		}
+		if createFlags.useLatestPreReleasedVMVersion {
+			vmVersion = constants.LatestPreReleaseVersionTag
+		}
+		if sc, err = vm.CreateDescriptorSidecar(
+			sc,
+			app,
+			descriptor,
+			blockchainName,
+			vmVersion,
+			createFlags.tokenSymbol,
+			sovereign,
+		); err != nil {
+			return err
+		}
 	} else {
 		if genesisPath == "" {
 			genesisPath, err = app.Prompt.CaptureExistingFilepath("Enter path to custom genesis")
@@ -405,11 +589,16 @@ func createBlockchainConfig(cmd *cobra.Command, args []string) error {
 		sc.ExternalToken = useExternalGasToken
 		sc.TeleporterKey = constants.ICMKeyName
 		sc.TeleporterVersion = icmInfo.Version
-		if genesisPath != "" {
-			if evmCompatibleGenesis, err := utils.FileIsSubnetEVMGenesis(genesisPath); err != nil {
-				return err
-			} else if evmCompatibleGenesis {
-				// evm genesis file was given. make appropriate checks and customizations for ICM
+		if genesisPath != "" || createFlags.template != "" {
+			evmCompatibleGenesis := createFlags.template != ""
+			if genesisPath != "" {
+				evmCompatibleGenesis, err = utils.FileIsSubnetEVMGenesis(genesisPath)
+				if err != nil {
+					return err
+				}
+			}
+			if evmCompatibleGenesis {
+				// evm genesis file or template was given. make appropriate checks and customizations for ICM
 				genesisBytes, err = addSubnetEVMGenesisPrefundedAddress(
 					genesisBytes,
 					icmInfo.FundedAddress,
@@ -422,31 +611,37 @@ func createBlockchainConfig(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if utils.ByteSliceIsSubnetEvmGenesis(genesisBytes) {
+		if err := checkGenesisSupply(genesisBytes, sc.TokenSymbol, createFlags.maxSupply); err != nil {
+			return err
+		}
+	}
+
 	if err = app.WriteGenesisFile(blockchainName, genesisBytes); err != nil {
 		return err
 	}
 
-	// subnet-evm check based on genesis
-	// covers both subnet-evm vms and custom vms
-	if hasSubnetEVMGenesis, _, err := app.HasSubnetEVMGenesis(blockchainName); err != nil {
+	// default chain config is given by the VM descriptor, or for custom vms,
+	// by subnet-evm if the genesis is compatible
+	var chainConfig []byte
+	if descriptor, err := vm.GetDescriptor(app, vmType); err == nil {
+		chainConfig = descriptor.DefaultChainConfig(createFlags.enableDebugging)
+	} else if hasSubnetEVMGenesis, _, err := app.HasSubnetEVMGenesis(blockchainName); err != nil {
 		return err
 	} else if hasSubnetEVMGenesis {
-		if createFlags.enableDebugging {
-			if err := SetBlockchainConf(
-				blockchainName,
-				vm.EvmDebugConfig,
-				constants.ChainConfigFileName,
-			); err != nil {
-				return err
-			}
-		} else {
-			if err := SetBlockchainConf(
-				blockchainName,
-				vm.EvmNonDebugConfig,
-				constants.ChainConfigFileName,
-			); err != nil {
-				return err
-			}
+		descriptor, err := vm.GetDescriptor(app, models.SubnetEvm)
+		if err != nil {
+			return err
+		}
+		chainConfig = descriptor.DefaultChainConfig(createFlags.enableDebugging)
+	}
+	if chainConfig != nil {
+		if err := SetBlockchainConf(
+			blockchainName,
+			chainConfig,
+			constants.ChainConfigFileName,
+		); err != nil {
+			return err
 		}
 	}
 
@@ -486,6 +681,24 @@ func addSubnetEVMGenesisPrefundedAddress(genesisBytes []byte, address string, ba
 	return json.MarshalIndent(genesisMap, "", "  ")
 }
 
+// prints the supply summary of [genesisBytes], failing if its supply is
+// greater than [maxSupply] whole tokens. a zero [maxSupply] means no limit
+func checkGenesisSupply(genesisBytes []byte, tokenSymbol string, maxSupply uint64) error {
+	genesis, err := utils.ByteSliceToSubnetEvmGenesis(genesisBytes)
+	if err != nil {
+		return err
+	}
+	printGenesisSupplySummary(genesis.Alloc, tokenSymbol)
+	if maxSupply == 0 {
+		return nil
+	}
+	maxSupplyWei := new(big.Int).Mul(new(big.Int).SetUint64(maxSupply), vm.OneAvax)
+	if supply := contract.GetGenesisSupplySummary(genesis.Alloc, 0).TotalSupply; supply.Cmp(maxSupplyWei) > 0 {
+		return fmt.Errorf("genesis supply of %s wei exceeds the max supply of %d %s", supply, maxSupply, tokenSymbol)
+	}
+	return nil
+}
+
 func sendMetrics(repoName, blockchainName string) error {
 	flags := make(map[string]string)
 	flags[constants.SubnetType] = repoName
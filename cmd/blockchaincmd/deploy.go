@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/ava-labs/avalanche-cli/cmd/flags"
 	"github.com/ava-labs/avalanche-cli/cmd/interchaincmd/messengercmd"
@@ -119,7 +120,12 @@ attempts to deploy the same Blockchain to the same network (local, Fuji, Mainnet
 allowed. If you'd like to redeploy a Blockchain locally for testing, you must first call
 avalanche network clean to reset all deployed chain state. Subsequent local deploys
 redeploy the chain with fresh state. You can deploy the same Blockchain to multiple networks,
-so you can take your locally tested Blockchain and deploy it on Fuji or Mainnet.`,
+so you can take your locally tested Blockchain and deploy it on Fuji or Mainnet.
+
+On public networks, --dry-run builds and signs the P-Chain transactions without issuing them,
+totals their fees and the bootstrap validators balance, checks that the paying addresses hold
+enough funds and that the bootstrap validators are valid and reachable, and saves the signed
+off plan. Pass the plan to a later deploy with --plan-file to issue it unchanged.`,
 		RunE:              deployBlockchain,
 		PersistentPostRun: handlePostRun,
 		Args:              cobrautils.ExactArgs(1),
@@ -207,6 +213,7 @@ so you can take your locally tested Blockchain and deploy it on Fuji or Mainnet.
 
 	cmd.Flags().BoolVar(&partialSync, "partial-sync", true, "set primary network partial sync for new validators")
 	cmd.Flags().Uint32Var(&numNodes, "num-nodes", constants.LocalNetworkNumNodes, "number of nodes to be created on local network deploy")
+	addDeployPlanFlags(cmd)
 	return cmd
 }
 
@@ -452,6 +459,27 @@ func deployBlockchain(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to validate SubnetEVM genesis format: %w", validationErr)
 	}
 
+	// refuse custom VM binaries not matching the pinned build
+	if vmPath := app.GetCustomVMPath(chain); sidecar.VM == models.CustomVM && utils.FileExists(vmPath) {
+		if err := vm.VerifyCustomVMBinary(sidecar, vmPath); err != nil {
+			return fmt.Errorf("%w. Use 'avalanche blockchain vm rebuild %s' to rebuild it", err, chain)
+		}
+	}
+
+	descriptor, err := vm.GetDescriptor(app, sidecar.VM)
+	if err != nil && !errors.Is(err, vm.ErrDescriptorNotFound) {
+		return err
+	}
+	if descriptor != nil {
+		genesis, err := app.LoadRawGenesis(chain)
+		if err != nil {
+			return err
+		}
+		if err := descriptor.ValidateGenesis(genesis); err != nil {
+			return err
+		}
+	}
+
 	chainGenesis, err := app.LoadRawGenesis(chain)
 	if err != nil {
 		return err
@@ -475,6 +503,13 @@ func deployBlockchain(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if err := validateDeployPlanFlags(network); err != nil {
+		return err
+	}
+	if deployPlanPath != "" && !dryRun {
+		return executeDeployPlan(blockchainName, sidecar, network, chainGenesis)
+	}
+
 	ux.Logger.PrintToUser("Deploying %s to %s", chains, network.Name())
 
 	if network.Kind == models.Local {
@@ -577,6 +612,10 @@ func deployBlockchain(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	payingAddresses, err := kc.PChainFormattedStrAddresses()
+	if err != nil {
+		return err
+	}
 
 	deployBalance := uint64(deployBalanceAVAX * float64(units.Avax))
 	// whether user has created Avalanche Nodes when blockchain deploy command is called
@@ -588,7 +627,7 @@ func deployBlockchain(cmd *cobra.Command, args []string) error {
 				ux.Logger.PrintToUser("Using [%s] to be set as a change owner for leftover AVAX", changeOwnerAddress)
 			}
 		}
-		if !generateNodeID {
+		if !generateNodeID && !dryRun {
 			if cancel, err := StartLocalMachine(
 				network,
 				sidecar,
@@ -727,6 +766,9 @@ func deployBlockchain(cmd *cobra.Command, args []string) error {
 
 	// deploy to public network
 	deployer := subnet.NewPublicDeployer(app, kc, network)
+	if dryRun {
+		deployer = subnet.NewDryRunPublicDeployer(app, kc, network)
+	}
 
 	if createSubnet {
 		subnetID, err = deployer.DeploySubnet(controlKeys, threshold)
@@ -736,7 +778,7 @@ func deployBlockchain(cmd *cobra.Command, args []string) error {
 		// TODO: remove once dynamic fees conf can be updated on wallet
 		deployer.CleanCacheWallet()
 		// get the control keys in the same order as the tx
-		_, controlKeys, threshold, err = txutils.GetOwners(network, subnetID)
+		controlKeys, threshold, err = deployer.GetSubnetOwners(subnetID)
 		if err != nil {
 			return err
 		}
@@ -767,9 +809,25 @@ func deployBlockchain(cmd *cobra.Command, args []string) error {
 		// TODO: remove once dynamic fees conf can be updated on wallet
 		deployer.CleanCacheWallet()
 
+		if dryRun && !isFullySigned {
+			return errDryRunNotFullySigned
+		}
 		savePartialTx = !isFullySigned && err == nil
 	}
 
+	plan := models.DeployPlan{
+		BlockchainName: blockchainName,
+		Network:        network.Name(),
+		SubnetID:       subnetID,
+		BlockchainID:   blockchainID,
+	}
+	if !subnetOnly {
+		plan.GenesisSHA256 = genesisSHA256(chainGenesis)
+	}
+	if dryRun && (!sidecar.Sovereign || subnetOnly) {
+		return finishDryRun("deploy", network, deployer, payingAddresses, availableBalance, plan)
+	}
+
 	if err := PrintDeployResults(chain, subnetID, blockchainID); err != nil {
 		return err
 	}
@@ -812,6 +870,14 @@ func deployBlockchain(cmd *cobra.Command, args []string) error {
 			return nil
 		}
 
+		if dryRun {
+			plan.ValidatorManagerAddress = validatorManagerStr
+			plan.ValidatorManagement = sidecar.ValidatorManagement
+			plan.ValidatorManagerOwner = sidecar.ValidatorManagerOwner
+			plan.ProxyContractOwner = sidecar.ProxyContractOwner
+			plan.BootstrapValidators = bootstrapValidators
+			return finishDryRun("deploy", network, deployer, payingAddresses, availableBalance, plan)
+		}
 		if savePartialTx {
 			return nil
 		}
@@ -834,6 +900,7 @@ func deployBlockchain(cmd *cobra.Command, args []string) error {
 			network,
 			avaGoBootstrapValidators,
 			sidecar.ValidatorManagement == validatormanagertypes.ProofOfStake,
+			sidecar.StakingTokenAddress,
 			validatorManagerStr,
 			sidecar.ProxyContractOwner,
 			sidecar.UseACP99,
@@ -858,10 +925,15 @@ func deployBlockchain(cmd *cobra.Command, args []string) error {
 		}
 		if network.Kind == models.Local && !simulatedPublicNetwork() {
 			ux.Logger.PrintToUser("")
+			vmBinaryPath, err := vm.SetupBlockchainVMBinary(app, blockchainName)
+			if err != nil {
+				return err
+			}
 			if err := localnet.LocalNetworkTrackSubnet(
 				app,
 				ux.Logger.PrintToUser,
 				blockchainName,
+				vmBinaryPath,
 			); err != nil {
 				return err
 			}
@@ -869,6 +941,14 @@ func deployBlockchain(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if tracked && descriptor != nil {
+		if err := probeBlockchainRPC(descriptor, blockchainName, network, blockchainID); err != nil {
+			ux.Logger.RedXToUser("Blockchain RPC health check failed: %s", err)
+		} else {
+			ux.Logger.GreenCheckmarkToUser("Blockchain RPC is healthy")
+		}
+	}
+
 	if sidecar.Sovereign && tracked {
 		ux.Logger.PrintToUser("")
 		ux.Logger.PrintToUser(logging.Green.Wrap("Your L1 is ready for on-chain interactions."))
@@ -991,6 +1071,28 @@ func deployBlockchain(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// checks that the RPC of [blockchainID] serves requests, as defined by the VM [descriptor].
+// The blockchain nodes are reached through the RPC endpoints saved for [blockchainName] on
+// [network], as L1s are not validated by the network API nodes, or through the network
+// endpoint if there are none
+func probeBlockchainRPC(descriptor vm.Descriptor, blockchainName string, network models.Network, blockchainID ids.ID) error {
+	nodeURI := network.Endpoint
+	sc, err := app.LoadSidecar(blockchainName)
+	if err != nil {
+		return err
+	}
+	for _, rpcEndpoint := range sc.Networks[network.Name()].RPCEndpoints {
+		if i := strings.Index(rpcEndpoint, "/ext/bc/"); i > 0 {
+			nodeURI = rpcEndpoint[:i]
+			break
+		}
+	}
+	rpcURL := strings.TrimSuffix(nodeURI, "/") + descriptor.RPCURLSuffix(blockchainID.String())
+	ctx, cancel := utils.GetAPILargeContext()
+	defer cancel()
+	return descriptor.HealthProbe(ctx, rpcURL)
+}
+
 func setBootstrapValidatorValidationID(avaGoBootstrapValidators []*txs.ConvertSubnetToL1Validator, bootstrapValidators []models.SubnetValidator, subnetID ids.ID) {
 	for index, avagoValidator := range avaGoBootstrapValidators {
 		for bootstrapValidatorIndex, validator := range bootstrapValidators {
@@ -0,0 +1,327 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package blockchaincmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/pkg/blockchain"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/subnet"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/sdk/validatormanager/validatormanagertypes"
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/spf13/cobra"
+)
+
+var (
+	dryRun         bool
+	deployPlanPath string
+
+	errDryRunNotFullySigned = errors.New("dry runs require txs to be fully signed by the given keys")
+	errPreflightFailed      = errors.New("preflight checks failed")
+)
+
+func addDeployPlanFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "build, check and sign the P-Chain txs without issuing them, and save them into a deploy plan")
+	cmd.Flags().StringVar(&deployPlanPath, "plan-file", "", "deploy plan file path: where to save the plan on dry runs (defaults to the blockchain dir), or the signed off plan to execute otherwise. "+
+		"The plan digest only detects accidental modifications, it does not authenticate who signed it off")
+}
+
+func validateDeployPlanFlags(network models.Network) error {
+	if (dryRun || deployPlanPath != "") && network.Kind == models.Local {
+		return fmt.Errorf("deploy plans are not supported on %s", network.Name())
+	}
+	if dryRun && useLocalMachine {
+		return fmt.Errorf("--dry-run is not compatible with --use-local-machine")
+	}
+	return nil
+}
+
+func genesisSHA256(genesis []byte) string {
+	digest := sha256.Sum256(genesis)
+	return hex.EncodeToString(digest[:])
+}
+
+// finishDryRun completes [plan] with the txs built by the dry run [deployer], checks that
+// it can be executed, and saves it once signed off by the user
+func finishDryRun(
+	cmdName string,
+	network models.Network,
+	deployer *subnet.PublicDeployer,
+	payingAddresses []string,
+	availableBalance uint64,
+	plan models.DeployPlan,
+) error {
+	plan.Txs = deployer.DryRunTxs()
+	plan.PayingAddresses = payingAddresses
+	for _, planTx := range plan.Txs {
+		plan.TotalFee += planTx.Fee
+	}
+	for _, validator := range plan.BootstrapValidators {
+		plan.TotalValidatorBalance += validator.Balance
+	}
+	printDeployPlan(plan)
+	if err := runPreflightChecks(network, plan, availableBalance); err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("")
+	if signOff, err := app.Prompt.CaptureYesNo("Do you sign off this plan, to be executed later as is?"); err != nil {
+		return err
+	} else if !signOff {
+		ux.Logger.PrintToUser("Deploy plan discarded")
+		return nil
+	}
+	planPath := deployPlanPath
+	if planPath == "" {
+		planPath = app.GetDeployPlanPath(plan.BlockchainName)
+	}
+	if err := saveDeployPlan(planPath, plan); err != nil {
+		return err
+	}
+	ux.Logger.GreenCheckmarkToUser("Deploy plan saved at %s", planPath)
+	ux.Logger.PrintToUser(
+		"To execute it, call `avalanche blockchain %s %s --plan-file %s` on %s",
+		cmdName,
+		plan.BlockchainName,
+		planPath,
+		network.Name(),
+	)
+	ux.Logger.PrintToUser("The plan is only valid while the paying addresses UTXOs are not spent elsewhere")
+	return nil
+}
+
+func printDeployPlan(plan models.DeployPlan) {
+	ux.Logger.PrintToUser("")
+	ux.Logger.PrintToUser("Deploy plan for %s on %s:", plan.BlockchainName, plan.Network)
+	for i, planTx := range plan.Txs {
+		ux.Logger.PrintToUser("  %d. %s %s (fee %.9f AVAX)", i+1, planTx.Kind, planTx.TxID, float64(planTx.Fee)/float64(units.Avax))
+	}
+	ux.Logger.PrintToUser("Subnet ID: %s", plan.SubnetID)
+	if plan.BlockchainID != ids.Empty {
+		ux.Logger.PrintToUser("Blockchain ID: %s", plan.BlockchainID)
+	}
+	for _, validator := range plan.BootstrapValidators {
+		ux.Logger.PrintToUser(
+			"Bootstrap validator %s: weight %d, balance %.9f AVAX",
+			validator.NodeID,
+			validator.Weight,
+			float64(validator.Balance)/float64(units.Avax),
+		)
+	}
+	ux.Logger.PrintToUser("Total fees: %.9f AVAX", float64(plan.TotalFee)/float64(units.Avax))
+	if plan.TotalValidatorBalance > 0 {
+		ux.Logger.PrintToUser("Total bootstrap validators balance: %.9f AVAX", float64(plan.TotalValidatorBalance)/float64(units.Avax))
+	}
+}
+
+// checks that the paying addresses hold enough funds, and that the bootstrap
+// validators have valid BLS info and reachable nodes
+func runPreflightChecks(network models.Network, plan models.DeployPlan, availableBalance uint64) error {
+	ux.Logger.PrintToUser("")
+	ux.Logger.PrintToUser("Preflight checks:")
+	failed := false
+	requiredBalance := plan.TotalFee + plan.TotalValidatorBalance
+	if availableBalance >= requiredBalance {
+		ux.Logger.GreenCheckmarkToUser(
+			"Paying addresses hold %.9f AVAX, enough to cover %.9f AVAX",
+			float64(availableBalance)/float64(units.Avax),
+			float64(requiredBalance)/float64(units.Avax),
+		)
+	} else {
+		ux.Logger.RedXToUser(
+			"Paying addresses hold %.9f AVAX, not enough to cover %.9f AVAX",
+			float64(availableBalance)/float64(units.Avax),
+			float64(requiredBalance)/float64(units.Avax),
+		)
+		failed = true
+	}
+	if len(plan.BootstrapValidators) > 0 {
+		nodeIDs := []ids.NodeID{}
+		for _, validator := range plan.BootstrapValidators {
+			nodeID, err := ids.NodeIDFromString(validator.NodeID)
+			if err != nil {
+				return err
+			}
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+		reachableNodeIDs := set.Set[ids.NodeID]{}
+		if !generateNodeID {
+			var err error
+			reachableNodeIDs, err = getReachableNodeIDs(network, nodeIDs)
+			if err != nil {
+				return err
+			}
+		}
+		for i, validator := range plan.BootstrapValidators {
+			pop, err := blockchain.ConvertToBLSProofOfPossession(validator.BLSPublicKey, validator.BLSProofOfPossession)
+			if err == nil {
+				err = pop.Verify()
+			}
+			if err != nil {
+				ux.Logger.RedXToUser("Bootstrap validator %s has an invalid BLS proof of possession: %s", validator.NodeID, err)
+				failed = true
+			} else {
+				ux.Logger.GreenCheckmarkToUser("Bootstrap validator %s has a valid BLS proof of possession", validator.NodeID)
+			}
+			switch {
+			case generateNodeID:
+				ux.Logger.PrintToUser(logging.Yellow.Wrap("Bootstrap validator %s node is yet to be created"), validator.NodeID)
+			case reachableNodeIDs.Contains(nodeIDs[i]):
+				ux.Logger.GreenCheckmarkToUser("Bootstrap validator %s node is reachable", validator.NodeID)
+			default:
+				ux.Logger.RedXToUser("Bootstrap validator %s node is not reachable", validator.NodeID)
+				failed = true
+			}
+		}
+	}
+	if failed {
+		return errPreflightFailed
+	}
+	return nil
+}
+
+// returns the nodes among [nodeIDs] that are connected to the network API node, or that
+// answer on the given bootstrap endpoints
+func getReachableNodeIDs(network models.Network, nodeIDs []ids.NodeID) (set.Set[ids.NodeID], error) {
+	reachableNodeIDs := set.Set[ids.NodeID]{}
+	for _, endpoint := range bootstrapEndpoints {
+		ctx, cancel := utils.GetAPIContext()
+		nodeID, _, err := info.NewClient(endpoint).GetNodeID(ctx)
+		cancel()
+		if err == nil {
+			reachableNodeIDs.Add(nodeID)
+		}
+	}
+	ctx, cancel := utils.GetAPILargeContext()
+	defer cancel()
+	peers, err := info.NewClient(network.Endpoint).Peers(ctx, nodeIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failure getting %s peers: %w", network.Name(), err)
+	}
+	for _, peer := range peers {
+		reachableNodeIDs.Add(peer.Info.ID)
+	}
+	return reachableNodeIDs, nil
+}
+
+func saveDeployPlan(planPath string, plan models.DeployPlan) error {
+	var err error
+	plan.SignedOffAt = time.Now().UTC()
+	plan.Digest, err = plan.ComputeDigest()
+	if err != nil {
+		return err
+	}
+	planBytes, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(planPath, planBytes, constants.WriteReadReadPerms)
+}
+
+func loadDeployPlan(planPath string) (models.DeployPlan, error) {
+	planBytes, err := os.ReadFile(planPath)
+	if err != nil {
+		return models.DeployPlan{}, err
+	}
+	var plan models.DeployPlan
+	if err := json.Unmarshal(planBytes, &plan); err != nil {
+		return models.DeployPlan{}, fmt.Errorf("invalid deploy plan %s: %w", planPath, err)
+	}
+	if signedOff, err := plan.IsSignedOff(); err != nil {
+		return models.DeployPlan{}, err
+	} else if !signedOff {
+		return models.DeployPlan{}, fmt.Errorf("deploy plan %s is not signed off, or was modified after sign off", planPath)
+	}
+	return plan, nil
+}
+
+// executeDeployPlan issues the txs of the signed off plan at [deployPlanPath], and
+// records the deployed blockchain on the sidecar
+func executeDeployPlan(
+	blockchainName string,
+	sidecar models.Sidecar,
+	network models.Network,
+	chainGenesis []byte,
+) error {
+	plan, err := loadDeployPlan(deployPlanPath)
+	if err != nil {
+		return err
+	}
+	if plan.BlockchainName != blockchainName {
+		return fmt.Errorf("deploy plan %s is for blockchain %s, not %s", deployPlanPath, plan.BlockchainName, blockchainName)
+	}
+	if plan.Network != network.Name() {
+		return fmt.Errorf("deploy plan %s is for %s, not %s", deployPlanPath, plan.Network, network.Name())
+	}
+	if plan.GenesisSHA256 != "" && plan.GenesisSHA256 != genesisSHA256(chainGenesis) {
+		return fmt.Errorf("genesis of %s changed after deploy plan %s was signed off", blockchainName, deployPlanPath)
+	}
+	printDeployPlan(plan)
+	ux.Logger.PrintToUser("Signed off at %s", plan.SignedOffAt.Format(time.RFC3339))
+	ux.Logger.PrintToUser("")
+	if err := subnet.IssueDeployPlan(network, plan); err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("")
+	if err := PrintDeployResults(blockchainName, plan.SubnetID, plan.BlockchainID); err != nil {
+		return err
+	}
+	if plan.ValidatorManagerAddress == "" {
+		return app.UpdateSidecarNetworks(
+			&sidecar,
+			network,
+			plan.SubnetID,
+			plan.BlockchainID,
+			"",
+			"",
+			nil,
+			"",
+			"",
+		)
+	}
+	sidecar.Sovereign = true
+	if plan.ValidatorManagement != "" && plan.ValidatorManagement != validatormanagertypes.UndefinedValidatorManagement {
+		sidecar.ValidatorManagement = plan.ValidatorManagement
+	}
+	if plan.ValidatorManagerOwner != "" {
+		sidecar.ValidatorManagerOwner = plan.ValidatorManagerOwner
+	}
+	if plan.ProxyContractOwner != "" {
+		sidecar.ProxyContractOwner = plan.ProxyContractOwner
+	}
+	avaGoBootstrapValidators, err := ConvertToAvalancheGoSubnetValidator(plan.BootstrapValidators)
+	if err != nil {
+		return err
+	}
+	setBootstrapValidatorValidationID(avaGoBootstrapValidators, plan.BootstrapValidators, plan.SubnetID)
+	if err := app.UpdateSidecarNetworks(
+		&sidecar,
+		network,
+		plan.SubnetID,
+		plan.BlockchainID,
+		"",
+		"",
+		plan.BootstrapValidators,
+		"",
+		plan.ValidatorManagerAddress,
+	); err != nil {
+		return err
+	}
+	ux.Logger.GreenCheckmarkToUser("Deploy plan successfully executed")
+	ux.Logger.PrintToUser("To enable the nodes to track the L1, set '%s' as the value for 'track-subnets' configuration in ~/.avalanchego/config.json", plan.SubnetID)
+	ux.Logger.PrintToUser("Once the Avalanche Node(s) are tracking the blockchain, call `avalanche contract initValidatorManager %s` to finish conversion to sovereign L1", blockchainName)
+	return nil
+}
@@ -3,9 +3,15 @@
 package blockchaincmd
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/ava-labs/avalanche-cli/cmd/flags"
+	"github.com/ava-labs/avalanche-cli/internal/testutils"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/vm"
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/stretchr/testify/require"
 )
 
@@ -78,3 +84,46 @@ func TestMutuallyExclusive(t *testing.T) {
 		}
 	}
 }
+
+func TestProbeBlockchainRPC(t *testing.T) {
+	require := require.New(t)
+	app = testutils.SetupTestInTempDir(t)
+	defer func() {
+		app = nil
+	}()
+	blockchainID := ids.GenerateTestID()
+	rpcPath := "/ext/bc/" + blockchainID.String() + "/rpc"
+	// only the L1 node serves the blockchain RPC
+	l1Node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != rpcPath {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	}))
+	defer l1Node.Close()
+	apiNode := httptest.NewServer(http.NotFoundHandler())
+	defer apiNode.Close()
+	network := models.NewNetwork(models.Devnet, 1337, apiNode.URL, "")
+	descriptor, err := vm.GetDescriptor(app, models.SubnetEvm)
+	require.NoError(err)
+
+	sc := models.Sidecar{
+		Name: "testl1",
+		VM:   models.SubnetEvm,
+		Networks: map[string]models.NetworkData{
+			network.Name(): {BlockchainID: blockchainID},
+		},
+	}
+	require.NoError(app.CreateSidecar(&sc))
+	// without saved RPC endpoints, the network endpoint is probed
+	require.Error(probeBlockchainRPC(descriptor, sc.Name, network, blockchainID))
+
+	sc.Networks[network.Name()] = models.NetworkData{
+		BlockchainID: blockchainID,
+		RPCEndpoints: []string{l1Node.URL + rpcPath},
+	}
+	require.NoError(app.UpdateSidecar(&sc))
+	require.NoError(probeBlockchainRPC(descriptor, sc.Name, network, blockchainID))
+}
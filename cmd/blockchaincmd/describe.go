@@ -39,6 +39,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// number of largest genesis holders shown on supply summaries
+const genesisTopHoldersToShow = 5
+
 var printGenesisOnly bool
 
 // avalanche blockchain describe
@@ -171,6 +174,10 @@ func PrintSubnetInfo(blockchainName string, onlyLocalnetInfo bool) error {
 		if err != nil {
 			return err
 		}
+		if descriptor, err := vm.GetDescriptor(app, sc.VM); err == nil && sc.VM != models.SubnetEvm && data.BlockchainID != ids.Empty {
+			// third party VMs serve their RPC on the path given by their descriptor
+			endpoint = strings.TrimSuffix(network.Endpoint, "/") + descriptor.RPCURLSuffix(data.BlockchainID.String())
+		}
 		if network.Kind == models.Local {
 			localEndpoint = endpoint
 		}
@@ -224,6 +231,7 @@ func PrintSubnetInfo(blockchainName string, onlyLocalnetInfo bool) error {
 		if err := printAllocations(sc, genesis); err != nil {
 			return err
 		}
+		printGenesisSupplySummary(genesis.Alloc, sc.TokenSymbol)
 		printSmartContracts(sc, genesis)
 		printPrecompiles(genesis)
 	}
@@ -325,6 +333,37 @@ func printAllocations(sc models.Sidecar, genesis core.Genesis) error {
 	return nil
 }
 
+func printGenesisSupplySummary(alloc core.GenesisAlloc, tokenSymbol string) {
+	summary := contract.GetGenesisSupplySummary(alloc, genesisTopHoldersToShow)
+	ux.Logger.PrintToUser("")
+	t := ux.DefaultTable("Genesis Supply", nil)
+	t.AppendRow(table.Row{"Holders", summary.Holders})
+	t.AppendRow(table.Row{
+		fmt.Sprintf("Total Supply (%s)", tokenSymbol),
+		new(big.Int).Div(summary.TotalSupply, big.NewInt(params.Ether)).String(),
+	})
+	t.AppendRow(table.Row{"Total Supply (wei)", summary.TotalSupply.String()})
+	ux.Logger.PrintToUser(t.Render())
+	if len(summary.TopHolders) == 0 {
+		return
+	}
+	ux.Logger.PrintToUser("")
+	t = ux.DefaultTable(
+		"Top Holders",
+		table.Row{"Address", fmt.Sprintf("Amount (%s)", tokenSymbol), "Share"},
+	)
+	for _, holder := range summary.TopHolders {
+		// share in basis points
+		share := new(big.Int).Div(new(big.Int).Mul(holder.Balance, big.NewInt(10_000)), summary.TotalSupply).Uint64()
+		t.AppendRow(table.Row{
+			holder.Address.Hex(),
+			new(big.Int).Div(holder.Balance, big.NewInt(params.Ether)).String(),
+			fmt.Sprintf("%d.%02d%%", share/100, share%100),
+		})
+	}
+	ux.Logger.PrintToUser(t.Render())
+}
+
 func printSmartContracts(sc models.Sidecar, genesis core.Genesis) {
 	if len(genesis.Alloc) == 0 {
 		return
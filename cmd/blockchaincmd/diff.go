@@ -0,0 +1,146 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package blockchaincmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/pkg/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+// configuration of a blockchain, or of a raw genesis file, to be compared
+type diffTarget struct {
+	name        string
+	genesis     []byte
+	sidecar     *models.Sidecar
+	chainConfig []byte
+}
+
+// avalanche blockchain diff
+func newDiffCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff [blockchainNameOrGenesisPath] [blockchainNameOrGenesisPath]",
+		Short: "Compare the configuration of two blockchains",
+		Long: `The blockchain diff command compares the configuration of two blockchains, or of two
+revisions of a genesis. Each argument is either the name of a blockchain configuration or the
+path to a genesis file.
+
+Subnet-EVM genesis are compared semantically: chain config, fee config, precompile configs and
+allow lists, initial allocations, and ICM and validator manager contracts. For blockchain
+configurations, sidecar settings and chain config files are also compared.`,
+		RunE: diffBlockchains,
+		Args: cobrautils.ExactArgs(2),
+	}
+}
+
+func diffBlockchains(_ *cobra.Command, args []string) error {
+	targetA, err := loadDiffTarget(args[0])
+	if err != nil {
+		return err
+	}
+	targetB, err := loadDiffTarget(args[1])
+	if err != nil {
+		return err
+	}
+	diffs := []vm.ConfigDifference{}
+	if utils.ByteSliceIsSubnetEvmGenesis(targetA.genesis) && utils.ByteSliceIsSubnetEvmGenesis(targetB.genesis) {
+		genesisDiffs, err := vm.DiffSubnetEVMGenesis(targetA.genesis, targetB.genesis)
+		if err != nil {
+			return err
+		}
+		diffs = append(diffs, genesisDiffs...)
+	} else if !bytes.Equal(targetA.genesis, targetB.genesis) {
+		diffs = append(diffs, vm.ConfigDifference{
+			Section: vm.DiffSectionGenesis,
+			Field:   "content hash",
+			A:       crypto.Keccak256Hash(targetA.genesis).Hex(),
+			B:       crypto.Keccak256Hash(targetB.genesis).Hex(),
+		})
+	}
+	if targetA.sidecar != nil && targetB.sidecar != nil {
+		sidecarDiffs, err := vm.DiffSidecars(*targetA.sidecar, *targetB.sidecar)
+		if err != nil {
+			return err
+		}
+		diffs = append(diffs, sidecarDiffs...)
+		chainConfigDiffs, err := vm.DiffJSONObjects(
+			vm.DiffSectionChainFile,
+			json.RawMessage(targetA.chainConfig),
+			json.RawMessage(targetB.chainConfig),
+		)
+		if err != nil {
+			return err
+		}
+		diffs = append(diffs, chainConfigDiffs...)
+	} else {
+		ux.Logger.PrintToUser("Sidecar and chain config file are only compared between blockchain configurations")
+	}
+	if len(diffs) == 0 {
+		ux.Logger.GreenCheckmarkToUser("No differences found between %s and %s", targetA.name, targetB.name)
+		return nil
+	}
+	t := ux.DefaultTable(
+		fmt.Sprintf("%d differences", len(diffs)),
+		table.Row{"Section", "Field", targetA.name, targetB.name},
+	)
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, AutoMerge: true},
+	})
+	for _, diff := range diffs {
+		t.AppendRow(table.Row{diff.Section, diff.Field, diff.A, diff.B})
+	}
+	ux.Logger.PrintToUser(t.Render())
+	return nil
+}
+
+// loads the configuration of blockchain [arg], or if there is no such blockchain, the genesis file at [arg]
+func loadDiffTarget(arg string) (diffTarget, error) {
+	if app.GenesisExists(arg) {
+		genesis, err := app.LoadRawGenesis(arg)
+		if err != nil {
+			return diffTarget{}, err
+		}
+		sc, err := app.LoadSidecar(arg)
+		if err != nil {
+			return diffTarget{}, err
+		}
+		chainConfig := []byte("{}")
+		if utils.FileExists(app.GetChainConfigPath(arg)) {
+			chainConfig, err = app.LoadRawChainConfig(arg)
+			if err != nil {
+				return diffTarget{}, err
+			}
+			if !json.Valid(chainConfig) {
+				return diffTarget{}, fmt.Errorf("invalid chain config file for blockchain %s", arg)
+			}
+		}
+		return diffTarget{
+			name:        arg,
+			genesis:     genesis,
+			sidecar:     &sc,
+			chainConfig: chainConfig,
+		}, nil
+	}
+	if !utils.FileExists(arg) {
+		return diffTarget{}, fmt.Errorf("%s is neither a blockchain configuration nor a genesis file", arg)
+	}
+	genesis, err := os.ReadFile(arg)
+	if err != nil {
+		return diffTarget{}, err
+	}
+	return diffTarget{
+		name:    arg,
+		genesis: genesis,
+	}, nil
+}
@@ -0,0 +1,497 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package blockchaincmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/cmd/flags"
+	"github.com/ava-labs/avalanche-cli/pkg/blockchain"
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/keychain"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/networkoptions"
+	"github.com/ava-labs/avalanche-cli/pkg/prompts"
+	"github.com/ava-labs/avalanche-cli/pkg/subnet"
+	"github.com/ava-labs/avalanche-cli/pkg/txutils"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/ids"
+	avagoconstants "github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+type BlockchainMigrateToL1Flags struct {
+	ValidatorEndpoints []string
+}
+
+var (
+	migrateToL1Flags BlockchainMigrateToL1Flags
+	// nodes may take a few blocks to accept the conversion
+	l1ValidatorSetCheckAttempts = 10
+	l1ValidatorSetCheckInterval = 3 * time.Second
+)
+
+// legacyValidatorInfo is the BLS info of a subnet validator, together with
+// where it was obtained from
+type legacyValidatorInfo struct {
+	publicKey string
+	pop       string
+	source    string
+	endpoint  string
+}
+
+// avalanche blockchain migrateToL1
+func newMigrateToL1Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrateToL1 [blockchainName]",
+		Short: "Converts a Subnet with running validators into a sovereign L1",
+		Long: `The blockchain migrateToL1 command converts a non sovereign Subnet into a sovereign L1, keeping
+the nodes that are currently validating the Subnet as the L1 bootstrap validators.
+
+The BLS public key and proof of possession of each Subnet validator are obtained from the
+info API of the nodes given by --validator-endpoints, from the Primary Network validator set
+otherwise, and are prompted to the user as a last resort. The current Subnet weights are
+proposed as L1 weights.
+
+The ConvertSubnetToL1Tx is created with the same multisig flow as blockchain convert. Once it
+is committed, the command checks that every reachable node sees the L1 validator set.
+
+If the Subnet was already converted, for example by committing a multisig ConvertSubnetToL1Tx
+elsewhere, the command only checks that the nodes given by --validator-endpoints see the
+L1 validator set of the P-Chain.`,
+		RunE:              migrateToL1,
+		PersistentPostRun: handlePostRun,
+		Args:              cobrautils.ExactArgs(1),
+	}
+	networkoptions.AddNetworkFlagsToCmd(cmd, &globalNetworkFlags, true, networkoptions.DefaultSupportedNetworkOptions)
+	cmd.Flags().StringVarP(&keyName, "key", "k", "", "select the key to use [fuji/devnet convert to l1 tx only]")
+	cmd.Flags().StringSliceVar(&subnetAuthKeys, "auth-keys", nil, "control keys that will be used to authenticate convert to L1 tx")
+	cmd.Flags().StringVar(&outputTxPath, "output-tx-path", "", "file path of the convert to L1 tx (for multi-sig)")
+	cmd.Flags().BoolVarP(&useLedger, "ledger", "g", false, "use ledger instead of key (always true on mainnet, defaults to false on fuji/devnet)")
+	cmd.Flags().StringSliceVar(&ledgerAddresses, "ledger-addrs", []string{}, "use the given ledger addresses")
+	cmd.Flags().StringSliceVar(&migrateToL1Flags.ValidatorEndpoints, "validator-endpoints", nil, "API endpoints of the Subnet validators, used to get their BLS info and to check the L1 validator set")
+	cmd.Flags().Float64Var(
+		&deployBalanceAVAX,
+		"balance",
+		float64(constants.BootstrapValidatorBalanceNanoAVAX)/float64(units.Avax),
+		"set the AVAX balance of each L1 validator that will be used for continuous fee on P-Chain",
+	)
+	cmd.Flags().StringVar(&changeOwnerAddress, "change-owner-address", "", "address that will receive change if node is no longer L1 validator")
+	cmd.Flags().BoolVar(&createFlags.proofOfAuthority, "proof-of-authority", false, "use proof of authority(PoA) for validator management")
+	cmd.Flags().BoolVar(&createFlags.proofOfStake, "proof-of-stake", false, "use proof of stake(PoS) for validator management")
+	cmd.Flags().StringVar(&createFlags.validatorManagerOwner, "validator-manager-owner", "", "EVM address that controls Validator Manager Owner")
+	cmd.Flags().StringVar(&createFlags.proxyContractOwner, "proxy-contract-owner", "", "EVM address that controls ProxyAdmin for TransparentProxy of ValidatorManager contract")
+	cmd.Flags().StringVar(&validatorManagerAddress, "validator-manager-address", "", "validator manager address")
+	cmd.Flags().BoolVar(&doStrongInputChecks, "verify-input", true, "check for input confirmation")
+	return cmd
+}
+
+// migrateToL1 is the cobra command run for converting subnets with running validators into sovereign L1s
+func migrateToL1(_ *cobra.Command, args []string) error {
+	blockchainName := args[0]
+
+	chains, err := ValidateSubnetNameAndGetChains(args)
+	if err != nil {
+		return err
+	}
+	chain := chains[0]
+
+	sidecar, err := app.LoadSidecar(chain)
+	if err != nil {
+		return fmt.Errorf("failed to load sidecar for later update: %w", err)
+	}
+
+	if outputTxPath != "" {
+		if _, err := os.Stat(outputTxPath); err == nil {
+			return fmt.Errorf("outputTxPath %q already exists", outputTxPath)
+		}
+	}
+	if !flags.EnsureMutuallyExclusive([]bool{createFlags.proofOfAuthority, createFlags.proofOfStake}) {
+		return errMutuallyExlusiveValidatorManagementOptions
+	}
+
+	network, err := networkoptions.GetNetworkFromCmdLineFlags(
+		app,
+		"",
+		globalNetworkFlags,
+		true,
+		false,
+		networkoptions.GetNetworkFromSidecar(sidecar, networkoptions.DefaultSupportedNetworkOptions),
+		"",
+	)
+	if err != nil {
+		return err
+	}
+	clusterNameFlagValue = globalNetworkFlags.ClusterName
+
+	subnetID := sidecar.Networks[network.Name()].SubnetID
+	blockchainID := sidecar.Networks[network.Name()].BlockchainID
+	if subnetID == ids.Empty {
+		return constants.ErrNoSubnetID
+	}
+	if blockchainID == ids.Empty {
+		return constants.ErrNoBlockchainID
+	}
+
+	subnetInfo, err := blockchain.GetSubnet(subnetID, network)
+	if err != nil {
+		return fmt.Errorf("failed to get subnet %s: %w", subnetID, err)
+	}
+	if subnetInfo.ConversionID != ids.Empty {
+		ux.Logger.PrintToUser("Subnet %s has already been converted into a sovereign L1", subnetID)
+		if len(migrateToL1Flags.ValidatorEndpoints) == 0 {
+			return fmt.Errorf("use --validator-endpoints to check the L1 validator set seen by the nodes")
+		}
+		expected, err := getL1ValidatorSet(network.Endpoint, subnetID)
+		if err != nil {
+			return err
+		}
+		return verifyL1ValidatorSet(subnetID, expected, migrateToL1Flags.ValidatorEndpoints)
+	}
+
+	legacyValidators, err := subnet.GetPublicSubnetValidators(subnetID, network)
+	if err != nil {
+		return err
+	}
+	if len(legacyValidators) == 0 {
+		return fmt.Errorf("subnet %s has no validators. Use avalanche blockchain convert to provide bootstrap validators", subnetID)
+	}
+	ux.Logger.PrintToUser("Subnet %s is currently validated by %d nodes", subnetID, len(legacyValidators))
+	ux.Logger.PrintToUser("")
+
+	if validatorManagerAddress == "" {
+		validatorManagerAddressAddrFmt, err := app.Prompt.CaptureAddress("What is the address of the Validator Manager?")
+		if err != nil {
+			return err
+		}
+		validatorManagerAddress = validatorManagerAddressAddrFmt.String()
+	}
+	if err = promptValidatorManagementType(app, &sidecar); err != nil {
+		return err
+	}
+	if err := setSidecarValidatorManageOwner(&sidecar, createFlags); err != nil {
+		return err
+	}
+	sidecar.UpdateValidatorManagerAddress(network.Name(), validatorManagerAddress)
+	sidecar.Sovereign = true
+
+	kc, err := keychain.GetKeychainFromCmdLineFlags(
+		app,
+		constants.PayTxsFeesMsg,
+		network,
+		keyName,
+		useEwoq,
+		useLedger,
+		ledgerAddresses,
+		0,
+	)
+	if err != nil {
+		return err
+	}
+	availableBalance, err := utils.GetNetworkBalance(kc.Addresses().List(), network.Endpoint)
+	if err != nil {
+		return err
+	}
+	if changeOwnerAddress == "" {
+		// use provided key as change owner unless already set
+		if pAddr, err := kc.PChainFormattedStrAddresses(); err == nil && len(pAddr) > 0 {
+			changeOwnerAddress = pAddr[0]
+			ux.Logger.PrintToUser("Using [%s] to be set as a change owner for leftover AVAX", changeOwnerAddress)
+		}
+	}
+
+	nodeIDs := utils.Map(legacyValidators, func(v platformvm.ClientPermissionlessValidator) ids.NodeID { return v.NodeID })
+	validatorsInfo, err := getLegacyValidatorsBLSInfo(network, nodeIDs, migrateToL1Flags.ValidatorEndpoints)
+	if err != nil {
+		return err
+	}
+
+	deployBalance := uint64(deployBalanceAVAX * float64(units.Avax))
+	bootstrapValidators := make([]models.SubnetValidator, 0, len(legacyValidators))
+	for _, legacyValidator := range legacyValidators {
+		validatorInfo := validatorsInfo[legacyValidator.NodeID]
+		bootstrapValidators = append(bootstrapValidators, models.SubnetValidator{
+			NodeID:               legacyValidator.NodeID.String(),
+			Weight:               legacyValidator.Weight,
+			Balance:              deployBalance,
+			BLSPublicKey:         validatorInfo.publicKey,
+			BLSProofOfPossession: validatorInfo.pop,
+			ChangeOwnerAddr:      changeOwnerAddress,
+		})
+	}
+	if err := proposeL1Weights(bootstrapValidators, validatorsInfo); err != nil {
+		return err
+	}
+
+	requiredBalance := deployBalance * uint64(len(bootstrapValidators))
+	if availableBalance < requiredBalance {
+		return fmt.Errorf(
+			"required balance for %d validators dynamic fee on PChain is %d but the given key has %d",
+			len(bootstrapValidators),
+			requiredBalance,
+			availableBalance,
+		)
+	}
+
+	kcKeys, err := kc.PChainFormattedStrAddresses()
+	if err != nil {
+		return err
+	}
+	// get keys for convertL1 tx signing
+	_, controlKeys, threshold, err = txutils.GetOwners(network, subnetID)
+	if err != nil {
+		return err
+	}
+	if subnetAuthKeys != nil {
+		if err := prompts.CheckSubnetAuthKeys(kcKeys, subnetAuthKeys, controlKeys, threshold); err != nil {
+			return err
+		}
+	} else {
+		subnetAuthKeys, err = prompts.GetSubnetAuthKeys(app.Prompt, kcKeys, controlKeys, threshold)
+		if err != nil {
+			return err
+		}
+	}
+	ux.Logger.PrintToUser("Your auth keys for convert to L1 tx creation: %s", subnetAuthKeys)
+
+	deployer := subnet.NewPublicDeployer(app, kc, network)
+	_, cancel, savePartialTx, err := convertSubnetToL1(
+		bootstrapValidators,
+		deployer,
+		subnetID,
+		blockchainID,
+		network,
+		chain,
+		sidecar,
+		controlKeys,
+		subnetAuthKeys,
+		validatorManagerAddress,
+		doStrongInputChecks,
+	)
+	if err != nil {
+		return err
+	}
+	if cancel {
+		return nil
+	}
+	if savePartialTx {
+		ux.Logger.PrintToUser(
+			"Once the transaction is committed, call `avalanche blockchain migrateToL1 %s --validator-endpoints <endpoints>` to check the L1 validator set seen by the nodes",
+			blockchainName,
+		)
+		return nil
+	}
+
+	expected := map[string]uint64{}
+	endpoints := []string{network.Endpoint}
+	for _, bootstrapValidator := range bootstrapValidators {
+		expected[bootstrapValidator.NodeID] = bootstrapValidator.Weight
+		nodeID, err := ids.NodeIDFromString(bootstrapValidator.NodeID)
+		if err != nil {
+			return err
+		}
+		if endpoint := validatorsInfo[nodeID].endpoint; endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		} else {
+			ux.Logger.PrintToUser("Node %s has no known endpoint. Skipping its L1 validator set check", nodeID)
+		}
+	}
+	if err := verifyL1ValidatorSet(subnetID, expected, endpoints); err != nil {
+		return err
+	}
+
+	ux.Logger.PrintToUser("")
+	ux.Logger.GreenCheckmarkToUser("Subnet is successfully converted to sovereign L1")
+	ux.Logger.PrintToUser("Call `avalanche contract initValidatorManager %s` to finish the migration to sovereign L1", blockchainName)
+	return nil
+}
+
+// getLegacyValidatorsBLSInfo gets the BLS public key and proof of possession of [nodeIDs], from the
+// info API of [endpoints] if the nodes are reachable, from the Primary Network validator set otherwise,
+// and prompting the user as a last resort
+func getLegacyValidatorsBLSInfo(
+	network models.Network,
+	nodeIDs []ids.NodeID,
+	endpoints []string,
+) (map[ids.NodeID]legacyValidatorInfo, error) {
+	validatorsInfo := map[ids.NodeID]legacyValidatorInfo{}
+	isValidator := map[ids.NodeID]bool{}
+	for _, nodeID := range nodeIDs {
+		isValidator[nodeID] = true
+	}
+	for _, endpoint := range endpoints {
+		infoClient := info.NewClient(endpoint)
+		ctx, cancel := utils.GetAPILargeContext()
+		nodeID, proofOfPossession, err := infoClient.GetNodeID(ctx)
+		cancel()
+		if err != nil {
+			ux.Logger.RedXToUser("could not reach node at %s: %s", endpoint, err)
+			continue
+		}
+		if !isValidator[nodeID] {
+			ux.Logger.RedXToUser("node %s at %s is not a subnet validator", nodeID, endpoint)
+			continue
+		}
+		validatorsInfo[nodeID] = newLegacyValidatorInfo(proofOfPossession, "info API", endpoint)
+	}
+	missing := utils.Filter(nodeIDs, func(nodeID ids.NodeID) bool {
+		_, ok := validatorsInfo[nodeID]
+		return !ok
+	})
+	if len(missing) > 0 {
+		pClient := platformvm.NewClient(network.Endpoint)
+		ctx, cancel := utils.GetAPIContext()
+		defer cancel()
+		primaryValidators, err := pClient.GetCurrentValidators(ctx, avagoconstants.PrimaryNetworkID, missing)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get primary network validators: %w", err)
+		}
+		for _, primaryValidator := range primaryValidators {
+			if primaryValidator.Signer != nil {
+				validatorsInfo[primaryValidator.NodeID] = newLegacyValidatorInfo(primaryValidator.Signer, "Primary Network", "")
+			}
+		}
+	}
+	for _, nodeID := range nodeIDs {
+		if _, ok := validatorsInfo[nodeID]; ok {
+			continue
+		}
+		ux.Logger.PrintToUser("Could not get BLS info for node %s", nodeID)
+		publicKey, pop, err := promptProofOfPossession(true, true)
+		if err != nil {
+			return nil, err
+		}
+		validatorsInfo[nodeID] = legacyValidatorInfo{
+			publicKey: publicKey,
+			pop:       pop,
+			source:    "user input",
+		}
+	}
+	return validatorsInfo, nil
+}
+
+func newLegacyValidatorInfo(proofOfPossession *signer.ProofOfPossession, source string, endpoint string) legacyValidatorInfo {
+	return legacyValidatorInfo{
+		publicKey: "0x" + hex.EncodeToString(proofOfPossession.PublicKey[:]),
+		pop:       "0x" + hex.EncodeToString(proofOfPossession.ProofOfPossession[:]),
+		source:    source,
+		endpoint:  endpoint,
+	}
+}
+
+// proposeL1Weights shows the current subnet weights as the proposed L1 weights
+// of [bootstrapValidators], and lets the user change them
+func proposeL1Weights(
+	bootstrapValidators []models.SubnetValidator,
+	validatorsInfo map[ids.NodeID]legacyValidatorInfo,
+) error {
+	t := ux.DefaultTable("Proposed L1 Validators", table.Row{"Node ID", "Weight", "BLS Info Source"})
+	for _, bootstrapValidator := range bootstrapValidators {
+		nodeID, err := ids.NodeIDFromString(bootstrapValidator.NodeID)
+		if err != nil {
+			return err
+		}
+		t.AppendRow(table.Row{bootstrapValidator.NodeID, bootstrapValidator.Weight, validatorsInfo[nodeID].source})
+	}
+	ux.Logger.PrintToUser(t.Render())
+	if !doStrongInputChecks {
+		return nil
+	}
+	if acceptWeights, err := app.Prompt.CaptureYesNo("Do you want to keep the current subnet weights for the L1 validators?"); err != nil {
+		return err
+	} else if acceptWeights {
+		return nil
+	}
+	for i := range bootstrapValidators {
+		weight, err := app.Prompt.CaptureWeight(
+			fmt.Sprintf("What weight would you like to assign to validator %s?", bootstrapValidators[i].NodeID),
+			func(uint64) error { return nil },
+		)
+		if err != nil {
+			return err
+		}
+		bootstrapValidators[i].Weight = weight
+	}
+	return nil
+}
+
+// verifyL1ValidatorSet checks that the P-Chain of every node at [endpoints] sees [expected]
+// as the validator set of [subnetID], retrying for the nodes that did not accept the conversion yet
+func verifyL1ValidatorSet(
+	subnetID ids.ID,
+	expected map[string]uint64,
+	endpoints []string,
+) error {
+	mismatches := 0
+	for _, endpoint := range endpoints {
+		var err error
+		for attempt := 0; attempt < l1ValidatorSetCheckAttempts; attempt++ {
+			if attempt > 0 {
+				time.Sleep(l1ValidatorSetCheckInterval)
+			}
+			if err = checkL1ValidatorSet(endpoint, subnetID, expected); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			ux.Logger.RedXToUser("%s: %s", endpoint, err)
+			mismatches++
+			continue
+		}
+		ux.Logger.GreenCheckmarkToUser("%s sees the L1 validator set", endpoint)
+	}
+	if mismatches > 0 {
+		return fmt.Errorf("%d of %d endpoints do not see the L1 validator set", mismatches, len(endpoints))
+	}
+	return nil
+}
+
+// getL1ValidatorSet returns the weight of each validator of [subnetID], as seen by the
+// P-Chain at [endpoint]
+func getL1ValidatorSet(endpoint string, subnetID ids.ID) (map[string]uint64, error) {
+	pClient := platformvm.NewClient(endpoint)
+	ctx, cancel := utils.GetAPIContext()
+	defer cancel()
+	validators, err := pClient.GetCurrentValidators(ctx, subnetID, nil)
+	if err != nil {
+		return nil, err
+	}
+	validatorSet := map[string]uint64{}
+	for _, validator := range validators {
+		validatorSet[validator.NodeID.String()] = validator.Weight
+	}
+	return validatorSet, nil
+}
+
+// checkL1ValidatorSet checks that the P-Chain of the node at [endpoint] sees
+// [expected] as the validator set of [subnetID]
+func checkL1ValidatorSet(endpoint string, subnetID ids.ID, expected map[string]uint64) error {
+	validatorSet, err := getL1ValidatorSet(endpoint, subnetID)
+	if err != nil {
+		return err
+	}
+	if len(validatorSet) != len(expected) {
+		return fmt.Errorf("expected %d L1 validators, found %d", len(expected), len(validatorSet))
+	}
+	for nodeID, weight := range validatorSet {
+		expectedWeight, ok := expected[nodeID]
+		if !ok {
+			return fmt.Errorf("unexpected validator %s", nodeID)
+		}
+		if weight != expectedWeight {
+			return fmt.Errorf("expected weight %d for validator %s, found %d", expectedWeight, nodeID, weight)
+		}
+	}
+	return nil
+}
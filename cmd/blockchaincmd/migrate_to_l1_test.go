@@ -0,0 +1,281 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package blockchaincmd
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/internal/mocks"
+	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	platformapi "github.com/ava-labs/avalanchego/vms/platformvm/api"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// testNodeAPI serves the info and P-Chain APIs of a node, with the given node ID and
+// proof of possession, and the given validator sets
+type testNodeAPI struct {
+	lock              sync.Mutex
+	nodeID            ids.NodeID
+	proofOfPossession *signer.ProofOfPossession
+	validators        map[ids.ID][]platformapi.PermissionlessValidator
+	validatorsCalls   int
+}
+
+func (n *testNodeAPI) setValidators(subnetID ids.ID, validators []platformapi.PermissionlessValidator) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.validators[subnetID] = validators
+}
+
+func (n *testNodeAPI) getValidatorsCalls() int {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.validatorsCalls
+}
+
+func (n *testNodeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	var request struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var result interface{}
+	switch {
+	case r.URL.Path == "/ext/info" && request.Method == "info.getNodeID":
+		result = info.GetNodeIDReply{
+			NodeID:  n.nodeID,
+			NodePOP: n.proofOfPossession,
+		}
+	case r.URL.Path == "/ext/P" && request.Method == "platform.getCurrentValidators":
+		n.validatorsCalls++
+		var args platformvm.GetCurrentValidatorsArgs
+		if err := json.Unmarshal(request.Params, &args); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		validators := []interface{}{}
+		for _, validator := range n.validators[args.SubnetID] {
+			requested := len(args.NodeIDs) == 0
+			for _, nodeID := range args.NodeIDs {
+				requested = requested || nodeID == validator.NodeID
+			}
+			if requested {
+				validators = append(validators, validator)
+			}
+		}
+		result = platformvm.GetCurrentValidatorsReply{Validators: validators}
+	default:
+		http.Error(w, "unexpected request", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      request.ID,
+		"result":  result,
+	})
+}
+
+func newTestNodeAPI(t *testing.T) (*testNodeAPI, string) {
+	blsSigner, err := localsigner.New()
+	require.NoError(t, err)
+	proofOfPossession, err := signer.NewProofOfPossession(blsSigner)
+	require.NoError(t, err)
+	nodeAPI := &testNodeAPI{
+		nodeID:            ids.GenerateTestNodeID(),
+		proofOfPossession: proofOfPossession,
+		validators:        map[ids.ID][]platformapi.PermissionlessValidator{},
+	}
+	server := httptest.NewServer(nodeAPI)
+	t.Cleanup(server.Close)
+	return nodeAPI, server.URL
+}
+
+func newTestValidator(nodeID ids.NodeID, weight uint64, proofOfPossession *signer.ProofOfPossession) platformapi.PermissionlessValidator {
+	return platformapi.PermissionlessValidator{
+		Staker: platformapi.Staker{
+			TxID:   ids.GenerateTestID(),
+			NodeID: nodeID,
+			Weight: avajson.Uint64(weight),
+		},
+		Signer: proofOfPossession,
+	}
+}
+
+func TestGetLegacyValidatorsBLSInfo(t *testing.T) {
+	require := require.New(t)
+	ux.NewUserLog(logging.NoLog{}, io.Discard)
+	reachableNode, reachableEndpoint := newTestNodeAPI(t)
+	nonValidatorNode, nonValidatorEndpoint := newTestNodeAPI(t)
+	primaryNetworkNode, _ := newTestNodeAPI(t)
+	pChain, pChainEndpoint := newTestNodeAPI(t)
+	// primary network validators are listed by the P-Chain, together with their BLS info
+	pChain.setValidators(ids.Empty, []platformapi.PermissionlessValidator{
+		newTestValidator(reachableNode.nodeID, 20, reachableNode.proofOfPossession),
+		newTestValidator(nonValidatorNode.nodeID, 20, nonValidatorNode.proofOfPossession),
+		newTestValidator(primaryNetworkNode.nodeID, 20, primaryNetworkNode.proofOfPossession),
+	})
+	unknownNodeID := ids.GenerateTestNodeID()
+	unreachableServer := httptest.NewServer(http.NotFoundHandler())
+	unreachableEndpoint := unreachableServer.URL
+	unreachableServer.Close()
+
+	prompter := mocks.NewPrompter(t)
+	prompter.On("CaptureValidatedString", "What is the node's BLS public key?", mock.Anything).Return("0x1234", nil).Once()
+	prompter.On("CaptureValidatedString", "What is the node's BLS proof of possession?", mock.Anything).Return("0x5678", nil).Once()
+	app = application.New()
+	app.Prompt = prompter
+	defer func() {
+		app = nil
+	}()
+
+	network := models.NewNetwork(models.Devnet, 1337, pChainEndpoint, "")
+	validatorsInfo, err := getLegacyValidatorsBLSInfo(
+		network,
+		[]ids.NodeID{reachableNode.nodeID, primaryNetworkNode.nodeID, unknownNodeID},
+		[]string{reachableEndpoint, nonValidatorEndpoint, unreachableEndpoint},
+	)
+	require.NoError(err)
+	require.Equal(map[ids.NodeID]legacyValidatorInfo{
+		reachableNode.nodeID: {
+			publicKey: "0x" + hex.EncodeToString(reachableNode.proofOfPossession.PublicKey[:]),
+			pop:       "0x" + hex.EncodeToString(reachableNode.proofOfPossession.ProofOfPossession[:]),
+			source:    "info API",
+			endpoint:  reachableEndpoint,
+		},
+		primaryNetworkNode.nodeID: {
+			publicKey: "0x" + hex.EncodeToString(primaryNetworkNode.proofOfPossession.PublicKey[:]),
+			pop:       "0x" + hex.EncodeToString(primaryNetworkNode.proofOfPossession.ProofOfPossession[:]),
+			source:    "Primary Network",
+		},
+		unknownNodeID: {
+			publicKey: "0x1234",
+			pop:       "0x5678",
+			source:    "user input",
+		},
+	}, validatorsInfo)
+}
+
+func TestCheckL1ValidatorSet(t *testing.T) {
+	ux.NewUserLog(logging.NoLog{}, io.Discard)
+	subnetID := ids.GenerateTestID()
+	nodeID1 := ids.GenerateTestNodeID()
+	nodeID2 := ids.GenerateTestNodeID()
+	expected := map[string]uint64{
+		nodeID1.String(): 20,
+		nodeID2.String(): 30,
+	}
+	tests := []struct {
+		name        string
+		validators  []platformapi.PermissionlessValidator
+		errContains string
+	}{
+		{
+			name: "matching",
+			validators: []platformapi.PermissionlessValidator{
+				newTestValidator(nodeID1, 20, nil),
+				newTestValidator(nodeID2, 30, nil),
+			},
+		},
+		{
+			name:        "not converted",
+			errContains: "expected 2 L1 validators, found 0",
+		},
+		{
+			name: "missing validator",
+			validators: []platformapi.PermissionlessValidator{
+				newTestValidator(nodeID1, 20, nil),
+			},
+			errContains: "expected 2 L1 validators, found 1",
+		},
+		{
+			name: "unexpected validator",
+			validators: []platformapi.PermissionlessValidator{
+				newTestValidator(nodeID1, 20, nil),
+				newTestValidator(ids.GenerateTestNodeID(), 30, nil),
+			},
+			errContains: "unexpected validator",
+		},
+		{
+			name: "weight mismatch",
+			validators: []platformapi.PermissionlessValidator{
+				newTestValidator(nodeID1, 20, nil),
+				newTestValidator(nodeID2, 10, nil),
+			},
+			errContains: "expected weight 30 for validator " + nodeID2.String() + ", found 10",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			nodeAPI, endpoint := newTestNodeAPI(t)
+			nodeAPI.setValidators(subnetID, tt.validators)
+			err := checkL1ValidatorSet(endpoint, subnetID, expected)
+			if tt.errContains != "" {
+				require.ErrorContains(err, tt.errContains)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+}
+
+func TestVerifyL1ValidatorSet(t *testing.T) {
+	require := require.New(t)
+	ux.NewUserLog(logging.NoLog{}, io.Discard)
+	defer func(attempts int, interval time.Duration) {
+		l1ValidatorSetCheckAttempts = attempts
+		l1ValidatorSetCheckInterval = interval
+	}(l1ValidatorSetCheckAttempts, l1ValidatorSetCheckInterval)
+	l1ValidatorSetCheckAttempts = 5
+	l1ValidatorSetCheckInterval = 20 * time.Millisecond
+
+	subnetID := ids.GenerateTestID()
+	nodeID := ids.GenerateTestNodeID()
+	validators := []platformapi.PermissionlessValidator{newTestValidator(nodeID, 20, nil)}
+	pChain, pChainEndpoint := newTestNodeAPI(t)
+	pChain.setValidators(subnetID, validators)
+	expected, err := getL1ValidatorSet(pChainEndpoint, subnetID)
+	require.NoError(err)
+	require.Equal(map[string]uint64{nodeID.String(): 20}, expected)
+
+	// a node that accepts the conversion late is retried
+	lateNode, lateEndpoint := newTestNodeAPI(t)
+	go func() {
+		time.Sleep(2 * l1ValidatorSetCheckInterval)
+		lateNode.setValidators(subnetID, validators)
+	}()
+	require.NoError(verifyL1ValidatorSet(subnetID, expected, []string{pChainEndpoint, lateEndpoint}))
+	// the P-Chain was already queried for the expected set, and saw it on the first check
+	require.Equal(2, pChain.getValidatorsCalls())
+	require.Greater(lateNode.getValidatorsCalls(), 1)
+
+	// a node that never accepts it fails after a bounded number of attempts
+	staleNode, staleEndpoint := newTestNodeAPI(t)
+	err = verifyL1ValidatorSet(subnetID, expected, []string{pChainEndpoint, staleEndpoint})
+	require.ErrorContains(err, "1 of 2 endpoints do not see the L1 validator set")
+	require.Equal(l1ValidatorSetCheckAttempts, staleNode.getValidatorsCalls())
+}
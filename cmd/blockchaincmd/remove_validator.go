@@ -440,6 +440,9 @@ func removeValidatorSOV(
 		return err
 	}
 
+	if sc.PoS() && sc.StakingTokenAddress != "" {
+		ux.Logger.PrintToUser("ERC20 stake on %s, and rewards if eligible, were sent to the validator owner", sc.StakingTokenAddress)
+	}
 	ux.Logger.GreenCheckmarkToUser("Validator successfully removed from the Subnet")
 
 	return nil
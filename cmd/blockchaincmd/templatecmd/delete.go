@@ -0,0 +1,30 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package templatecmd
+
+import (
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/pkg/vm"
+	"github.com/spf13/cobra"
+)
+
+// avalanche blockchain template delete
+func newDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete [templateName]",
+		Short: "Delete a user genesis template",
+		Long:  "The blockchain template delete command deletes a user genesis template. Bundled templates can't be deleted.",
+		RunE:  deleteTemplate,
+		Args:  cobrautils.ExactArgs(1),
+	}
+}
+
+func deleteTemplate(_ *cobra.Command, args []string) error {
+	templateName := args[0]
+	if err := vm.DeleteGenesisTemplate(app, templateName); err != nil {
+		return err
+	}
+	ux.Logger.GreenCheckmarkToUser("Template %s deleted", templateName)
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package templatecmd
+
+import (
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/pkg/vm"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+// avalanche blockchain template list
+func newListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the available genesis templates",
+		Long:  "The blockchain template list command prints all bundled and user genesis templates.",
+		RunE:  listTemplates,
+		Args:  cobrautils.ExactArgs(0),
+	}
+}
+
+func listTemplates(_ *cobra.Command, _ []string) error {
+	templates, err := vm.GetGenesisTemplates(app)
+	if err != nil {
+		return err
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Name", "Kind", "Token", "VM Version", "Description"})
+	for _, template := range templates {
+		kind := "user"
+		if template.Bundled {
+			kind = "bundled"
+		}
+		vmVersion := template.VMVersion
+		if vmVersion == "" {
+			vmVersion = "-"
+		}
+		t.AppendRow(table.Row{template.Name, kind, template.TokenSymbol, vmVersion, template.Description})
+	}
+	ux.Logger.PrintToUser(t.Render())
+	return nil
+}
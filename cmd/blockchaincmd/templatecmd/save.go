@@ -0,0 +1,50 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package templatecmd
+
+import (
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/pkg/vm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	description string
+	force       bool
+)
+
+// avalanche blockchain template save
+func newSaveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "save [blockchainName] [templateName]",
+		Short: "Save the genesis of a blockchain as a template",
+		Long: `The blockchain template save command creates a user genesis template out of the
+genesis and configuration of an existing Subnet-EVM blockchain.
+
+The chain ID is not saved, as each blockchain created from the template needs its own.
+Allocations are kept, and can be replaced when using the template.`,
+		RunE: saveTemplate,
+		Args: cobrautils.ExactArgs(2),
+	}
+	cmd.Flags().StringVar(&description, "description", "", "description of the template")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "overwrite an existing user template with the same name")
+	return cmd
+}
+
+func saveTemplate(_ *cobra.Command, args []string) error {
+	blockchainName := args[0]
+	templateName := args[1]
+	if err := vm.ValidateGenesisTemplateName(templateName); err != nil {
+		return err
+	}
+	template, err := vm.NewGenesisTemplateFromBlockchain(app, blockchainName, templateName, description)
+	if err != nil {
+		return err
+	}
+	if err := vm.SaveGenesisTemplate(app, template, force); err != nil {
+		return err
+	}
+	ux.Logger.GreenCheckmarkToUser("Template %s saved from blockchain %s", templateName, blockchainName)
+	return nil
+}
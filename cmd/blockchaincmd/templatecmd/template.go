@@ -0,0 +1,33 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package templatecmd
+
+import (
+	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/spf13/cobra"
+)
+
+var app *application.Avalanche
+
+// avalanche blockchain template
+func NewCmd(injectedApp *application.Avalanche) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template",
+		Short: "Manage genesis templates",
+		Long: `The blockchain template command suite manages the named genesis templates that can be
+used to create new blockchains with 'avalanche blockchain create --template'.
+
+Bundled templates are shipped with the CLI. User templates are saved from the genesis
+and configuration of existing blockchains.`,
+		RunE: cobrautils.CommandSuiteUsage,
+	}
+	app = injectedApp
+	// blockchain template list
+	cmd.AddCommand(newListCmd())
+	// blockchain template save
+	cmd.AddCommand(newSaveCmd())
+	// blockchain template delete
+	cmd.AddCommand(newDeleteCmd())
+	return cmd
+}
@@ -8,7 +8,6 @@ import (
 
 	"github.com/ava-labs/avalanche-cli/pkg/binutils"
 	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
-	"github.com/ava-labs/avalanche-cli/pkg/constants"
 	"github.com/ava-labs/avalanche-cli/pkg/models"
 	"github.com/ava-labs/avalanche-cli/pkg/plugins"
 	"github.com/ava-labs/avalanche-cli/pkg/subnet"
@@ -126,8 +125,12 @@ func upgradeVM(_ *cobra.Command, args []string) error {
 	}
 
 	vmType := sc.VM
-	if vmType == models.SubnetEvm {
-		return selectUpdateOption(vmType, sc, networkToUpgrade)
+	descriptor, err := vm.GetDescriptor(app, vmType)
+	if err != nil && !errors.Is(err, vm.ErrDescriptorNotFound) {
+		return err
+	}
+	if descriptor != nil {
+		return selectUpdateOption(descriptor, sc, networkToUpgrade)
 	}
 
 	// Must be a custom update
@@ -186,10 +189,10 @@ func selectNetworkToUpgrade(sc models.Sidecar, upgradeOptions []string) (string,
 	return selectedDeployment, nil
 }
 
-func selectUpdateOption(vmType models.VMType, sc models.Sidecar, networkToUpgrade string) error {
+func selectUpdateOption(descriptor vm.Descriptor, sc models.Sidecar, networkToUpgrade string) error {
 	switch {
 	case useLatest:
-		return updateToLatestVersion(vmType, sc, networkToUpgrade)
+		return updateToLatestVersion(descriptor, sc, networkToUpgrade)
 	case targetVersion != "":
 		return updateToSpecificVersion(sc, networkToUpgrade)
 	case binaryPathArg != "":
@@ -210,7 +213,7 @@ func selectUpdateOption(vmType models.VMType, sc models.Sidecar, networkToUpgrad
 
 	switch updateDecision {
 	case latestVersionUpdate:
-		return updateToLatestVersion(vmType, sc, networkToUpgrade)
+		return updateToLatestVersion(descriptor, sc, networkToUpgrade)
 	case specificVersionUpdate:
 		return updateToSpecificVersion(sc, networkToUpgrade)
 	case customBinaryUpdate:
@@ -220,14 +223,15 @@ func selectUpdateOption(vmType models.VMType, sc models.Sidecar, networkToUpgrad
 	}
 }
 
-func updateToLatestVersion(vmType models.VMType, sc models.Sidecar, networkToUpgrade string) error {
+func updateToLatestVersion(descriptor vm.Descriptor, sc models.Sidecar, networkToUpgrade string) error {
 	// pull in current version
 	currentVersion := sc.VMVersion
 
 	// check latest version
+	org, repo := descriptor.Repo()
 	latestVersion, err := app.Downloader.GetLatestReleaseVersion(
-		constants.AvaLabsOrg,
-		vmType.RepoName(),
+		org,
+		repo,
 		"",
 	)
 	if err != nil {
@@ -266,6 +270,23 @@ func updateToSpecificVersion(sc models.Sidecar, networkToUpgrade string) error {
 }
 
 func updateVMByNetwork(sc models.Sidecar, targetVersion string, networkToUpgrade string) error {
+	// VMs registered through descriptor manifests run from the blockchain custom VM
+	// binary, so the target release is installed there before upgrading
+	if targetVersion != "" && sc.VM != models.SubnetEvm && sc.VM != models.CustomVM {
+		descriptor, err := vm.GetDescriptor(app, sc.VM)
+		if err != nil {
+			return err
+		}
+		targetVersion, sc.RPCVersion, err = vm.InstallDescriptorVMRelease(app, descriptor, sc.Name, targetVersion)
+		if err != nil {
+			return err
+		}
+	}
+	if sc.VM == models.CustomVM {
+		if err := vm.VerifyCustomVMBinary(sc, app.GetCustomVMPath(sc.Name)); err != nil {
+			return err
+		}
+	}
 	switch networkToUpgrade {
 	case futureDeployment:
 		return updateFutureVM(sc, targetVersion)
@@ -289,6 +310,13 @@ func updateToCustomBin(sc models.Sidecar, networkToUpgrade, binaryPath string, u
 		}
 	}
 
+	// a pinned custom VM build can only be replaced by a binary reproducing it
+	if sc.VM == models.CustomVM {
+		if err := vm.VerifyCustomVMBinary(sc, binaryPath); err != nil {
+			return fmt.Errorf("%w. Use 'avalanche blockchain vm rebuild %s --commit <commit>' to pin a new build", err, sc.Name)
+		}
+	}
+
 	if err := app.CopyVMBinary(binaryPath, sc.Name); err != nil {
 		return err
 	}
@@ -320,20 +348,9 @@ func updateExistingLocalVM(sc models.Sidecar, targetVersion string) error {
 	if err != nil {
 		return err
 	}
-	var vmBin string
-	switch sc.VM {
-	// download the binary and prepare to copy it
-	case models.SubnetEvm:
-		_, vmBin, err = binutils.SetupSubnetEVM(app, targetVersion)
-		if err != nil {
-			return fmt.Errorf("failed to install subnet-evm: %w", err)
-		}
-	case models.CustomVM:
-		// get the path to the already copied binary
-		vmBin = binutils.SetupCustomBin(app, sc.Name)
-
-	default:
-		return errors.New("unknown VM type " + string(sc.VM))
+	vmBin, err := vm.SetupVMBinary(app, sc.VM, sc.Name, targetVersion)
+	if err != nil {
+		return err
 	}
 
 	rpcVersion, err := vm.GetVMBinaryProtocolVersion(vmBin)
@@ -0,0 +1,170 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatormanagercmd
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanche-cli/cmd/flags"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/networkoptions"
+	"github.com/ava-labs/avalanche-cli/pkg/prompts"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/sdk/evm"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	globalNetworkFlags   networkoptions.NetworkFlags
+	rpcURL               string
+	externalEVMSignature bool
+)
+
+// addTxFlags adds the flags shared by all commands that issue a tx to the L1
+func addTxFlags(cmd *cobra.Command) {
+	networkoptions.AddNetworkFlagsToCmd(cmd, &globalNetworkFlags, true, networkoptions.DefaultSupportedNetworkOptions)
+	flags.AddRPCFlagToCmd(cmd, app, &rpcURL)
+	cmd.Flags().BoolVar(&externalEVMSignature, "external-evm-signature", false, "set this value to true when signing the tx outside of cli (for multisig or ledger)")
+}
+
+// l1Context is the sidecar, network and rpc endpoint of the L1 a command operates on
+type l1Context struct {
+	sc      models.Sidecar
+	network models.Network
+	rpcURL  string
+	// address of the validator manager, as seen by the validators (that is, the proxy if any)
+	validatorManagerAddress common.Address
+}
+
+func loadL1Context(blockchainName string) (l1Context, error) {
+	sc, err := app.LoadSidecar(blockchainName)
+	if err != nil {
+		return l1Context{}, fmt.Errorf("failed to load sidecar: %w", err)
+	}
+	if !sc.Sovereign {
+		return l1Context{}, fmt.Errorf("avalanche blockchain validatorManager commands are only applicable to sovereign L1s")
+	}
+	network, err := networkoptions.GetNetworkFromCmdLineFlags(
+		app,
+		"",
+		globalNetworkFlags,
+		true,
+		false,
+		networkoptions.GetNetworkFromSidecar(sc, networkoptions.DefaultSupportedNetworkOptions),
+		"",
+	)
+	if err != nil {
+		return l1Context{}, err
+	}
+	return newL1Context(blockchainName, sc, network, rpcURL)
+}
+
+// newL1Context returns the context of the L1 deployed on [network], using [rpcURL]
+// if given, or the L1 endpoint otherwise
+func newL1Context(
+	blockchainName string,
+	sc models.Sidecar,
+	network models.Network,
+	rpcURL string,
+) (l1Context, error) {
+	validatorManagerAddress := sc.Networks[network.Name()].ValidatorManagerAddress
+	if validatorManagerAddress == "" {
+		return l1Context{}, fmt.Errorf("unable to find Validator Manager address")
+	}
+	if rpcURL == "" {
+		var err error
+		rpcURL, _, err = contract.GetBlockchainEndpoints(
+			app,
+			network,
+			contract.ChainSpec{
+				BlockchainName: blockchainName,
+			},
+			true,
+			false,
+		)
+		if err != nil {
+			return l1Context{}, err
+		}
+	}
+	return l1Context{
+		sc:                      sc,
+		network:                 network,
+		rpcURL:                  rpcURL,
+		validatorManagerAddress: common.HexToAddress(validatorManagerAddress),
+	}, nil
+}
+
+// getAddress validates [addressStr], or prompts for an address if empty
+func getAddress(addressStr string, promptStr string) (common.Address, error) {
+	if addressStr == "" {
+		return app.Prompt.CaptureAddress(promptStr)
+	}
+	if !common.IsHexAddress(addressStr) {
+		return common.Address{}, fmt.Errorf("invalid address %q", addressStr)
+	}
+	return common.HexToAddress(addressStr), nil
+}
+
+// issueOwnerTx issues the tx built by [buildTx] on behalf of [owner]. With
+// --external-evm-signature, the tx is not signed, but dumped for the owner to sign it
+// outside of the CLI. Returns true if the tx was issued
+func issueOwnerTx(
+	network models.Network,
+	owner common.Address,
+	goal string,
+	buildTx func(generateRawTxOnly bool, privateKey string) (*types.Transaction, *types.Receipt, error),
+) (bool, error) {
+	privateKey := ""
+	if !externalEVMSignature {
+		var err error
+		privateKey, err = getPrivateKey(network, owner, goal)
+		if err != nil {
+			return false, err
+		}
+	}
+	rawTx, _, err := buildTx(externalEVMSignature, privateKey)
+	if err != nil {
+		return false, err
+	}
+	if rawTx != nil {
+		dump, err := evm.TxDump(fmt.Sprintf("%s, to be signed by %s", goal, owner), rawTx)
+		if err == nil {
+			ux.Logger.PrintToUser(dump)
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// getPrivateKey returns the private key of [address], prompting for it if it is not
+// a key managed by the CLI
+func getPrivateKey(
+	network models.Network,
+	address common.Address,
+	goal string,
+) (string, error) {
+	found, _, _, privateKey, err := contract.SearchForManagedKey(
+		app,
+		network,
+		address,
+		true,
+	)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		ux.Logger.PrintToUser("Private key for address %s was not found", address)
+		return prompts.PromptPrivateKey(
+			app.Prompt,
+			goal,
+			app.GetKeyDir(),
+			app.GetKey,
+			"",
+			"",
+		)
+	}
+	return privateKey, nil
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatormanagercmd
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/internal/mocks"
+	"github.com/ava-labs/avalanche-cli/internal/testutils"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestGetAddress(t *testing.T) {
+	require := testutils.SetupTest(t)
+	app = testutils.SetupTestInTempDir(t)
+	mockPrompt := &mocks.Prompter{}
+	app.Prompt = mockPrompt
+	promptedAddress := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	mockPrompt.On("CaptureAddress", "new owner").Return(promptedAddress, nil)
+
+	address, err := getAddress("0x1111111111111111111111111111111111111111", "new owner")
+	require.NoError(err)
+	require.Equal(common.HexToAddress("0x1111111111111111111111111111111111111111"), address)
+
+	_, err = getAddress("0x1111", "new owner")
+	require.ErrorContains(err, "invalid address")
+
+	address, err = getAddress("", "new owner")
+	require.NoError(err)
+	require.Equal(promptedAddress, address)
+	mockPrompt.AssertExpectations(t)
+}
+
+func TestNewL1Context(t *testing.T) {
+	require := testutils.SetupTest(t)
+	app = testutils.SetupTestInTempDir(t)
+	network := models.NewLocalNetwork()
+	sc := models.Sidecar{
+		Name:      "l1",
+		Sovereign: true,
+		Networks: map[string]models.NetworkData{
+			network.Name(): {
+				ValidatorManagerAddress: "0x0FEEDC0DE0000000000000000000000000000000",
+			},
+		},
+	}
+
+	l1, err := newL1Context("l1", sc, network, "http://127.0.0.1:9650/ext/bc/l1/rpc")
+	require.NoError(err)
+	require.Equal("http://127.0.0.1:9650/ext/bc/l1/rpc", l1.rpcURL)
+	require.Equal(common.HexToAddress("0x0FEEDC0DE0000000000000000000000000000000"), l1.validatorManagerAddress)
+
+	_, err = newL1Context("l1", sc, models.NewFujiNetwork(), "http://127.0.0.1:9650/ext/bc/l1/rpc")
+	require.ErrorContains(err, "unable to find Validator Manager address")
+}
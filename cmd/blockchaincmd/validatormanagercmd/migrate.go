@@ -0,0 +1,312 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatormanagercmd
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/cmd/flags"
+	"github.com/ava-labs/avalanche-cli/cmd/networkcmd"
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	"github.com/ava-labs/avalanche-cli/pkg/localnet"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/networkoptions"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/pkg/validatormanager"
+	validatorManagerSDK "github.com/ava-labs/avalanche-cli/sdk/validatormanager"
+	"github.com/ava-labs/avalanche-cli/sdk/validatormanager/validatormanagertypes"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+const (
+	migrateToPoS = "pos"
+
+	rehearsalSnapshotInfix   = "-pre-migration-"
+	rehearsalTimestampFormat = "20060102150405"
+)
+
+type ValidatorManagerMigrateFlags struct {
+	To                      string
+	Rehearse                bool
+	SkipRehearsal           bool
+	MinimumStakeAmount      uint64
+	MaximumStakeAmount      uint64
+	MinimumStakeDuration    uint64
+	MinimumDelegationFee    uint16
+	MaximumStakeMultiplier  uint8
+	WeightToValueFactor     uint64
+	RewardBasisPoints       uint64
+	RewardCalculatorAddress string
+}
+
+var migrateFlags ValidatorManagerMigrateFlags
+
+// avalanche blockchain validatorManager migrate
+func newMigrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate [blockchainName]",
+		Short: "Migrate the validator manager from PoA to PoS",
+		Long: `The blockchain validatorManager migrate command migrates the Proof of Authority validator
+manager of an L1 to a Native Token Proof of Stake validator manager.
+
+It deploys the PoS validator manager, and a reward calculator unless --reward-calculator-address
+is given, moves the implementation behind the validator manager proxy, and initializes the
+PoS settings. The validator set is kept as is: the command checks that all active
+validators are carried over to the PoS manager.
+
+Before migrating an L1 on a public network, the migration is rehearsed on the copy of the
+blockchain deployed on the local network (see avalanche blockchain deploy --local). The local
+network state is saved before the rehearsal, and restored afterwards, so the local copy is
+kept on PoA. Use --rehearse to only rehearse the migration.
+
+The stake of the validators carried over to PoS is deposited by the proxy admin owner, who
+becomes their PoS owner, and gets the stake back when they are removed.`,
+		RunE: migrate,
+		Args: cobrautils.ExactArgs(1),
+	}
+	networkoptions.AddNetworkFlagsToCmd(cmd, &globalNetworkFlags, true, networkoptions.DefaultSupportedNetworkOptions)
+	flags.AddRPCFlagToCmd(cmd, app, &rpcURL)
+	cmd.Flags().StringVar(&migrateFlags.To, "to", "", "validator management to migrate to (pos)")
+	cmd.Flags().BoolVar(&migrateFlags.Rehearse, "rehearse", false, "only rehearse the migration on the local network copy of the blockchain")
+	cmd.Flags().BoolVar(&migrateFlags.SkipRehearsal, "skip-rehearsal", false, "do not rehearse the migration on the local network copy of the blockchain")
+	cmd.Flags().Uint64Var(&migrateFlags.MinimumStakeAmount, "pos-minimum-stake-amount", validatorManagerSDK.DefaultPoSMinimumStakeAmount, "minimum stake amount")
+	cmd.Flags().Uint64Var(&migrateFlags.MaximumStakeAmount, "pos-maximum-stake-amount", validatorManagerSDK.DefaultPoSMaximumStakeAmount, "maximum stake amount")
+	cmd.Flags().Uint64Var(&migrateFlags.MinimumStakeDuration, "pos-minimum-stake-duration", constants.PoSL1MinimumStakeDurationSeconds, "minimum stake duration (in seconds)")
+	cmd.Flags().Uint16Var(&migrateFlags.MinimumDelegationFee, "pos-minimum-delegation-fee", validatorManagerSDK.DefaultPoSDMinimumDelegationFee, "minimum delegation fee")
+	cmd.Flags().Uint8Var(&migrateFlags.MaximumStakeMultiplier, "pos-maximum-stake-multiplier", validatorManagerSDK.DefaultPoSMaximumStakeMultiplier, "maximum stake multiplier")
+	cmd.Flags().Uint64Var(&migrateFlags.WeightToValueFactor, "pos-weight-to-value-factor", validatorManagerSDK.DefaultPoSWeightToValueFactor, "weight to value factor")
+	cmd.Flags().Uint64Var(&migrateFlags.RewardBasisPoints, "reward-basis-points", 100, "reward basis points for the deployed PoS Reward Calculator")
+	cmd.Flags().StringVar(&migrateFlags.RewardCalculatorAddress, "reward-calculator-address", "", "use the reward calculator at this address instead of deploying one")
+	_ = cmd.MarkFlagRequired("to")
+	return cmd
+}
+
+func migrate(_ *cobra.Command, args []string) error {
+	blockchainName := args[0]
+	if migrateFlags.To != migrateToPoS {
+		return fmt.Errorf("unsupported migration target %q: only %q is supported", migrateFlags.To, migrateToPoS)
+	}
+	if migrateFlags.Rehearse && migrateFlags.SkipRehearsal {
+		return fmt.Errorf("--rehearse and --skip-rehearsal are mutually exclusive")
+	}
+	if migrateFlags.RewardCalculatorAddress != "" && !common.IsHexAddress(migrateFlags.RewardCalculatorAddress) {
+		return fmt.Errorf("invalid reward calculator address %q", migrateFlags.RewardCalculatorAddress)
+	}
+	sc, err := app.LoadSidecar(blockchainName)
+	if err != nil {
+		return fmt.Errorf("failed to load sidecar: %w", err)
+	}
+	if !sc.Sovereign {
+		return fmt.Errorf("avalanche blockchain validatorManager commands are only applicable to sovereign L1s")
+	}
+	if !sc.PoA() {
+		return fmt.Errorf("blockchain %s does not use Proof of Authority validator management", blockchainName)
+	}
+	if sc.UseACP99 {
+		return fmt.Errorf("migration to PoS is only supported for v1.0.0 validator managers")
+	}
+	localNetwork := models.NewLocalNetwork()
+	network := localNetwork
+	if !migrateFlags.Rehearse {
+		network, err = networkoptions.GetNetworkFromCmdLineFlags(
+			app,
+			"",
+			globalNetworkFlags,
+			true,
+			false,
+			networkoptions.GetNetworkFromSidecar(sc, networkoptions.DefaultSupportedNetworkOptions),
+			"",
+		)
+		if err != nil {
+			return err
+		}
+	}
+	if migrateFlags.Rehearse || (network.Kind != models.Local && !migrateFlags.SkipRehearsal) {
+		if _, ok := sc.Networks[localNetwork.Name()]; !ok {
+			return fmt.Errorf(
+				"blockchain %s is not deployed on the local network to rehearse the migration. Deploy it with avalanche blockchain deploy %s --local, or use --skip-rehearsal",
+				blockchainName,
+				blockchainName,
+			)
+		}
+		ux.Logger.PrintToUser("Rehearsing migration on the %s copy of blockchain %s ...", localNetwork.Name(), blockchainName)
+		ux.Logger.PrintLineSeparator()
+		if err := rehearseMigration(blockchainName, sc, localNetwork); err != nil {
+			return fmt.Errorf("migration rehearsal failed: %w", err)
+		}
+		ux.Logger.PrintLineSeparator()
+		ux.Logger.GreenCheckmarkToUser("Migration rehearsal succeeded")
+		if migrateFlags.Rehearse {
+			return nil
+		}
+	}
+	l1, err := newL1Context(blockchainName, sc, network, rpcURL)
+	if err != nil {
+		return err
+	}
+	if err := migrateToProofOfStake(blockchainName, l1); err != nil {
+		return err
+	}
+	sc.ValidatorManagement = validatormanagertypes.ProofOfStake
+	if err := app.UpdateSidecar(&sc); err != nil {
+		return err
+	}
+	ux.Logger.GreenCheckmarkToUser("Validator manager of %s successfully migrated to Proof of Stake on %s", blockchainName, network.Name())
+	return nil
+}
+
+// rehearseMigration migrates the local network copy of [blockchainName], and then restores
+// the local network to the state it had before the rehearsal, so the copy is kept on PoA
+// and the rehearsal can be repeated
+func rehearseMigration(blockchainName string, sc models.Sidecar, localNetwork models.Network) (err error) {
+	running, err := localnet.IsLocalNetworkRunning(app)
+	if err != nil {
+		return err
+	}
+	if !running {
+		return fmt.Errorf("local network is not running. Start it with avalanche network start, or use --skip-rehearsal")
+	}
+	ux.Logger.PrintToUser("Saving local network state ...")
+	if err := networkcmd.StopAndSave(); err != nil {
+		return err
+	}
+	snapshotPath := app.GetSnapshotPath(constants.DefaultSnapshotName)
+	backupPath := app.GetSnapshotPath(blockchainName + rehearsalSnapshotInfix + time.Now().Format(rehearsalTimestampFormat))
+	if err := localnet.TmpNetMove(snapshotPath, backupPath); err != nil {
+		return err
+	}
+	startFlags := networkcmd.StartFlags{
+		UserProvidedAvagoVersion: constants.DefaultAvalancheGoVersion,
+		RelayerVersion:           constants.DefaultRelayerVersion,
+		NumNodes:                 constants.LocalNetworkNumNodes,
+	}
+	defer func() {
+		ux.Logger.PrintLineSeparator()
+		ux.Logger.PrintToUser("Restoring local network state previous to the rehearsal ...")
+		if restoreErr := restoreLocalNetwork(snapshotPath, backupPath, startFlags); restoreErr != nil {
+			restoreErr = fmt.Errorf("failure restoring local network from snapshot %s: %w", backupPath, restoreErr)
+			if err == nil {
+				err = restoreErr
+			} else {
+				err = fmt.Errorf("%w. %w", err, restoreErr)
+			}
+		}
+	}()
+	if err := networkcmd.Start(startFlags, false); err != nil {
+		return err
+	}
+	l1, err := newL1Context(blockchainName, sc, localNetwork, "")
+	if err != nil {
+		return err
+	}
+	return migrateToProofOfStake(blockchainName, l1)
+}
+
+// restoreLocalNetwork stops the local network, and starts it again from [backupPath]
+func restoreLocalNetwork(snapshotPath string, backupPath string, startFlags networkcmd.StartFlags) error {
+	if running, err := localnet.IsLocalNetworkRunning(app); err != nil {
+		return err
+	} else if running {
+		if err := networkcmd.StopAndSave(); err != nil {
+			return err
+		}
+	}
+	if err := os.RemoveAll(snapshotPath); err != nil {
+		return err
+	}
+	if err := localnet.TmpNetMove(backupPath, snapshotPath); err != nil {
+		return err
+	}
+	if err := networkcmd.Start(startFlags, false); err != nil {
+		return err
+	}
+	return os.RemoveAll(backupPath)
+}
+
+func migrateToProofOfStake(blockchainName string, l1 l1Context) error {
+	if err := checkProxy(blockchainName, l1); err != nil {
+		return err
+	}
+	networkData := l1.sc.Networks[l1.network.Name()]
+	_, privateKey, err := contract.GetEVMSubnetPrefundedKey(
+		app,
+		l1.network,
+		contract.ChainSpec{
+			BlockchainName: blockchainName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	proxyAdminOwner, err := contract.GetContractOwner(l1.rpcURL, common.HexToAddress(validatorManagerSDK.ProxyAdminContractAddress))
+	if err != nil {
+		return err
+	}
+	proxyAdminOwnerPrivateKey, err := getPrivateKey(l1.network, proxyAdminOwner, "migrate validator manager to PoS")
+	if err != nil {
+		return err
+	}
+	validationIDs, err := getValidationIDs(l1)
+	if err != nil {
+		return err
+	}
+	carried, err := validatormanager.MigratePoAToPoS(
+		validatormanager.PoSMigration{
+			RPCURL:                    l1.rpcURL,
+			SubnetID:                  networkData.SubnetID,
+			PrivateKey:                privateKey,
+			ProxyAdminOwnerPrivateKey: proxyAdminOwnerPrivateKey,
+			PoSParams: validatorManagerSDK.PoSParams{
+				MinimumStakeAmount:      new(big.Int).SetUint64(migrateFlags.MinimumStakeAmount),
+				MaximumStakeAmount:      new(big.Int).SetUint64(migrateFlags.MaximumStakeAmount),
+				MinimumStakeDuration:    migrateFlags.MinimumStakeDuration,
+				MinimumDelegationFee:    migrateFlags.MinimumDelegationFee,
+				MaximumStakeMultiplier:  migrateFlags.MaximumStakeMultiplier,
+				WeightToValueFactor:     new(big.Int).SetUint64(migrateFlags.WeightToValueFactor),
+				RewardCalculatorAddress: migrateFlags.RewardCalculatorAddress,
+				UptimeBlockchainID:      networkData.BlockchainID,
+			},
+			RewardBasisPoints: migrateFlags.RewardBasisPoints,
+			ValidationIDs:     validationIDs,
+		},
+		ux.Logger.PrintToUser,
+	)
+	if err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("%d active validators carried over to the PoS validator manager", len(carried))
+	return nil
+}
+
+// getValidationIDs returns the bootstrap validators, and the validators registered
+// afterwards on the validator manager
+func getValidationIDs(l1 l1Context) ([]ids.ID, error) {
+	validationIDs := []ids.ID{}
+	seen := map[ids.ID]bool{}
+	for _, bootstrapValidator := range l1.sc.Networks[l1.network.Name()].BootstrapValidators {
+		validationID, err := ids.FromString(bootstrapValidator.ValidationID)
+		if err != nil {
+			continue
+		}
+		validationIDs = append(validationIDs, validationID)
+		seen[validationID] = true
+	}
+	registrations, err := validatormanager.GetL1ValidatorRegistrations(l1.rpcURL, l1.validatorManagerAddress)
+	if err != nil {
+		return nil, err
+	}
+	for _, registration := range registrations {
+		if !seen[registration.ValidationID] {
+			validationIDs = append(validationIDs, registration.ValidationID)
+			seen[registration.ValidationID] = true
+		}
+	}
+	return validationIDs, nil
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatormanagercmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/spf13/cobra"
+)
+
+var newOwner string
+
+// avalanche blockchain validatorManager owner
+func newOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner",
+		Short: "Manage the owner of the validator manager",
+		Long:  `The blockchain validatorManager owner command suite manages the owner of an Ownable validator manager.`,
+		RunE:  cobrautils.CommandSuiteUsage,
+	}
+	// blockchain validatorManager owner transfer
+	cmd.AddCommand(newOwnerTransferCmd())
+	return cmd
+}
+
+// avalanche blockchain validatorManager owner transfer
+func newOwnerTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [blockchainName]",
+		Short: "Transfer ownership of the validator manager",
+		Long: `The blockchain validatorManager owner transfer command transfers ownership of the
+validator manager of an L1 to a new address. The transaction is signed by the current owner.`,
+		RunE: transferOwner,
+		Args: cobrautils.ExactArgs(1),
+	}
+	addTxFlags(cmd)
+	cmd.Flags().StringVar(&newOwner, "new-owner", "", "address of the new owner")
+	return cmd
+}
+
+func transferOwner(_ *cobra.Command, args []string) error {
+	blockchainName := args[0]
+	l1, err := loadL1Context(blockchainName)
+	if err != nil {
+		return err
+	}
+	owner, err := contract.GetContractOwner(l1.rpcURL, l1.validatorManagerAddress)
+	if err != nil {
+		return fmt.Errorf("failure getting owner of validator manager at %s, it may not be Ownable: %w", l1.validatorManagerAddress, err)
+	}
+	newOwnerAddress, err := getAddress(newOwner, "What is the address of the new validator manager owner?")
+	if err != nil {
+		return err
+	}
+	if newOwnerAddress == owner {
+		return fmt.Errorf("%s is already the owner of the validator manager", owner)
+	}
+	ux.Logger.PrintToUser("Validator Manager: %s", l1.validatorManagerAddress)
+	ux.Logger.PrintToUser("Current Owner: %s", owner)
+	ux.Logger.PrintToUser("New Owner: %s", newOwnerAddress)
+	issued, err := issueOwnerTx(
+		l1.network,
+		owner,
+		"transfer validator manager ownership",
+		func(generateRawTxOnly bool, privateKey string) (*types.Transaction, *types.Receipt, error) {
+			return contract.TransferOwnership(
+				l1.rpcURL,
+				generateRawTxOnly,
+				owner,
+				privateKey,
+				l1.validatorManagerAddress,
+				newOwnerAddress,
+			)
+		},
+	)
+	if err != nil || !issued {
+		return err
+	}
+	if strings.EqualFold(l1.sc.ValidatorManagerOwner, owner.Hex()) {
+		l1.sc.ValidatorManagerOwner = newOwnerAddress.Hex()
+		if err := app.UpdateSidecar(&l1.sc); err != nil {
+			return err
+		}
+	}
+	ux.Logger.GreenCheckmarkToUser("Validator manager ownership transferred to %s", newOwnerAddress)
+	return nil
+}
@@ -0,0 +1,223 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatormanagercmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/pkg/validatormanager"
+	"github.com/ava-labs/avalanche-cli/sdk/evm"
+	validatorManagerSDK "github.com/ava-labs/avalanche-cli/sdk/validatormanager"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+var implementation string
+
+// avalanche blockchain validatorManager proxy
+func newProxyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proxy",
+		Short: "Manage the validator manager proxy",
+		Long: `The blockchain validatorManager proxy command suite manages the transparent proxy the
+validator manager is deployed behind, and its proxy admin.`,
+		RunE: cobrautils.CommandSuiteUsage,
+	}
+	// blockchain validatorManager proxy upgrade
+	cmd.AddCommand(newProxyUpgradeCmd())
+	// blockchain validatorManager proxy admin
+	cmd.AddCommand(newProxyAdminCmd())
+	return cmd
+}
+
+// avalanche blockchain validatorManager proxy upgrade
+func newProxyUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade [blockchainName]",
+		Short: "Upgrade the validator manager implementation",
+		Long: `The blockchain validatorManager proxy upgrade command points the validator manager proxy
+to a new implementation contract. The transaction is signed by the owner of the proxy admin.
+
+The bytecode deployed at the new implementation address must match a validator manager
+release known to the CLI.`,
+		RunE: upgradeProxy,
+		Args: cobrautils.ExactArgs(1),
+	}
+	addTxFlags(cmd)
+	cmd.Flags().StringVar(&implementation, "implementation", "", "address of the new validator manager implementation")
+	return cmd
+}
+
+// avalanche blockchain validatorManager proxy admin
+func newProxyAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Manage the owner of the validator manager proxy admin",
+		Long:  `The blockchain validatorManager proxy admin command suite manages the owner of the proxy admin.`,
+		RunE:  cobrautils.CommandSuiteUsage,
+	}
+	// blockchain validatorManager proxy admin transfer
+	cmd.AddCommand(newProxyAdminTransferCmd())
+	return cmd
+}
+
+// avalanche blockchain validatorManager proxy admin transfer
+func newProxyAdminTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [blockchainName]",
+		Short: "Transfer ownership of the validator manager proxy admin",
+		Long: `The blockchain validatorManager proxy admin transfer command transfers ownership of the
+proxy admin, and so the right to upgrade the validator manager, to a new address. The
+transaction is signed by the current owner.`,
+		RunE: transferProxyAdmin,
+		Args: cobrautils.ExactArgs(1),
+	}
+	addTxFlags(cmd)
+	cmd.Flags().StringVar(&newOwner, "new-owner", "", "address of the new proxy admin owner")
+	return cmd
+}
+
+// loadProxyContext returns the L1 context, checking that its validator manager is
+// behind the proxy deployed by the CLI
+func loadProxyContext(blockchainName string) (l1Context, error) {
+	l1, err := loadL1Context(blockchainName)
+	if err != nil {
+		return l1Context{}, err
+	}
+	return l1, checkProxy(blockchainName, l1)
+}
+
+func checkProxy(blockchainName string, l1 l1Context) error {
+	if l1.validatorManagerAddress != common.HexToAddress(validatorManagerSDK.ProxyContractAddress) {
+		return fmt.Errorf("validator manager of %s is not deployed behind a proxy", blockchainName)
+	}
+	client, err := evm.GetClient(l1.rpcURL)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	deployed, err := client.ContractAlreadyDeployed(validatorManagerSDK.ProxyAdminContractAddress)
+	if err != nil {
+		return err
+	}
+	if !deployed {
+		return fmt.Errorf("proxy admin not found at %s", validatorManagerSDK.ProxyAdminContractAddress)
+	}
+	return nil
+}
+
+func upgradeProxy(_ *cobra.Command, args []string) error {
+	blockchainName := args[0]
+	l1, err := loadProxyContext(blockchainName)
+	if err != nil {
+		return err
+	}
+	implementationAddress, err := getAddress(implementation, "What is the address of the new validator manager implementation?")
+	if err != nil {
+		return err
+	}
+	currentImplementation, err := validatormanager.GetProxyValidatorManager(l1.rpcURL)
+	if err != nil {
+		return err
+	}
+	if currentImplementation == implementationAddress {
+		return fmt.Errorf("validator manager proxy already points to %s", implementationAddress)
+	}
+	client, err := evm.GetClient(l1.rpcURL)
+	if err != nil {
+		return err
+	}
+	bytecode, err := client.GetContractBytecode(implementationAddress.Hex())
+	client.Close()
+	if err != nil {
+		return err
+	}
+	if len(bytecode) == 0 {
+		return fmt.Errorf("no contract deployed at %s", implementationAddress)
+	}
+	release, ok := validatormanager.GetValidatorManagerRelease(bytecode)
+	if !ok {
+		return fmt.Errorf("bytecode at %s does not match any known validator manager release", implementationAddress)
+	}
+	proxyAdminOwner, err := contract.GetContractOwner(l1.rpcURL, common.HexToAddress(validatorManagerSDK.ProxyAdminContractAddress))
+	if err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("Validator Manager Proxy: %s", validatorManagerSDK.ProxyContractAddress)
+	ux.Logger.PrintToUser("Current Implementation: %s", currentImplementation)
+	ux.Logger.PrintToUser("New Implementation: %s (%s)", implementationAddress, release)
+	issued, err := issueOwnerTx(
+		l1.network,
+		proxyAdminOwner,
+		"upgrade validator manager proxy",
+		func(generateRawTxOnly bool, privateKey string) (*types.Transaction, *types.Receipt, error) {
+			return validatormanager.UpgradeProxyValidatorManager(
+				l1.rpcURL,
+				generateRawTxOnly,
+				proxyAdminOwner,
+				privateKey,
+				implementationAddress,
+				"upgrade validator manager proxy",
+			)
+		},
+	)
+	if err != nil || !issued {
+		return err
+	}
+	ux.Logger.GreenCheckmarkToUser("Validator manager proxy upgraded to %s", implementationAddress)
+	return nil
+}
+
+func transferProxyAdmin(_ *cobra.Command, args []string) error {
+	blockchainName := args[0]
+	l1, err := loadProxyContext(blockchainName)
+	if err != nil {
+		return err
+	}
+	proxyAdminAddress := common.HexToAddress(validatorManagerSDK.ProxyAdminContractAddress)
+	owner, err := contract.GetContractOwner(l1.rpcURL, proxyAdminAddress)
+	if err != nil {
+		return err
+	}
+	newOwnerAddress, err := getAddress(newOwner, "What is the address of the new proxy admin owner?")
+	if err != nil {
+		return err
+	}
+	if newOwnerAddress == owner {
+		return fmt.Errorf("%s is already the owner of the proxy admin", owner)
+	}
+	ux.Logger.PrintToUser("Proxy Admin: %s", proxyAdminAddress)
+	ux.Logger.PrintToUser("Current Owner: %s", owner)
+	ux.Logger.PrintToUser("New Owner: %s", newOwnerAddress)
+	issued, err := issueOwnerTx(
+		l1.network,
+		owner,
+		"transfer proxy admin ownership",
+		func(generateRawTxOnly bool, privateKey string) (*types.Transaction, *types.Receipt, error) {
+			return contract.TransferOwnership(
+				l1.rpcURL,
+				generateRawTxOnly,
+				owner,
+				privateKey,
+				proxyAdminAddress,
+				newOwnerAddress,
+			)
+		},
+	)
+	if err != nil || !issued {
+		return err
+	}
+	if strings.EqualFold(l1.sc.ProxyContractOwner, owner.Hex()) {
+		l1.sc.ProxyContractOwner = newOwnerAddress.Hex()
+		if err := app.UpdateSidecar(&l1.sc); err != nil {
+			return err
+		}
+	}
+	ux.Logger.GreenCheckmarkToUser("Proxy admin ownership transferred to %s", newOwnerAddress)
+	return nil
+}
@@ -0,0 +1,34 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatormanagercmd
+
+import (
+	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/spf13/cobra"
+)
+
+var app *application.Avalanche
+
+// avalanche blockchain validatorManager
+func NewCmd(injectedApp *application.Avalanche) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validatorManager",
+		Short: "Administer the validator manager of an L1",
+		Long: `The blockchain validatorManager command suite administers the validator manager contract
+of a sovereign L1: the owner of the manager, the transparent proxy the manager is
+deployed behind, and the migration from Proof of Authority to Proof of Stake.
+
+Transactions are signed by a stored key, or can be generated unsigned with
+--external-evm-signature, to be signed outside of the CLI (for multisig or ledger).`,
+		RunE: cobrautils.CommandSuiteUsage,
+	}
+	app = injectedApp
+	// blockchain validatorManager owner
+	cmd.AddCommand(newOwnerCmd())
+	// blockchain validatorManager proxy
+	cmd.AddCommand(newProxyCmd())
+	// blockchain validatorManager migrate
+	cmd.AddCommand(newMigrateCmd())
+	return cmd
+}
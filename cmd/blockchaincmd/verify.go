@@ -0,0 +1,223 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package blockchaincmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/drift"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/networkoptions"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/pkg/validatormanager"
+	sdkblockchain "github.com/ava-labs/avalanche-cli/sdk/blockchain"
+	"github.com/ava-labs/avalanche-cli/sdk/evm"
+	validatorManagerSDK "github.com/ava-labs/avalanche-cli/sdk/validatormanager"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+var fixDrift bool
+
+// avalanche blockchain verify
+func newVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [blockchainName]",
+		Short: "Verify the blockchain configuration against its deployment",
+		Long: `The blockchain verify command checks the deployment info recorded for a blockchain
+against the P-Chain and the blockchain itself. Subnet and blockchain IDs, L1 conversion info,
+validator manager proxy, ICM contracts, RPC endpoints and bootstrap validators are verified.
+
+Mismatches arise after operating the blockchain outside of the CLI, or from another machine.
+Use --fix to update the blockchain configuration to the observed state.`,
+		RunE: verifyBlockchain,
+		Args: cobrautils.ExactArgs(1),
+	}
+	networkoptions.AddNetworkFlagsToCmd(cmd, &globalNetworkFlags, false, networkoptions.DefaultSupportedNetworkOptions)
+	cmd.Flags().BoolVar(&fixDrift, "fix", false, "update the blockchain configuration to the observed state")
+	return cmd
+}
+
+func verifyBlockchain(_ *cobra.Command, args []string) error {
+	blockchainName := args[0]
+	sc, err := app.LoadSidecar(blockchainName)
+	if err != nil {
+		return err
+	}
+	network, err := networkoptions.GetNetworkFromCmdLineFlags(
+		app,
+		"",
+		globalNetworkFlags,
+		true,
+		false,
+		networkoptions.GetNetworkFromSidecar(sc, networkoptions.DefaultSupportedNetworkOptions),
+		"",
+	)
+	if err != nil {
+		return err
+	}
+	networkData, ok := sc.Networks[network.Name()]
+	if !ok || networkData.SubnetID == ids.Empty {
+		return fmt.Errorf("%s has not been deployed to %s", blockchainName, network.Name())
+	}
+	observed, err := observeDeployment(sc, network, networkData)
+	if err != nil {
+		return err
+	}
+	mismatches := drift.Detect(sc, network.Name(), observed)
+	if len(mismatches) == 0 {
+		ux.Logger.GreenCheckmarkToUser("%s configuration matches its deployment on %s", blockchainName, network.Name())
+		return nil
+	}
+	t := ux.DefaultTable(
+		fmt.Sprintf("%d mismatches", len(mismatches)),
+		table.Row{"Field", "Configuration", "Observed", "Fix"},
+	)
+	fixable := 0
+	for _, mismatch := range mismatches {
+		fix := mismatch.Hint
+		if mismatch.Fixable() {
+			fix = "update configuration"
+			fixable++
+		}
+		t.AppendRow(table.Row{mismatch.Field, mismatch.Sidecar, mismatch.Observed, fix})
+	}
+	ux.Logger.PrintToUser(t.Render())
+	if !fixDrift {
+		if fixable > 0 {
+			ux.Logger.PrintToUser("Use --fix to update %d mismatches on the %s configuration", fixable, blockchainName)
+		}
+		return nil
+	}
+	if fixable == 0 {
+		ux.Logger.PrintToUser("No mismatch can be fixed by updating the %s configuration", blockchainName)
+		return nil
+	}
+	fixed := drift.Fix(&sc, network.Name(), mismatches)
+	if err := app.UpdateSidecar(&sc); err != nil {
+		return err
+	}
+	ux.Logger.GreenCheckmarkToUser("Updated %d mismatches on the %s configuration", fixed, blockchainName)
+	return nil
+}
+
+// observeDeployment gets the state of the [networkData] deployment from the P-Chain and
+// from the blockchain RPC
+func observeDeployment(sc models.Sidecar, network models.Network, networkData models.NetworkData) (drift.Observed, error) {
+	observed := drift.Observed{
+		RPCEndpointErrs:        map[string]error{},
+		BootstrapValidationIDs: map[string]ids.ID{},
+	}
+	subnetInfo, err := sdkblockchain.GetSubnet(networkData.SubnetID, network.SDKNetwork())
+	if err != nil {
+		if strings.Contains(err.Error(), database.ErrNotFound.Error()) {
+			return observed, nil
+		}
+		return observed, fmt.Errorf("failed to get subnet %s: %w", networkData.SubnetID, err)
+	}
+	observed.SubnetFound = true
+	observed.ConversionID = subnetInfo.ConversionID
+	observed.ManagerChainID = subnetInfo.ManagerChainID
+	if len(subnetInfo.ManagerAddress) > 0 {
+		observed.ManagerAddress = common.HexToAddress("0x" + hex.EncodeToString(subnetInfo.ManagerAddress)).Hex()
+	}
+	pClient := platformvm.NewClient(network.Endpoint)
+	if networkData.BlockchainID != ids.Empty {
+		ctx, cancel := utils.GetAPIContext()
+		defer cancel()
+		// unknown blockchains are left with an empty subnet ID
+		observed.BlockchainSubnetID, _ = pClient.ValidatedBy(ctx, networkData.BlockchainID)
+	}
+	blockchainID := networkData.BlockchainID
+	if observed.ManagerChainID != ids.Empty {
+		blockchainID = observed.ManagerChainID
+	}
+	rpcURL := network.BlockchainEndpoint(blockchainID.String())
+	if len(networkData.RPCEndpoints) > 0 && blockchainID == networkData.BlockchainID {
+		rpcURL = networkData.RPCEndpoints[0]
+	}
+	observeContracts(&observed, networkData, rpcURL)
+	if sc.VM == models.SubnetEvm && sc.ChainID != "" {
+		for _, endpoint := range networkData.RPCEndpoints {
+			observed.RPCEndpointErrs[endpoint] = checkRPCEndpoint(endpoint, sc.ChainID)
+		}
+	}
+	// bootstrap validators get validation IDs from the conversion subnet ID, in sorted order
+	for index := range networkData.BootstrapValidators {
+		validationID := networkData.SubnetID.Append(uint32(index))
+		ctx, cancel := utils.GetAPIContext()
+		validator, _, err := pClient.GetL1Validator(ctx, validationID)
+		cancel()
+		if err != nil {
+			continue
+		}
+		observed.BootstrapValidationIDs[validator.NodeID.String()] = validationID
+	}
+	return observed, nil
+}
+
+// observeContracts gets the state of the validator manager proxy and of the ICM contracts
+// from [rpcURL]
+func observeContracts(observed *drift.Observed, networkData models.NetworkData, rpcURL string) {
+	client, err := evm.GetClient(rpcURL)
+	if err != nil {
+		observed.RPCErr = err
+		return
+	}
+	defer client.Close()
+	if _, err := client.GetChainID(); err != nil {
+		observed.RPCErr = err
+		return
+	}
+	observed.ManagerIsProxy = strings.EqualFold(observed.ManagerAddress, validatorManagerSDK.ProxyContractAddress)
+	if observed.ManagerIsProxy {
+		implementation, err := validatormanager.GetProxyValidatorManager(rpcURL)
+		if err != nil {
+			observed.RPCErr = err
+			return
+		}
+		if implementation != (common.Address{}) {
+			observed.ProxyImplementation = implementation.Hex()
+		}
+	}
+	if networkData.TeleporterMessengerAddress != "" {
+		observed.MessengerDeployed, err = client.ContractAlreadyDeployed(networkData.TeleporterMessengerAddress)
+		if err != nil {
+			observed.RPCErr = err
+			return
+		}
+	}
+	if networkData.TeleporterRegistryAddress != "" {
+		observed.RegistryDeployed, err = client.ContractAlreadyDeployed(networkData.TeleporterRegistryAddress)
+		if err != nil {
+			observed.RPCErr = err
+			return
+		}
+	}
+}
+
+// checkRPCEndpoint verifies that [endpoint] serves an EVM with chain ID [expectedChainID]
+func checkRPCEndpoint(endpoint string, expectedChainID string) error {
+	client, err := evm.GetClient(endpoint)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	chainID, err := client.GetChainID()
+	if err != nil {
+		return err
+	}
+	if chainID.String() != expectedChainID {
+		return fmt.Errorf("%w: chain ID %s", drift.ErrRPCEndpointMismatch, chainID)
+	}
+	return nil
+}
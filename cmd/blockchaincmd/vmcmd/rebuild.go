@@ -0,0 +1,100 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package vmcmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/pkg/vm"
+	"github.com/spf13/cobra"
+)
+
+var commit string
+
+// avalanche blockchain vm rebuild
+func newRebuildCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebuild [blockchainName]",
+		Short: "Check that a custom VM rebuild reproduces the pinned build",
+		Long: `The blockchain vm rebuild command rebuilds the custom VM of a blockchain at its pinned
+commit, and checks that the resulting binary has the pinned SHA-256. If the local VM binary
+does not match the pinned build, it is replaced by the rebuilt one.
+
+With --commit, the custom VM is instead built at the given commit, which becomes the
+pinned commit together with the SHA-256 of the new binary. Blockchains created before
+builds were pinned get pinned to the current commit of their branch.`,
+		RunE: rebuildVM,
+		Args: cobrautils.ExactArgs(1),
+	}
+	cmd.Flags().StringVar(&commit, "commit", "", "pin the custom VM build to this full commit hash")
+	return cmd
+}
+
+func rebuildVM(_ *cobra.Command, args []string) error {
+	blockchainName := args[0]
+	sc, err := app.LoadSidecar(blockchainName)
+	if err != nil {
+		return err
+	}
+	if sc.VM != models.CustomVM || sc.CustomVMRepoURL == "" {
+		return fmt.Errorf("blockchain %s does not use a custom VM built from a source code repository", blockchainName)
+	}
+	if commit != "" {
+		if _, err := hex.DecodeString(commit); err != nil || len(commit) != 40 {
+			return fmt.Errorf("invalid commit %q: expected a full 40 characters commit hash", commit)
+		}
+	}
+
+	if commit != "" || sc.CustomVMBinarySHA256 == "" {
+		sc.CustomVMCommit = commit
+		sc.CustomVMBinarySHA256 = ""
+		if err := vm.BuildCustomVM(app, &sc); err != nil {
+			return err
+		}
+		sc.RPCVersion, err = vm.GetVMBinaryProtocolVersion(app.GetCustomVMPath(blockchainName))
+		if err != nil {
+			return fmt.Errorf("unable to get RPC version: %w", err)
+		}
+		if err := app.UpdateSidecar(&sc); err != nil {
+			return err
+		}
+		ux.Logger.GreenCheckmarkToUser(
+			"Custom VM of %s pinned to commit %s with binary SHA-256 %s",
+			blockchainName,
+			sc.CustomVMCommit,
+			sc.CustomVMBinarySHA256,
+		)
+		return nil
+	}
+
+	tmpDir, err := os.MkdirTemp("", "custom-vm-rebuild")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+	vmPath := filepath.Join(tmpDir, blockchainName)
+	if err := vm.BuildCustomVMBinary(app, &sc, vmPath); err != nil {
+		return err
+	}
+	if err := vm.VerifyCustomVMBinary(sc, vmPath); err != nil {
+		return fmt.Errorf("rebuild of commit %s is not reproducible: %w", sc.CustomVMCommit, err)
+	}
+	ux.Logger.GreenCheckmarkToUser(
+		"Rebuild of commit %s reproduces the pinned SHA-256 %s",
+		sc.CustomVMCommit,
+		sc.CustomVMBinarySHA256,
+	)
+	if err := vm.VerifyCustomVMBinary(sc, app.GetCustomVMPath(blockchainName)); err != nil {
+		ux.Logger.PrintToUser("Replacing local custom VM binary, as it does not match the pinned build")
+		if err := app.CopyVMBinary(vmPath, blockchainName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package vmcmd
+
+import (
+	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/spf13/cobra"
+)
+
+var app *application.Avalanche
+
+// avalanche blockchain vm
+func NewCmd(injectedApp *application.Avalanche) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vm",
+		Short: "Manage custom VM builds",
+		Long: `The blockchain vm command suite manages the builds of custom VMs created from a
+source code repository.
+
+Custom VM builds are pinned to a commit, and the SHA-256 of the resulting binary is
+recorded on the blockchain configuration. Deploy, node sync and upgrade vm refuse
+binaries that do not match the pinned build.`,
+		RunE: cobrautils.CommandSuiteUsage,
+	}
+	app = injectedApp
+	// blockchain vm rebuild
+	cmd.AddCommand(newRebuildCmd())
+	return cmd
+}
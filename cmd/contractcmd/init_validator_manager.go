@@ -16,6 +16,7 @@ import (
 	"github.com/ava-labs/avalanche-cli/pkg/networkoptions"
 	"github.com/ava-labs/avalanche-cli/pkg/prompts"
 	"github.com/ava-labs/avalanche-cli/pkg/signatureaggregator"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
 	"github.com/ava-labs/avalanche-cli/pkg/ux"
 	"github.com/ava-labs/avalanche-cli/pkg/validatormanager"
 	blockchainSDK "github.com/ava-labs/avalanche-cli/sdk/blockchain"
@@ -30,6 +31,8 @@ import (
 
 type POSManagerSpecFlags struct {
 	rewardCalculatorAddress string
+	rewardCalculator        string
+	rewardBasisPoints       uint64
 	minimumStakeAmount      uint64 // big.Int
 	maximumStakeAmount      uint64 // big.Int
 	minimumStakeDuration    uint64
@@ -66,6 +69,8 @@ func newInitValidatorManagerCmd() *cobra.Command {
 	flags.AddSignatureAggregatorFlagsToCmd(cmd, &initValidatorManagerFlags.SigAggFlags)
 
 	cmd.Flags().StringVar(&initPOSManagerFlags.rewardCalculatorAddress, "pos-reward-calculator-address", "", "(PoS only) initialize the ValidatorManager with reward calculator address")
+	cmd.Flags().StringVar(&initPOSManagerFlags.rewardCalculator, "pos-reward-calculator", "", "(PoS only) deploy and use the custom IRewardCalculator at this forge or hardhat artifact path")
+	cmd.Flags().Uint64Var(&initPOSManagerFlags.rewardBasisPoints, "pos-reward-basis-points", 0, "(PoS only) deploy and use an example reward calculator with this reward basis points")
 	cmd.Flags().Uint64Var(&initPOSManagerFlags.minimumStakeAmount, "pos-minimum-stake-amount", 1, "(PoS only) minimum stake amount")
 	cmd.Flags().Uint64Var(&initPOSManagerFlags.maximumStakeAmount, "pos-maximum-stake-amount", 1000, "(PoS only) maximum stake amount")
 	cmd.Flags().Uint64Var(&initPOSManagerFlags.minimumStakeDuration, "pos-minimum-stake-duration", constants.PoSL1MinimumStakeDurationSeconds, "(PoS only) minimum stake duration (in seconds)")
@@ -228,13 +233,15 @@ func initValidatorManager(_ *cobra.Command, args []string) error {
 				initValidatorManagerFlags.RPC,
 				genesisPrivateKey,
 				proxyOwnerPrivateKey,
+				sc.StakingTokenAddress,
 			); err != nil {
 				return err
 			}
 		}
 		ux.Logger.PrintToUser(logging.Yellow.Wrap("Initializing Proof of Stake Validator Manager contract on blockchain %s"), blockchainName)
-		if initPOSManagerFlags.rewardCalculatorAddress == "" {
-			initPOSManagerFlags.rewardCalculatorAddress = validatorManagerSDK.RewardCalculatorAddress
+		rewardCalculatorAddress, err := getRewardCalculatorAddress(initValidatorManagerFlags.RPC, privateKey)
+		if err != nil {
+			return err
 		}
 		if err := validatormanager.SetupPoS(
 			aggregatorCtx,
@@ -251,16 +258,63 @@ func initValidatorManager(_ *cobra.Command, args []string) error {
 				MinimumDelegationFee:    initPOSManagerFlags.minimumDelegationFee,
 				MaximumStakeMultiplier:  initPOSManagerFlags.maximumStakeMultiplier,
 				WeightToValueFactor:     big.NewInt(int64(initPOSManagerFlags.weightToValueFactor)),
-				RewardCalculatorAddress: initPOSManagerFlags.rewardCalculatorAddress,
+				RewardCalculatorAddress: rewardCalculatorAddress,
 				UptimeBlockchainID:      blockchainID,
+				StakingTokenAddress:     sc.StakingTokenAddress,
 			},
 			validatorManagerAddress,
 		); err != nil {
 			return err
 		}
-		ux.Logger.GreenCheckmarkToUser("Native Token Proof of Stake Validator Manager contract successfully initialized on blockchain %s", blockchainName)
+		ux.Logger.GreenCheckmarkToUser("Proof of Stake Validator Manager contract successfully initialized on blockchain %s", blockchainName)
+		scNetwork.RewardCalculatorAddress = rewardCalculatorAddress
+		sc.Networks[network.Name()] = scNetwork
+		if err := app.UpdateSidecar(&sc); err != nil {
+			return err
+		}
 	default: // unsupported
 		return fmt.Errorf("only PoA and PoS supported")
 	}
 	return nil
 }
+
+// getRewardCalculatorAddress returns the reward calculator to initialize the PoS Validator Manager
+// with, deploying it first if a custom artifact or reward basis points were given
+func getRewardCalculatorAddress(rpcURL string, privateKey string) (string, error) {
+	if !flags.EnsureMutuallyExclusive([]bool{
+		initPOSManagerFlags.rewardCalculatorAddress != "",
+		initPOSManagerFlags.rewardCalculator != "",
+		initPOSManagerFlags.rewardBasisPoints != 0,
+	}) {
+		return "", fmt.Errorf("--pos-reward-calculator-address, --pos-reward-calculator and --pos-reward-basis-points are mutually exclusive")
+	}
+	switch {
+	case initPOSManagerFlags.rewardCalculatorAddress != "":
+		if !common.IsHexAddress(initPOSManagerFlags.rewardCalculatorAddress) {
+			return "", fmt.Errorf("invalid reward calculator address %q", initPOSManagerFlags.rewardCalculatorAddress)
+		}
+		return initPOSManagerFlags.rewardCalculatorAddress, nil
+	case initPOSManagerFlags.rewardCalculator != "":
+		rewardCalculator, err := validatormanager.LoadRewardCalculatorArtifact(utils.ExpandHome(initPOSManagerFlags.rewardCalculator))
+		if err != nil {
+			return "", err
+		}
+		ux.Logger.PrintToUser("Deploying reward calculator %s ...", rewardCalculator.Path)
+		rewardCalculatorAddress, err := validatormanager.DeployCustomRewardCalculatorContract(rpcURL, privateKey, rewardCalculator)
+		if err != nil {
+			return "", err
+		}
+		ux.Logger.PrintToUser("Reward calculator deployed at %s", rewardCalculatorAddress.Hex())
+		return rewardCalculatorAddress.Hex(), nil
+	case initPOSManagerFlags.rewardBasisPoints != 0:
+		ux.Logger.PrintToUser("Deploying example reward calculator with %d reward basis points ...", initPOSManagerFlags.rewardBasisPoints)
+		rewardCalculatorAddress, err := validatormanager.DeployRewardCalculatorContract(rpcURL, privateKey, initPOSManagerFlags.rewardBasisPoints)
+		if err != nil {
+			return "", err
+		}
+		ux.Logger.PrintToUser("Reward calculator deployed at %s", rewardCalculatorAddress.Hex())
+		return rewardCalculatorAddress.Hex(), nil
+	default:
+		return validatorManagerSDK.RewardCalculatorAddress, nil
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package relayercmd
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/localnet"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/networkoptions"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+var configNetworkOptions = []networkoptions.NetworkOption{
+	networkoptions.Local,
+	networkoptions.Fuji,
+}
+
+// avalanche interchain relayer config
+func newConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect AWM relayer configuration",
+		Long: `The relayer config command suite provides tools to check the local AWM relayer
+configuration before the relayer is started.`,
+		RunE: cobrautils.CommandSuiteUsage,
+		Args: cobrautils.ExactArgs(0),
+	}
+	// relayer config validate
+	cmd.AddCommand(newConfigValidateCmd())
+	// relayer config diff
+	cmd.AddCommand(newConfigDiffCmd())
+	return cmd
+}
+
+// returns the path of the local relayer config for the network selected by [networkFlags]
+func getRelayerConfigPath(networkFlags networkoptions.NetworkFlags) (string, error) {
+	network, err := networkoptions.GetNetworkFromCmdLineFlags(
+		app,
+		"",
+		networkFlags,
+		true,
+		false,
+		configNetworkOptions,
+		"",
+	)
+	if err != nil {
+		return "", err
+	}
+	localNetworkRootDir := ""
+	if network.Kind == models.Local {
+		localNetworkRootDir, err = localnet.GetLocalNetworkDir(app)
+		if err != nil {
+			return "", err
+		}
+	}
+	relayerConfigPath := app.GetLocalRelayerConfigPath(network.Kind, localNetworkRootDir)
+	if !utils.FileExists(relayerConfigPath) {
+		return "", fmt.Errorf("there is no relayer configuration available")
+	}
+	return relayerConfigPath, nil
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package relayercmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/interchain/relayer"
+	"github.com/ava-labs/avalanche-cli/pkg/networkoptions"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanchego/utils/logging"
+
+	"github.com/spf13/cobra"
+)
+
+var configDiffNetworkFlags networkoptions.NetworkFlags
+
+// avalanche interchain relayer config diff
+func newConfigDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [otherConfigPath]",
+		Short: "shows differences against another AWM relayer configuration",
+		Long: `Shows the differences between the local AWM relayer configuration and the one at
+[otherConfigPath], for the global settings and for each source and destination.
+Relayer keys are compared by their address.`,
+		RunE: configDiff,
+		Args: cobrautils.ExactArgs(1),
+	}
+	networkoptions.AddNetworkFlagsToCmd(cmd, &configDiffNetworkFlags, true, configNetworkOptions)
+	return cmd
+}
+
+func configDiff(_ *cobra.Command, args []string) error {
+	relayerConfigPath, err := getRelayerConfigPath(configDiffNetworkFlags)
+	if err != nil {
+		return err
+	}
+	currentConfig, err := relayer.LoadRelayerConfig(relayerConfigPath)
+	if err != nil {
+		return err
+	}
+	otherConfig, err := relayer.LoadRelayerConfig(args[0])
+	if err != nil {
+		return fmt.Errorf("failure loading %s: %w", args[0], err)
+	}
+	diffs := relayer.DiffRelayerConfigs(currentConfig, otherConfig)
+	if len(diffs) == 0 {
+		ux.Logger.PrintToUser("No differences found")
+		return nil
+	}
+	for _, diff := range diffs {
+		entry := diff.Role
+		if diff.BlockchainID != "" {
+			entry = fmt.Sprintf("%s %s", diff.Role, diff.BlockchainID)
+		}
+		switch diff.Kind {
+		case relayer.RelayerConfigEntryAdded:
+			ux.Logger.PrintToUser(logging.Green.Wrap(fmt.Sprintf("+ %s", entry)))
+		case relayer.RelayerConfigEntryRemoved:
+			ux.Logger.PrintToUser(logging.Red.Wrap(fmt.Sprintf("- %s", entry)))
+		default:
+			ux.Logger.PrintToUser(logging.Yellow.Wrap(fmt.Sprintf("~ %s", entry)))
+			ux.Logger.PrintToUser("    %s", strings.Join(diff.Details, "\n    "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package relayercmd
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/interchain/relayer"
+	"github.com/ava-labs/avalanche-cli/pkg/networkoptions"
+	"github.com/ava-labs/avalanche-cli/pkg/precompiles"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/pkg/vm"
+	"github.com/ava-labs/avalanchego/utils/logging"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+type ConfigValidateFlags struct {
+	Network    networkoptions.NetworkFlags
+	MinBalance float64
+}
+
+var configValidateFlags ConfigValidateFlags
+
+// avalanche interchain relayer config validate
+func newConfigValidateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "validates AWM relayer configuration",
+		Long: `Validates every source and destination on the local AWM relayer configuration.
+
+For each one, it checks that the RPC endpoint is reachable and that the configured
+blockchain ID matches the one reported by the chain warp precompile. For sources, it
+checks that the ICM messenger and registry contracts are deployed and that the reward
+address is set. For destinations, it checks that the relayer key is set and funded.`,
+		RunE: configValidate,
+		Args: cobrautils.ExactArgs(0),
+	}
+	networkoptions.AddNetworkFlagsToCmd(cmd, &configValidateFlags.Network, true, configNetworkOptions)
+	cmd.Flags().Float64Var(&configValidateFlags.MinBalance, "min-balance", 1, "minimum AVAX balance required for the relayer key on each destination")
+	return cmd
+}
+
+func configValidate(_ *cobra.Command, _ []string) error {
+	relayerConfigPath, err := getRelayerConfigPath(configValidateFlags.Network)
+	if err != nil {
+		return err
+	}
+	relayerConfig, err := relayer.LoadRelayerConfig(relayerConfigPath)
+	if err != nil {
+		return err
+	}
+	minBalance, _ := new(big.Float).Mul(
+		big.NewFloat(configValidateFlags.MinBalance),
+		new(big.Float).SetInt(vm.OneAvax),
+	).Int(nil)
+	checks := relayer.ValidateRelayerConfig(relayerConfig, precompiles.WarpPrecompileGetBlockchainID, minBalance)
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Role", "Blockchain ID", "Check", "Result", "Details"})
+	failed := 0
+	for _, check := range checks {
+		result := logging.Green.Wrap("ok")
+		if !check.Passed {
+			result = logging.Red.Wrap("failed")
+			failed++
+		}
+		t.AppendRow(table.Row{check.Role, check.BlockchainID, check.Check, result, check.Details})
+	}
+	ux.Logger.PrintToUser(t.Render())
+	if failed > 0 {
+		return fmt.Errorf("relayer configuration %s failed %d of %d checks", relayerConfigPath, failed, len(checks))
+	}
+	ux.Logger.GreenCheckmarkToUser("Relayer configuration %s is valid", relayerConfigPath)
+	return nil
+}
@@ -24,7 +24,9 @@ and configuring an ICM relayers.`,
 	cmd.AddCommand(newLogsCmd())
 	cmd.AddCommand(newStartCmd())
 	cmd.AddCommand(newStopCmd())
-	// TODO: config
+	cmd.AddCommand(newStatusCmd())
+	cmd.AddCommand(newSuperviseCmd())
+	cmd.AddCommand(newConfigCmd())
 	// TODO: fund
 	return cmd
 }
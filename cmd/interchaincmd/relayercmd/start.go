@@ -4,6 +4,7 @@ package relayercmd
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
 	"github.com/ava-labs/avalanche-cli/pkg/constants"
@@ -15,6 +16,7 @@ import (
 	"github.com/ava-labs/avalanche-cli/pkg/ssh"
 	"github.com/ava-labs/avalanche-cli/pkg/utils"
 	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/pkg/vm"
 
 	"github.com/spf13/cobra"
 )
@@ -26,9 +28,14 @@ var startNetworkOptions = []networkoptions.NetworkOption{
 }
 
 type StartFlags struct {
-	Network networkoptions.NetworkFlags
-	BinPath string
-	Version string
+	Network       networkoptions.NetworkFlags
+	BinPath       string
+	Version       string
+	Supervise     bool
+	FundingKey    string
+	BalanceFloor  float64
+	LogMaxSizeMB  int
+	LogMaxBackups int
 }
 
 var startFlags StartFlags
@@ -38,9 +45,14 @@ func newStartCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "start",
 		Short: "starts AWM relayer",
-		Long:  `Starts AWM relayer on the specified network (Currently only for local network).`,
-		RunE:  start,
-		Args:  cobrautils.ExactArgs(0),
+		Long: `Starts AWM relayer on the specified network (Currently only for local network).
+
+With --supervise, the relayer is run under a supervisor that restarts it with backoff
+if it exits, rotates its logs, and tops up the relayer balance on each destination
+with the given funding key whenever it falls under the balance floor. Restarts and
+top ups are shown by relayer status.`,
+		RunE: start,
+		Args: cobrautils.ExactArgs(0),
 	}
 	networkoptions.AddNetworkFlagsToCmd(cmd, &startFlags.Network, true, startNetworkOptions)
 	cmd.Flags().StringVar(&startFlags.BinPath, "bin-path", "", "use the given relayer binary")
@@ -50,6 +62,11 @@ func newStartCmd() *cobra.Command {
 		constants.DefaultRelayerVersion,
 		"version to use",
 	)
+	cmd.Flags().BoolVar(&startFlags.Supervise, "supervise", false, "run the relayer under a supervisor (local machine only)")
+	cmd.Flags().StringVar(&startFlags.FundingKey, "funding-key", "", "stored key used by the supervisor to top up the relayer balances")
+	cmd.Flags().Float64Var(&startFlags.BalanceFloor, "balance-floor", 10, "top up the relayer balance on a destination when under this AVAX amount")
+	cmd.Flags().IntVar(&startFlags.LogMaxSizeMB, "log-max-size", 100, "rotate the supervised relayer log when reaching this size in MB")
+	cmd.Flags().IntVar(&startFlags.LogMaxBackups, "log-max-backups", 5, "number of rotated supervised relayer logs to keep")
 	return cmd
 }
 
@@ -73,6 +90,9 @@ func CallStart(_ []string, flags StartFlags, network models.Network) error {
 			return err
 		}
 	}
+	if flags.Supervise && network.ClusterName != "" {
+		return fmt.Errorf("--supervise is only supported for relayers running on the local machine")
+	}
 	switch {
 	case network.ClusterName != "":
 		host, err := node.GetICMRelayerHost(app, network.ClusterName)
@@ -108,15 +128,36 @@ func CallStart(_ []string, flags StartFlags, network models.Network) error {
 		}
 		if !utils.FileExists(relayerConfigPath) {
 			return fmt.Errorf("there is no relayer configuration available")
-		} else if binPath, err := relayer.DeployRelayer(
-			flags.Version,
-			flags.BinPath,
-			app.GetICMRelayerBinDir(),
-			relayerConfigPath,
-			app.GetLocalRelayerLogPath(network.Kind),
-			app.GetLocalRelayerRunPath(network.Kind),
-			app.GetLocalRelayerStorageDir(network.Kind),
-		); err != nil {
+		}
+		var binPath string
+		if flags.Supervise {
+			supervisorConfig, err := getSupervisorConfig(network, flags)
+			if err != nil {
+				return err
+			}
+			binPath, err = relayer.DeploySupervisedRelayer(
+				flags.Version,
+				flags.BinPath,
+				app.GetICMRelayerBinDir(),
+				relayerConfigPath,
+				app.GetLocalRelayerLogPath(network.Kind),
+				app.GetLocalRelayerRunPath(network.Kind),
+				app.GetLocalRelayerStorageDir(network.Kind),
+				app.GetLocalRelayerSupervisorPath(network.Kind),
+				supervisorConfig,
+			)
+		} else {
+			binPath, err = relayer.DeployRelayer(
+				flags.Version,
+				flags.BinPath,
+				app.GetICMRelayerBinDir(),
+				relayerConfigPath,
+				app.GetLocalRelayerLogPath(network.Kind),
+				app.GetLocalRelayerRunPath(network.Kind),
+				app.GetLocalRelayerStorageDir(network.Kind),
+			)
+		}
+		if err != nil {
 			return err
 		} else if network.Kind == models.Local {
 			if err := localnet.WriteExtraLocalNetworkData(app, "", binPath, "", ""); err != nil {
@@ -125,6 +166,32 @@ func CallStart(_ []string, flags StartFlags, network models.Network) error {
 		}
 		ux.Logger.GreenCheckmarkToUser("Local AWM Relayer successfully started for %s", network.Kind)
 		ux.Logger.PrintToUser("Logs can be found at %s", app.GetLocalRelayerLogPath(network.Kind))
+		if flags.Supervise {
+			ux.Logger.PrintToUser("Supervisor events can be found at %s", app.GetLocalRelayerEventsPath(network.Kind))
+		}
 	}
 	return nil
 }
+
+func getSupervisorConfig(network models.Network, flags StartFlags) (relayer.SupervisorConfig, error) {
+	supervisorConfig := relayer.SupervisorConfig{
+		EventsPath:    app.GetLocalRelayerEventsPath(network.Kind),
+		LogMaxSizeMB:  flags.LogMaxSizeMB,
+		LogMaxBackups: flags.LogMaxBackups,
+	}
+	if flags.FundingKey == "" {
+		ux.Logger.PrintToUser("No funding key given. The supervisor will not top up relayer balances")
+		return supervisorConfig, nil
+	}
+	fundingKeyPath := app.GetKeyPath(flags.FundingKey)
+	if !utils.FileExists(fundingKeyPath) {
+		return supervisorConfig, fmt.Errorf("funding key %s does not exist", flags.FundingKey)
+	}
+	balanceFloor, _ := new(big.Float).Mul(
+		big.NewFloat(flags.BalanceFloor),
+		new(big.Float).SetInt(vm.OneAvax),
+	).Int(nil)
+	supervisorConfig.FundingKeyPath = fundingKeyPath
+	supervisorConfig.BalanceFloor = balanceFloor
+	return supervisorConfig, nil
+}
@@ -0,0 +1,210 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package relayercmd
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/interchain/relayer"
+	"github.com/ava-labs/avalanche-cli/pkg/localnet"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/networkoptions"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/pkg/vm"
+	"github.com/ava-labs/avalanchego/utils/logging"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+const localRelayerHost = "127.0.0.1"
+
+var statusNetworkOptions = []networkoptions.NetworkOption{
+	networkoptions.Local,
+	networkoptions.Fuji,
+}
+
+type StatusFlags struct {
+	Network          networkoptions.NetworkFlags
+	BalanceThreshold float64
+	PendingBlocks    uint64
+	Events           int
+}
+
+var statusFlags StatusFlags
+
+// avalanche interchain relayer status
+func newStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "shows AWM relayer status",
+		Long: `Shows the status of the local AWM relayer, as reported by its health and metrics
+endpoints. For each source and destination pair it shows the last processed height,
+the successful and failed deliveries, and the messages sent on the latest source blocks
+that are still pending delivery.
+
+It also shows the balance of the relayer key on each destination blockchain, warning
+if it is below the given threshold, and the latest restarts and top ups made by the
+supervisor if the relayer was started with --supervise.`,
+		RunE: status,
+		Args: cobrautils.ExactArgs(0),
+	}
+	networkoptions.AddNetworkFlagsToCmd(cmd, &statusFlags.Network, true, statusNetworkOptions)
+	cmd.Flags().Float64Var(&statusFlags.BalanceThreshold, "balance-threshold", 10, "warn if the relayer balance on a destination is below this AVAX amount")
+	cmd.Flags().Uint64Var(&statusFlags.PendingBlocks, "pending-blocks", 1000, "number of latest source blocks to inspect for pending messages")
+	cmd.Flags().IntVar(&statusFlags.Events, "events", 10, "number of latest supervisor events to show")
+	return cmd
+}
+
+func status(_ *cobra.Command, _ []string) error {
+	network, err := networkoptions.GetNetworkFromCmdLineFlags(
+		app,
+		"",
+		statusFlags.Network,
+		true,
+		false,
+		statusNetworkOptions,
+		"",
+	)
+	if err != nil {
+		return err
+	}
+	localNetworkRootDir := ""
+	if network.Kind == models.Local {
+		localNetworkRootDir, err = localnet.GetLocalNetworkDir(app)
+		if err != nil {
+			return err
+		}
+	}
+	relayerConfigPath := app.GetLocalRelayerConfigPath(network.Kind, localNetworkRootDir)
+	if !utils.FileExists(relayerConfigPath) {
+		return fmt.Errorf("there is no relayer configuration available")
+	}
+	relayerIsUp, pid, _, err := relayer.RelayerIsUp(app.GetLocalRelayerRunPath(network.Kind))
+	if err != nil {
+		return err
+	}
+	relayerStatus, err := relayer.GetRelayerStatus(relayerConfigPath, localRelayerHost, statusFlags.PendingBlocks)
+	if err != nil {
+		return err
+	}
+	blockchainIDToBlockchainName, err := getBlockchainIDToBlockchainNameMap(network)
+	if err != nil {
+		// names are only informative
+		blockchainIDToBlockchainName = map[string]string{}
+	}
+	blockchainName := func(blockchainID string) string {
+		if name := blockchainIDToBlockchainName[blockchainID]; name != "" {
+			return name
+		}
+		return blockchainID
+	}
+
+	if relayerIsUp {
+		ux.Logger.PrintToUser("Process:  %s (pid %d)", logging.Green.Wrap("running"), pid)
+	} else {
+		ux.Logger.PrintToUser("Process:  %s", logging.Red.Wrap("not running"))
+	}
+	if relayerStatus.Healthy {
+		ux.Logger.PrintToUser("Health:   %s", logging.Green.Wrap("healthy"))
+	} else {
+		ux.Logger.PrintToUser("Health:   %s (%s)", logging.Red.Wrap("unhealthy"), relayerStatus.HealthDetails)
+	}
+	ux.Logger.PrintToUser("Health endpoint:  %s", relayerStatus.HealthEndpoint)
+	ux.Logger.PrintToUser("Metrics endpoint: %s", relayerStatus.MetricsEndpoint)
+	ux.Logger.PrintToUser("")
+
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Source", "Destination", "Source Height", "Last Processed", "Successful", "Failed", "Pending"})
+	for _, pair := range relayerStatus.Pairs {
+		lastProcessed := "-"
+		if pair.HasLastProcessed {
+			lastProcessed = fmt.Sprintf("%d", pair.LastProcessedHeight)
+		}
+		failed := fmt.Sprintf("%d", pair.Failed)
+		if pair.Failed > 0 {
+			failed = logging.Red.Wrap(failed)
+		}
+		pending := fmt.Sprintf("%d", pair.Pending)
+		if pair.Pending > 0 {
+			pending = logging.Yellow.Wrap(pending)
+		}
+		t.AppendRow(table.Row{
+			blockchainName(pair.SourceBlockchainID),
+			blockchainName(pair.DestinationBlockchainID),
+			pair.SourceHeight,
+			lastProcessed,
+			pair.Successful,
+			failed,
+			pending,
+		})
+	}
+	ux.Logger.PrintToUser(t.Render())
+	ux.Logger.PrintToUser("")
+
+	thresholdWei, _ := new(big.Float).Mul(
+		big.NewFloat(statusFlags.BalanceThreshold),
+		new(big.Float).SetInt(vm.OneAvax),
+	).Int(nil)
+	lowBalances := []relayer.RelayerBalance{}
+	t = table.NewWriter()
+	t.AppendHeader(table.Row{"Destination", "Relayer Address", "Balance"})
+	for _, balance := range relayerStatus.Balances {
+		balanceStr := fmt.Sprintf("%.4f AVAX", weiToAvax(balance.Balance))
+		if balance.Balance.Cmp(thresholdWei) < 0 {
+			balanceStr = logging.Red.Wrap(balanceStr)
+			lowBalances = append(lowBalances, balance)
+		}
+		t.AppendRow(table.Row{blockchainName(balance.BlockchainID), balance.Address, balanceStr})
+	}
+	ux.Logger.PrintToUser(t.Render())
+	for _, balance := range lowBalances {
+		ux.Logger.RedXToUser(
+			"relayer balance on %s is below %.4f AVAX. Consider funding %s",
+			blockchainName(balance.BlockchainID),
+			statusFlags.BalanceThreshold,
+			balance.Address,
+		)
+	}
+	events, err := relayer.GetSupervisorEvents(app.GetLocalRelayerEventsPath(network.Kind), statusFlags.Events)
+	if err != nil {
+		return err
+	}
+	if len(events) > 0 {
+		ux.Logger.PrintToUser("")
+		ux.Logger.PrintToUser("Supervisor events:")
+		t = table.NewWriter()
+		t.AppendHeader(table.Row{"Time", "Event", "Details"})
+		for _, event := range events {
+			kind := event.Kind
+			switch kind {
+			case relayer.SupervisorEventExit, relayer.SupervisorEventTopUpFailure, relayer.SupervisorEventBalanceFailed:
+				kind = logging.Red.Wrap(kind)
+			case relayer.SupervisorEventRestart, relayer.SupervisorEventTopUp:
+				kind = logging.Yellow.Wrap(kind)
+			}
+			t.AppendRow(table.Row{event.Time.Local().Format(time.DateTime), kind, event.Message})
+		}
+		ux.Logger.PrintToUser(t.Render())
+	}
+	if len(relayerStatus.Errors) > 0 {
+		ux.Logger.PrintToUser("")
+		for _, errStr := range relayerStatus.Errors {
+			ux.Logger.PrintToUser(logging.Yellow.Wrap(fmt.Sprintf("Warning: %s", errStr)))
+		}
+	}
+	return nil
+}
+
+// converts a wei amount into AVAX
+func weiToAvax(wei *big.Int) float64 {
+	avax, _ := new(big.Float).Quo(
+		new(big.Float).SetInt(wei),
+		new(big.Float).SetInt(vm.OneAvax),
+	).Float64()
+	return avax
+}
@@ -0,0 +1,28 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package relayercmd
+
+import (
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/interchain/relayer"
+
+	"github.com/spf13/cobra"
+)
+
+// avalanche interchain relayer supervise
+//
+// executed in background by relayer start --supervise
+func newSuperviseCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:    "supervise [supervisorConfigPath]",
+		Short:  "runs a supervised AWM relayer",
+		Long:   `Runs AWM relayer under a supervisor, as configured by relayer start --supervise.`,
+		RunE:   supervise,
+		Args:   cobrautils.ExactArgs(1),
+		Hidden: true,
+	}
+}
+
+func supervise(_ *cobra.Command, args []string) error {
+	return relayer.RunSupervisor(args[0])
+}
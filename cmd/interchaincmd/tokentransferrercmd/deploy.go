@@ -6,6 +6,7 @@ import (
 	_ "embed"
 	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	cmdflags "github.com/ava-labs/avalanche-cli/cmd/flags"
@@ -50,6 +51,7 @@ type DeployFlags struct {
 	homeFlags   HomeFlags
 	remoteFlags RemoteFlags
 	version     string
+	customBuild bool
 }
 
 var deployFlags DeployFlags
@@ -83,7 +85,12 @@ func NewDeployCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&deployFlags.homeFlags.native, "deploy-native-home", false, "deploy a Transferrer Home for the Chain's Native Token")
 	cmd.Flags().StringVar(&deployFlags.homeFlags.erc20Address, "deploy-erc20-home", "", "deploy a Transferrer Home for the given Chain's ERC20 Token")
 	cmd.Flags().StringVar(&deployFlags.homeFlags.homeAddress, "use-home", "", "use the given Transferrer's Home Address")
-	cmd.Flags().StringVar(&deployFlags.version, "version", constants.ICTTVersion, "tag/branch/commit of Avalanche Interchain Token Transfer (ICTT) to be used")
+	versionDesc := "tag/branch/commit of Avalanche Interchain Token Transfer (ICTT) to be used"
+	if embeddedVersions := ictt.EmbeddedVersions(); len(embeddedVersions) > 0 {
+		versionDesc += fmt.Sprintf(" (embedded: %s)", strings.Join(embeddedVersions, ", "))
+	}
+	cmd.Flags().StringVar(&deployFlags.version, "version", constants.ICTTVersion, versionDesc)
+	cmd.Flags().BoolVar(&deployFlags.customBuild, "custom-build", false, "download and build ICTT contracts with forge, instead of using the ones embedded on the CLI")
 	cmd.Flags().BoolVar(&deployFlags.remoteFlags.native, "deploy-native-remote", false, "deploy a Transferrer Remote for the Chain's Native Token")
 	cmd.Flags().BoolVar(&deployFlags.remoteFlags.removeMinterAdmin, "remove-minter-admin", false, "remove the native minter precompile admin found on remote blockchain genesis")
 	deployFlags.homeFlags.privateKeyFlags.SetFlagNames("home-private-key", "home-key", "home-genesis-key")
@@ -138,11 +145,6 @@ func getHomeKeyAndAddress(app *application.Avalanche, network models.Network, ho
 }
 
 func CallDeploy(_ []string, flags DeployFlags) error {
-	if !ictt.FoundryIsInstalled() {
-		if err := ictt.InstallFoundry(); err != nil {
-			return err
-		}
-	}
 	network, err := networkoptions.GetNetworkFromCmdLineFlags(
 		app,
 		"On what Network do you want to deploy the Transferrer?",
@@ -482,20 +484,21 @@ func CallDeploy(_ []string, flags DeployFlags) error {
 	}
 
 	// Setup Contracts
-	ux.Logger.PrintToUser("Downloading Avalanche ICTT Contracts")
-	if err := ictt.DownloadRepo(app, flags.version); err != nil {
-		return err
+	var icttArtifacts ictt.Artifacts
+	if !flags.customBuild && !ictt.HasEmbeddedVersion(flags.version) {
+		ux.Logger.PrintToUser(logging.Yellow.Wrap("ICTT version %s is not embedded on the CLI. Building it from source"), flags.version)
+		flags.customBuild = true
 	}
-	ux.Logger.PrintToUser("Compiling Avalanche ICTT Contracts")
-	if err := ictt.BuildContracts(app); err != nil {
-		return err
+	if flags.customBuild {
+		icttArtifacts, err = ictt.BuildArtifacts(app, flags.version)
+	} else {
+		icttArtifacts, err = ictt.GetEmbeddedArtifacts(flags.version)
 	}
-
-	// Home Deploy
-	icttSrcDir, err := ictt.RepoDir(app)
 	if err != nil {
 		return err
 	}
+
+	// Home Deploy
 	var homeAddress common.Address
 	// TODO: need registry address, manager address, private key for the home chain (academy for fuji)
 	homeBlockchainID, err := contract.GetBlockchainID(app, network, flags.homeFlags.chainFlags)
@@ -519,7 +522,7 @@ func CallDeploy(_ []string, flags DeployFlags) error {
 			return err
 		}
 		homeAddress, err = ictt.DeployERC20Home(
-			icttSrcDir,
+			icttArtifacts,
 			homeRPCEndpoint,
 			homeKey,
 			common.HexToAddress(homeRegistryAddress),
@@ -543,7 +546,7 @@ func CallDeploy(_ []string, flags DeployFlags) error {
 			return err
 		}
 		wrappedNativeTokenAddress, err := ictt.DeployWrappedNativeToken(
-			icttSrcDir,
+			icttArtifacts,
 			homeRPCEndpoint,
 			homeKey,
 			nativeTokenSymbol,
@@ -555,7 +558,7 @@ func CallDeploy(_ []string, flags DeployFlags) error {
 		ux.Logger.PrintToUser("%s Address: %s", nativeTokenSymbol, wrappedNativeTokenAddress)
 		ux.Logger.PrintToUser("")
 		homeAddress, err = ictt.DeployNativeHome(
-			icttSrcDir,
+			icttArtifacts,
 			homeRPCEndpoint,
 			homeKey,
 			common.HexToAddress(homeRegistryAddress),
@@ -625,7 +628,7 @@ func CallDeploy(_ []string, flags DeployFlags) error {
 			remoteDecimals = flags.remoteFlags.Decimals
 		}
 		remoteAddress, err = ictt.DeployERC20Remote(
-			icttSrcDir,
+			icttArtifacts,
 			remoteRPCEndpoint,
 			remoteKey,
 			common.HexToAddress(remoteRegistryAddress),
@@ -657,7 +660,7 @@ func CallDeploy(_ []string, flags DeployFlags) error {
 			return err
 		}
 		remoteAddress, err = ictt.DeployNativeRemote(
-			icttSrcDir,
+			icttArtifacts,
 			remoteRPCEndpoint,
 			remoteKey,
 			common.HexToAddress(remoteRegistryAddress),
@@ -750,7 +753,7 @@ func CallDeploy(_ []string, flags DeployFlags) error {
 		}
 
 		// Send a single token unit to report that the remote is collateralized.
-		err = ictt.Send(
+		_, err = ictt.Send(
 			homeRPCEndpoint,
 			homeAddress,
 			homeKey,
@@ -758,6 +761,7 @@ func CallDeploy(_ []string, flags DeployFlags) error {
 			remoteAddress,
 			common.HexToAddress(homeKeyAddress),
 			big.NewInt(1),
+			ictt.MultiHopSettings{},
 		)
 		if err != nil {
 			return err
@@ -0,0 +1,203 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package tokentransferrercmd
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	"github.com/ava-labs/avalanche-cli/pkg/ictt"
+	"github.com/ava-labs/avalanche-cli/pkg/networkoptions"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+type DescribeFlags struct {
+	Network         networkoptions.NetworkFlags
+	homeChainFlags  contract.ChainSpec
+	homeAddress     string
+	homeRPCEndpoint string
+	remoteRPCs      map[string]string
+	fromBlock       uint64
+}
+
+var describeFlags DescribeFlags
+
+// avalanche interchain tokenTransferrer describe
+func NewDescribeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "Describes a Token Transferrer Home and its registered Remotes",
+		Long: `Describes a Token Transferrer Home and all the Remotes registered on it.
+
+For each Remote it shows the collateral status, the decimals scaling applied between
+Home and Remote, the amount locked on the Home and the supply outstanding on the Remote.
+Remotes are inspected on their own chain if the chain is known to the CLI or its RPC is given
+by --remote-rpc, flagging undercollateralized Remotes and mismatches between the amount locked
+on the Home and the amount minted on the Remote. Transfers in flight can produce temporary
+mismatches.`,
+		RunE: describe,
+		Args: cobrautils.ExactArgs(0),
+	}
+	networkoptions.AddNetworkFlagsToCmd(cmd, &describeFlags.Network, true, networkoptions.DefaultSupportedNetworkOptions)
+	describeFlags.homeChainFlags.SetFlagNames(
+		"home-chain",
+		"c-chain-home",
+		"",
+		"",
+		"",
+	)
+	describeFlags.homeChainFlags.AddToCmd(cmd, "describe the Transferrer's Home on %s")
+	cmd.Flags().StringVar(&describeFlags.homeAddress, "home-address", "", "address of the Transferrer's Home")
+	cmd.Flags().StringVar(&describeFlags.homeRPCEndpoint, "home-rpc", "", "use the given RPC URL to connect to the home blockchain")
+	cmd.Flags().StringToStringVar(&describeFlags.remoteRPCs, "remote-rpc", nil, "use the given RPC URLs to connect to remote blockchains, as blockchainID=URL pairs")
+	cmd.Flags().Uint64Var(&describeFlags.fromBlock, "from-block", 0, "search for Remote registrations starting at this Home block")
+	return cmd
+}
+
+func describe(_ *cobra.Command, _ []string) error {
+	network, err := networkoptions.GetNetworkFromCmdLineFlags(
+		app,
+		"On what Network is the Transferrer deployed?",
+		describeFlags.Network,
+		true,
+		false,
+		networkoptions.DefaultSupportedNetworkOptions,
+		"",
+	)
+	if err != nil {
+		return err
+	}
+	if err := describeFlags.homeChainFlags.CheckMutuallyExclusiveFields(); err != nil {
+		return err
+	}
+	if !describeFlags.homeChainFlags.Defined() {
+		prompt := "Where is the Transferrer's Home?"
+		if cancel, err := contract.PromptChain(app, network, prompt, "", &describeFlags.homeChainFlags); err != nil {
+			return err
+		} else if cancel {
+			return nil
+		}
+	}
+	homeRPCEndpoint := describeFlags.homeRPCEndpoint
+	if homeRPCEndpoint == "" {
+		homeRPCEndpoint, _, err = contract.GetBlockchainEndpoints(app, network, describeFlags.homeChainFlags, true, false)
+		if err != nil {
+			return err
+		}
+	}
+	if describeFlags.homeAddress == "" {
+		addr, err := app.Prompt.CaptureAddress("Enter the address of the Transferrer's Home")
+		if err != nil {
+			return err
+		}
+		describeFlags.homeAddress = addr.Hex()
+	}
+	if !common.IsHexAddress(describeFlags.homeAddress) {
+		return fmt.Errorf("invalid home address %q", describeFlags.homeAddress)
+	}
+	remoteRPCEndpoints, blockchainNames, err := contract.GetKnownBlockchainEndpoints(app, network)
+	if err != nil {
+		return err
+	}
+	for blockchainIDStr, rpcEndpoint := range describeFlags.remoteRPCs {
+		blockchainID, err := ids.FromString(blockchainIDStr)
+		if err != nil {
+			return fmt.Errorf("invalid blockchain ID %q on --remote-rpc: %w", blockchainIDStr, err)
+		}
+		remoteRPCEndpoints[blockchainID] = rpcEndpoint
+	}
+	description, err := ictt.DescribeTokenHome(
+		homeRPCEndpoint,
+		common.HexToAddress(describeFlags.homeAddress),
+		remoteRPCEndpoints,
+		describeFlags.fromBlock,
+	)
+	if err != nil {
+		return err
+	}
+	homeKind := "ERC20 Token Home"
+	if description.Kind == ictt.NativeTokenHome {
+		homeKind = "Native Token Home"
+	}
+	ux.Logger.PrintToUser("Home:     %s (%s)", description.Address.Hex(), homeKind)
+	ux.Logger.PrintToUser("Decimals: %d", description.TokenDecimals)
+	ux.Logger.PrintToUser("")
+	if len(description.Remotes) == 0 {
+		ux.Logger.PrintToUser("No Remotes registered")
+	} else {
+		t := table.NewWriter()
+		t.AppendHeader(table.Row{"Blockchain", "Remote", "Kind", "Decimals", "Scaling", "Collateral Needed", "Collateralized", "Locked on Home", "Remote Supply"})
+		for _, remote := range description.Remotes {
+			blockchain := remote.BlockchainID.String()
+			if name := blockchainNames[remote.BlockchainID]; name != "" {
+				blockchain = name
+			}
+			kind, collateralized, supply := "-", "-", "-"
+			if remote.OutstandingSupply != nil {
+				kind = remoteKindDesc(remote.Kind)
+				collateralized = fmt.Sprintf("%t", remote.IsCollateralized)
+				supply = remote.OutstandingSupply.String()
+			}
+			collateralNeeded := remote.Home.CollateralNeeded.String()
+			if remote.Home.CollateralNeeded.Sign() > 0 {
+				collateralNeeded = logging.Red.Wrap(collateralNeeded)
+			}
+			t.AppendRow(table.Row{
+				blockchain,
+				remote.Address.Hex(),
+				kind,
+				remote.TokenDecimals,
+				scalingDesc(remote.Home),
+				collateralNeeded,
+				collateralized,
+				remote.TransferredBalance.String(),
+				supply,
+			})
+		}
+		ux.Logger.PrintToUser(t.Render())
+	}
+	issues := 0
+	for _, remote := range description.Remotes {
+		for _, issue := range remote.Issues {
+			ux.Logger.RedXToUser("remote %s: %s", remote.Address.Hex(), issue)
+			issues++
+		}
+	}
+	for _, errStr := range description.Errors {
+		ux.Logger.PrintToUser(logging.Yellow.Wrap(fmt.Sprintf("Warning: %s", errStr)))
+	}
+	if issues == 0 && len(description.Remotes) > 0 {
+		ux.Logger.GreenCheckmarkToUser("No inconsistencies found")
+	}
+	return nil
+}
+
+func remoteKindDesc(kind ictt.EndpointKind) string {
+	switch kind {
+	case ictt.ERC20TokenRemote:
+		return "ERC20"
+	case ictt.NativeTokenRemote:
+		return "Native"
+	}
+	return "-"
+}
+
+// describes how home amounts are converted into remote amounts
+func scalingDesc(remote ictt.RegisteredRemote) string {
+	if remote.TokenMultiplier == nil || remote.TokenMultiplier.Cmp(big.NewInt(1)) == 0 {
+		return "1:1"
+	}
+	op := "/"
+	if remote.MultiplyOnRemote {
+		op = "x"
+	}
+	return fmt.Sprintf("%s %s", op, remote.TokenMultiplier)
+}
@@ -0,0 +1,219 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package tokentransferrercmd
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	"github.com/ava-labs/avalanche-cli/pkg/ictt"
+	"github.com/ava-labs/avalanche-cli/pkg/networkoptions"
+	"github.com/ava-labs/avalanche-cli/pkg/prompts"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/spf13/cobra"
+)
+
+type SendFlags struct {
+	Network                       networkoptions.NetworkFlags
+	originChainFlags              contract.ChainSpec
+	destinationChainFlags         contract.ChainSpec
+	originTransferrerAddress      string
+	destinationTransferrerAddress string
+	keyName                       string
+	destinationAddress            string
+	amount                        float64
+	decimals                      uint8
+	multiHopFallback              string
+	secondaryFee                  float64
+}
+
+var sendFlags SendFlags
+
+// avalanche interchain tokenTransferrer send
+func NewSendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send",
+		Short: "Sends tokens between Token Transferrer endpoints",
+		Long: `Sends tokens from a Token Transferrer Home or Remote into another endpoint of the same
+Transferrer, and waits for each ICM message to be delivered, reporting its message ID.
+
+Transfers between two Remotes are routed through the Home, in two legs. For them, a fallback
+address receives the tokens on the Home chain if the second leg can't be delivered, and a
+secondary fee can be paid to the relayer of the second leg.`,
+		RunE: send,
+		Args: cobrautils.ExactArgs(0),
+	}
+	networkoptions.AddNetworkFlagsToCmd(cmd, &sendFlags.Network, true, networkoptions.DefaultSupportedNetworkOptions)
+	sendFlags.originChainFlags.SetFlagNames(
+		"origin-blockchain",
+		"c-chain-origin",
+		"",
+		"",
+		"origin-blockchain-id",
+	)
+	sendFlags.originChainFlags.AddToCmd(cmd, "send the tokens from %s")
+	sendFlags.destinationChainFlags.SetFlagNames(
+		"destination-blockchain",
+		"c-chain-destination",
+		"",
+		"",
+		"destination-blockchain-id",
+	)
+	sendFlags.destinationChainFlags.AddToCmd(cmd, "send the tokens into %s")
+	cmd.Flags().StringVar(&sendFlags.originTransferrerAddress, "origin-transferrer-address", "", "token transferrer address at the origin blockchain")
+	cmd.Flags().StringVar(&sendFlags.destinationTransferrerAddress, "destination-transferrer-address", "", "token transferrer address at the destination blockchain")
+	cmd.Flags().StringVar(&sendFlags.keyName, "key", "", "CLI stored key to send the tokens from")
+	cmd.Flags().StringVar(&sendFlags.destinationAddress, "destination-address", "", "address to receive the tokens at the destination blockchain")
+	cmd.Flags().Float64Var(&sendFlags.amount, "amount", 0, "amount of tokens to send")
+	cmd.Flags().Uint8Var(&sendFlags.decimals, "decimals", 18, "token decimals at the origin blockchain")
+	cmd.Flags().StringVar(&sendFlags.multiHopFallback, "multi-hop-fallback", "", "address to receive the tokens on the home chain if a remote to remote transfer can't reach its destination [defaults to the sender address]")
+	cmd.Flags().Float64Var(&sendFlags.secondaryFee, "secondary-fee", 0, "relayer fee for the home to destination leg of a remote to remote transfer, in token units")
+	return cmd
+}
+
+func send(_ *cobra.Command, _ []string) error {
+	network, err := networkoptions.GetNetworkFromCmdLineFlags(
+		app,
+		"On what Network is the Transferrer deployed?",
+		sendFlags.Network,
+		true,
+		false,
+		networkoptions.DefaultSupportedNetworkOptions,
+		"",
+	)
+	if err != nil {
+		return err
+	}
+	if err := sendFlags.originChainFlags.CheckMutuallyExclusiveFields(); err != nil {
+		return err
+	}
+	if err := sendFlags.destinationChainFlags.CheckMutuallyExclusiveFields(); err != nil {
+		return err
+	}
+	if !sendFlags.originChainFlags.Defined() {
+		if cancel, err := contract.PromptChain(app, network, "Where are the tokens to send?", "", &sendFlags.originChainFlags); err != nil {
+			return err
+		} else if cancel {
+			return nil
+		}
+	}
+	if !sendFlags.destinationChainFlags.Defined() {
+		if cancel, err := contract.PromptChain(app, network, "Where are the tokens going to?", "", &sendFlags.destinationChainFlags); err != nil {
+			return err
+		} else if cancel {
+			return nil
+		}
+	}
+	originDesc, err := contract.GetBlockchainDesc(sendFlags.originChainFlags)
+	if err != nil {
+		return err
+	}
+	destinationDesc, err := contract.GetBlockchainDesc(sendFlags.destinationChainFlags)
+	if err != nil {
+		return err
+	}
+	originRPCEndpoint, _, err := contract.GetBlockchainEndpoints(app, network, sendFlags.originChainFlags, true, false)
+	if err != nil {
+		return err
+	}
+	originBlockchainID, err := contract.GetBlockchainID(app, network, sendFlags.originChainFlags)
+	if err != nil {
+		return err
+	}
+	destinationBlockchainID, err := contract.GetBlockchainID(app, network, sendFlags.destinationChainFlags)
+	if err != nil {
+		return err
+	}
+	originTransferrerAddress, err := getAddress(sendFlags.originTransferrerAddress, fmt.Sprintf("Enter the address of the Token Transferrer on %s", originDesc))
+	if err != nil {
+		return err
+	}
+	destinationTransferrerAddress, err := getAddress(sendFlags.destinationTransferrerAddress, fmt.Sprintf("Enter the address of the Token Transferrer on %s", destinationDesc))
+	if err != nil {
+		return err
+	}
+	if sendFlags.keyName == "" {
+		sendFlags.keyName, err = prompts.CaptureKeyName(app.Prompt, "send the tokens", app.GetKeyDir(), true)
+		if err != nil {
+			return err
+		}
+	}
+	k, err := app.GetKey(sendFlags.keyName, network, false)
+	if err != nil {
+		return err
+	}
+	destinationAddress, err := getAddress(sendFlags.destinationAddress, "Enter the destination address")
+	if err != nil {
+		return err
+	}
+	if sendFlags.amount == 0 {
+		sendFlags.amount, err = app.Prompt.CaptureFloat("Amount to send (TOKEN units)", func(v float64) error {
+			if v <= 0 {
+				return fmt.Errorf("value %f must be greater than zero", v)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	multiHop := ictt.MultiHopSettings{
+		SecondaryFee: toTokenBaseUnits(sendFlags.secondaryFee, sendFlags.decimals),
+	}
+	if sendFlags.multiHopFallback != "" {
+		multiHop.Fallback, err = getAddress(sendFlags.multiHopFallback, "")
+		if err != nil {
+			return err
+		}
+	}
+	rpcEndpoints, _, err := contract.GetKnownBlockchainEndpoints(app, network)
+	if err != nil {
+		return err
+	}
+	rpcEndpoints[originBlockchainID] = originRPCEndpoint
+	legs, err := ictt.Transfer(
+		ictt.TransferSpec{
+			SourceRPCURL:            originRPCEndpoint,
+			SourceBlockchainID:      originBlockchainID,
+			SourceAddress:           originTransferrerAddress,
+			PrivateKey:              k.PrivKeyHex(),
+			DestinationBlockchainID: destinationBlockchainID,
+			DestinationAddress:      destinationTransferrerAddress,
+			AmountRecipient:         destinationAddress,
+			Amount:                  toTokenBaseUnits(sendFlags.amount, sendFlags.decimals),
+			MultiHop:                multiHop,
+		},
+		rpcEndpoints,
+		constants.ICTTTransferLegTimeout,
+	)
+	if err != nil {
+		return err
+	}
+	if len(legs) > 0 && legs[len(legs)-1].Delivered && legs[len(legs)-1].DestinationBlockchainID == destinationBlockchainID {
+		ux.Logger.GreenCheckmarkToUser("Tokens delivered to %s on %s", destinationAddress.Hex(), destinationDesc)
+	}
+	return nil
+}
+
+// validates [addressStr], prompting for it with [prompt] if empty
+func getAddress(addressStr string, prompt string) (common.Address, error) {
+	if addressStr == "" {
+		return app.Prompt.CaptureAddress(prompt)
+	}
+	if err := prompts.ValidateAddress(addressStr); err != nil {
+		return common.Address{}, err
+	}
+	return common.HexToAddress(addressStr), nil
+}
+
+// converts [amount] token units into base units for a token with [decimals]
+func toTokenBaseUnits(amount float64, decimals uint8) *big.Int {
+	multiplier := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	baseUnits, _ := new(big.Float).Mul(big.NewFloat(amount), new(big.Float).SetInt(multiplier)).Int(nil)
+	return baseUnits
+}
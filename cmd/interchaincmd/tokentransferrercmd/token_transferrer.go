@@ -21,5 +21,9 @@ func NewCmd(injectedApp *application.Avalanche) *cobra.Command {
 	app = injectedApp
 	// tokenTransferrer deploy
 	cmd.AddCommand(NewDeployCmd())
+	// tokenTransferrer describe
+	cmd.AddCommand(NewDescribeCmd())
+	// tokenTransferrer send
+	cmd.AddCommand(NewSendCmd())
 	return cmd
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/ava-labs/avalanche-cli/cmd/blockchaincmd"
 	"github.com/ava-labs/avalanche-cli/pkg/contract"
 	"github.com/ava-labs/avalanche-cli/pkg/key"
+	"github.com/ava-labs/avalanche-cli/pkg/localnet"
 	"github.com/ava-labs/avalanche-cli/pkg/models"
 	"github.com/ava-labs/avalanche-cli/pkg/networkoptions"
 	"github.com/ava-labs/avalanche-cli/pkg/utils"
@@ -246,13 +247,14 @@ func getClients(networks []models.Network, pchain bool, cchain bool, xchain bool
 }
 
 type addressInfo struct {
-	kind    string
-	name    string
-	chain   string
-	token   string
-	address string
-	balance string
-	network string
+	kind          string
+	name          string
+	chain         string
+	token         string
+	address       string
+	balance       string
+	network       string
+	genesisFunded bool
 }
 
 func listKeys(*cobra.Command, []string) error {
@@ -330,10 +332,45 @@ func listKeys(*cobra.Command, []string) error {
 			return err
 		}
 	}
+	if err := markGenesisFundedAddresses(addrInfos); err != nil {
+		return err
+	}
 	printAddrInfos(addrInfos)
 	return nil
 }
 
+// marks the addresses that were funded on the genesis of the currently executing local network
+func markGenesisFundedAddresses(addrInfos []addressInfo) error {
+	genesisAllocations, err := localnet.GetLocalNetworkGenesisAllocations(app)
+	if err != nil {
+		return err
+	}
+	if genesisAllocations == nil {
+		return nil
+	}
+	for i := range addrInfos {
+		addrInfos[i].genesisFunded = isGenesisFunded(genesisAllocations, addrInfos[i])
+	}
+	return nil
+}
+
+// tells if the AVAX balance of [addrInfo] was funded by [genesisAllocations]. Only the
+// primary network chains of the local network are funded on its genesis
+func isGenesisFunded(genesisAllocations *localnet.GenesisAllocations, addrInfo addressInfo) bool {
+	if addrInfo.network != models.NewLocalNetwork().Name() || addrInfo.token != "AVAX" {
+		return false
+	}
+	switch addrInfo.chain {
+	case localnet.PChainAlias, localnet.XChainAlias, localnet.CChainAlias:
+	default:
+		return false
+	}
+	if addrInfo.kind == "stored" && addrInfo.name == "ewoq" {
+		return genesisAllocations.EwoqFunded
+	}
+	return genesisAllocations.IsFunded(addrInfo.chain, addrInfo.address)
+}
+
 func getStoredKeysInfo(
 	clients *Clients,
 	networks []models.Network,
@@ -617,18 +654,27 @@ func printAddrInfos(addrInfos []addressInfo) {
 	table.SetHeader(header)
 	table.SetRowLine(true)
 	table.SetAutoMergeCellsByColumnIndex([]int{0, 1, 2})
+	someGenesisFunded := false
 	for _, addrInfo := range addrInfos {
+		addr := addrInfo.address
+		if addrInfo.genesisFunded {
+			addr += " *"
+			someGenesisFunded = true
+		}
 		table.Append([]string{
 			addrInfo.kind,
 			addrInfo.name,
 			addrInfo.chain,
-			addrInfo.address,
+			addr,
 			addrInfo.token,
 			addrInfo.balance,
 			addrInfo.network,
 		})
 	}
 	table.Render()
+	if someGenesisFunded {
+		ux.Logger.PrintToUser("* funded on local network genesis")
+	}
 }
 
 func getCChainBalanceStr(cClient ethclient.Client, addrStr string) (string, error) {
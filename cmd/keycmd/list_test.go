@@ -0,0 +1,88 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package keycmd
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/pkg/localnet"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsGenesisFunded(t *testing.T) {
+	localNetworkName := models.NewLocalNetwork().Name()
+	const (
+		cChainAddr = "0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC"
+		pChainAddr = "P-custom18jma8ppw3nhx5r4ap8clazz0dps7rv5u9xde7p"
+	)
+	genesisAllocations := &localnet.GenesisAllocations{
+		EwoqFunded: true,
+		FundedAddresses: []localnet.GenesisFundedAddress{
+			{Chain: localnet.CChainAlias, Address: cChainAddr},
+			{Chain: localnet.PChainAlias, Address: pChainAddr},
+		},
+	}
+	ewoq := func(chain string, token string, network string) addressInfo {
+		return addressInfo{kind: "stored", name: "ewoq", chain: chain, token: token, network: network}
+	}
+	tests := []struct {
+		name     string
+		addrInfo addressInfo
+		expected bool
+	}{
+		{
+			name:     "ewoq P-Chain",
+			addrInfo: ewoq(localnet.PChainAlias, "AVAX", localNetworkName),
+			expected: true,
+		},
+		{
+			name:     "ewoq X-Chain",
+			addrInfo: ewoq(localnet.XChainAlias, "AVAX", localNetworkName),
+			expected: true,
+		},
+		{
+			name:     "ewoq C-Chain",
+			addrInfo: ewoq(localnet.CChainAlias, "AVAX", localNetworkName),
+			expected: true,
+		},
+		{
+			name:     "ewoq L1 native token",
+			addrInfo: ewoq("myl1", "TOK (Native)", localNetworkName),
+		},
+		{
+			name:     "ewoq L1 with AVAX symbol",
+			addrInfo: ewoq("myl1", "AVAX", localNetworkName),
+		},
+		{
+			name:     "ewoq C-Chain ERC20",
+			addrInfo: ewoq(localnet.CChainAlias, "TST (0x1234.)", localNetworkName),
+		},
+		{
+			name:     "ewoq on other network",
+			addrInfo: ewoq(localnet.PChainAlias, "AVAX", models.NewFujiNetwork().Name()),
+		},
+		{
+			name:     "funded C-Chain address",
+			addrInfo: addressInfo{kind: "stored", name: "k1", chain: localnet.CChainAlias, token: "AVAX", address: cChainAddr, network: localNetworkName},
+			expected: true,
+		},
+		{
+			name:     "funded P-Chain address with other HRP",
+			addrInfo: addressInfo{kind: "stored", name: "k1", chain: localnet.PChainAlias, token: "AVAX", address: "P-local18jma8ppw3nhx5r4ap8clazz0dps7rv5u00z96u", network: localNetworkName},
+			expected: true,
+		},
+		{
+			name:     "funded address on other chain",
+			addrInfo: addressInfo{kind: "stored", name: "k1", chain: localnet.XChainAlias, token: "AVAX", address: "X-custom18jma8ppw3nhx5r4ap8clazz0dps7rv5u9xde7p", network: localNetworkName},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, isGenesisFunded(genesisAllocations, tt.addrInfo))
+		})
+	}
+
+	// ewoq is not marked if the genesis did not fund it
+	require.False(t, isGenesisFunded(&localnet.GenesisAllocations{}, ewoq(localnet.PChainAlias, "AVAX", localNetworkName)))
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
 	"github.com/ava-labs/avalanche-cli/pkg/contract"
 	"github.com/ava-labs/avalanche-cli/pkg/ictt"
 	"github.com/ava-labs/avalanche-cli/pkg/key"
@@ -56,6 +57,9 @@ var (
 	originTransferrerAddress      string
 	destinationTransferrerAddress string
 	destinationKeyName            string
+	multiHopFallback              string
+	secondaryFeeFlt               float64
+	trackDelivery                 bool
 	//
 	senderChainFlags   contract.ChainSpec
 	receiverChainFlags contract.ChainSpec
@@ -128,6 +132,24 @@ func newTransferCmd() *cobra.Command {
 		"",
 		"token transferrer address at the destination subnet (token transferrer experimental)",
 	)
+	cmd.Flags().StringVar(
+		&multiHopFallback,
+		"multi-hop-fallback",
+		"",
+		"address to receive the tokens on the home chain if a remote to remote transfer can't reach its destination [defaults to the sender address] (token transferrer experimental)",
+	)
+	cmd.Flags().Float64Var(
+		&secondaryFeeFlt,
+		"secondary-fee",
+		0,
+		"relayer fee for the home to destination leg of a remote to remote transfer, in TOKEN units (token transferrer experimental)",
+	)
+	cmd.Flags().BoolVar(
+		&trackDelivery,
+		"track-delivery",
+		false,
+		"wait for the transfer to be delivered, following both legs of a remote to remote transfer (token transferrer experimental)",
+	)
 	senderChainFlags.SetFlagNames(
 		"sender-blockchain",
 		"c-chain-sender",
@@ -559,19 +581,59 @@ func interEvmSend(
 			return err
 		}
 	}
+	multiHop := ictt.MultiHopSettings{
+		SecondaryFee: tokenUnitsToWei(secondaryFeeFlt),
+	}
+	if multiHopFallback != "" {
+		if err := prompts.ValidateAddress(multiHopFallback); err != nil {
+			return err
+		}
+		multiHop.Fallback = goethereumcommon.HexToAddress(multiHopFallback)
+	}
+	senderBlockchainID, err := contract.GetBlockchainID(
+		app,
+		network,
+		senderChain,
+	)
+	if err != nil {
+		return err
+	}
+	// by default the transfer is sent without waiting for a relayer to deliver it
+	legTimeout := time.Duration(0)
+	rpcEndpoints := map[ids.ID]string{}
+	if trackDelivery {
+		legTimeout = constants.ICTTTransferLegTimeout
+		rpcEndpoints, _, err = contract.GetKnownBlockchainEndpoints(app, network)
+		if err != nil {
+			return err
+		}
+	}
+	rpcEndpoints[senderBlockchainID] = senderURL
+	_, err = ictt.Transfer(
+		ictt.TransferSpec{
+			SourceRPCURL:            senderURL,
+			SourceBlockchainID:      senderBlockchainID,
+			SourceAddress:           goethereumcommon.HexToAddress(originTransferrerAddress),
+			PrivateKey:              privateKey,
+			DestinationBlockchainID: receiverBlockchainID,
+			DestinationAddress:      goethereumcommon.HexToAddress(destinationTransferrerAddress),
+			AmountRecipient:         destinationAddr,
+			Amount:                  tokenUnitsToWei(amountFlt),
+			MultiHop:                multiHop,
+		},
+		rpcEndpoints,
+		legTimeout,
+	)
+	return err
+}
+
+// converts a TOKEN amount into its 18 decimals representation
+func tokenUnitsToWei(amountFlt float64) *big.Int {
 	amount := new(big.Float).SetFloat64(amountFlt)
 	amount = amount.Mul(amount, new(big.Float).SetFloat64(float64(units.Avax)))
 	amount = amount.Mul(amount, new(big.Float).SetFloat64(float64(units.Avax)))
 	amountInt, _ := amount.Int(nil)
-	return ictt.Send(
-		senderURL,
-		goethereumcommon.HexToAddress(originTransferrerAddress),
-		privateKey,
-		receiverBlockchainID,
-		goethereumcommon.HexToAddress(destinationTransferrerAddress),
-		destinationAddr,
-		amountInt,
-	)
+	return amountInt
 }
 
 func pToPSend(
@@ -0,0 +1,97 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package networkcmd
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/localnet"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	sdkutils "github.com/ava-labs/avalanche-cli/sdk/utils"
+
+	"github.com/spf13/cobra"
+)
+
+type ExportFlags struct {
+	ComposeDir               string
+	SnapshotName             string
+	UserProvidedAvagoVersion string
+	RelayerVersion           string
+}
+
+var exportFlags ExportFlags
+
+// avalanche network export
+func newExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the local network as a standalone docker compose project",
+		Long: `The network export command turns a saved local network snapshot into a standalone
+docker compose project at the dir given by --compose.
+
+The project includes the node databases, staking keys, genesis, chain and subnet configs,
+VM plugins and, if present, the local relayer config, so running it with
+docker compose up reproduces the same network ID and blockchain IDs, without
+needing the CLI nor network access other than for pulling the images.
+
+The local network must be stopped, so its state is saved into a snapshot.
+The exported files contain private keys of the network nodes and relayer.`,
+		RunE: export,
+		Args: cobrautils.ExactArgs(0),
+	}
+	cmd.Flags().StringVar(&exportFlags.ComposeDir, "compose", "", "export the local network as a docker compose project at this dir")
+	cmd.Flags().StringVar(&exportFlags.SnapshotName, "snapshot-name", constants.DefaultSnapshotName, "name of snapshot to export")
+	cmd.Flags().StringVar(
+		&exportFlags.UserProvidedAvagoVersion,
+		"avalanchego-version",
+		"",
+		"use this avalanchego image version (defaults to the version used by the local network)",
+	)
+	cmd.Flags().StringVar(&exportFlags.RelayerVersion, "relayer-version", constants.DefaultRelayerVersion, "use this relayer image version")
+	return cmd
+}
+
+func export(*cobra.Command, []string) error {
+	if exportFlags.ComposeDir == "" {
+		return fmt.Errorf("--compose is required")
+	}
+	isRunning, err := localnet.IsLocalNetworkRunning(app)
+	if err != nil {
+		return err
+	}
+	if isRunning {
+		return fmt.Errorf("local network is running. stop it with 'avalanche network stop' so its state is saved, and retry")
+	}
+	snapshotPath := app.GetSnapshotPath(exportFlags.SnapshotName)
+	if !sdkutils.DirExists(snapshotPath) {
+		return fmt.Errorf("snapshot %s not found", exportFlags.SnapshotName)
+	}
+	exportDir := utils.ExpandHome(exportFlags.ComposeDir)
+	info, err := localnet.TmpNetExportCompose(
+		snapshotPath,
+		exportDir,
+		exportFlags.UserProvidedAvagoVersion,
+		exportFlags.RelayerVersion,
+	)
+	if err != nil {
+		return err
+	}
+	ux.Logger.GreenCheckmarkToUser("Local network exported to %s", exportDir)
+	ux.Logger.PrintToUser("AvalancheGo version: %s", info.AvalancheGoVersion)
+	for _, node := range info.Nodes {
+		ux.Logger.PrintToUser("  %s: http://127.0.0.1:%d", node.Name, node.HTTPPort)
+	}
+	if info.WithICMRelayer {
+		ux.Logger.PrintToUser("ICM Relayer version: %s", exportFlags.RelayerVersion)
+	}
+	if runtime.GOOS != "linux" {
+		ux.Logger.RedXToUser("VM plugins were built for %s. Replace them at %s with linux binaries before running the project", runtime.GOOS, exportDir)
+	}
+	ux.Logger.PrintToUser("")
+	ux.Logger.PrintToUser("To run it: docker compose -f %s up -d", info.ComposePath)
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package networkcmd
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/internal/testutils"
+	"github.com/ava-labs/avalanche-cli/pkg/localnet"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	require := require.New(t)
+	ux.NewUserLog(logging.NoLog{}, io.Discard)
+	app = testutils.SetupTestInTempDir(t)
+	defer func(flags ExportFlags) {
+		exportFlags = flags
+	}(exportFlags)
+
+	exportFlags = ExportFlags{SnapshotName: "test-snapshot", RelayerVersion: "v1.6.0"}
+	require.ErrorContains(export(nil, nil), "--compose is required")
+
+	exportFlags.ComposeDir = filepath.Join(t.TempDir(), "compose")
+	require.ErrorContains(export(nil, nil), "snapshot test-snapshot not found")
+
+	networkID, unparsedGenesis, upgradeBytes, defaultFlags, nodes, err := localnet.GetDefaultNetworkConf(2)
+	require.NoError(err)
+	_, err = localnet.TmpNetCreate(
+		context.Background(),
+		logging.NoLog{},
+		app.GetSnapshotPath(exportFlags.SnapshotName),
+		"",
+		"",
+		networkID,
+		nil,
+		nil,
+		unparsedGenesis,
+		upgradeBytes,
+		defaultFlags,
+		nodes,
+		false,
+	)
+	require.NoError(err)
+	require.NoError(export(nil, nil))
+	require.FileExists(filepath.Join(exportFlags.ComposeDir, "docker-compose.yml"))
+	require.DirExists(filepath.Join(exportFlags.ComposeDir, "node1"))
+	require.DirExists(filepath.Join(exportFlags.ComposeDir, "node2"))
+}
@@ -0,0 +1,191 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package networkcmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/localnet"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/subnet"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
+)
+
+type LogsFlags struct {
+	Follow     bool
+	NodeIDs    []string
+	Chains     []string
+	Level      string
+	Regex      string
+	ErrorsOnly bool
+}
+
+var logsFlags LogsFlags
+
+// avalanche network logs
+func newLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Shows aggregated logs for the local network",
+		Long: `The network logs command merges the logs of the local network nodes, the nodes of
+local clusters connected to it, the local relayer and the signature aggregator into one
+time ordered stream.
+
+Logs can be filtered by node ID, by chain (P, C, X, a blockchain ID, or a blockchain name),
+by minimum log level and by regex. With --follow, new log lines are shown as they are
+written. With --errors-only, a deduplicated summary of the errors found is shown instead.`,
+		RunE: showLogs,
+		Args: cobrautils.ExactArgs(0),
+	}
+	cmd.Flags().BoolVarP(&logsFlags.Follow, "follow", "f", false, "keep showing new log lines as they are written")
+	cmd.Flags().StringSliceVar(&logsFlags.NodeIDs, "node", nil, "only show logs for the given node IDs")
+	cmd.Flags().StringSliceVar(&logsFlags.Chains, "chain", nil, "only show logs for the given chains (P, C, X, blockchain ID or blockchain name)")
+	cmd.Flags().StringVar(&logsFlags.Level, "level", "", "only show logs with the given level or above (debug, info, warn, error)")
+	cmd.Flags().StringVar(&logsFlags.Regex, "regex", "", "only show log lines that match the given regex")
+	cmd.Flags().BoolVar(&logsFlags.ErrorsOnly, "errors-only", false, "show a deduplicated summary of the errors found on the logs")
+	return cmd
+}
+
+func showLogs(*cobra.Command, []string) error {
+	sources, err := localnet.GetLocalNetworkLogSources(app)
+	if err != nil {
+		return err
+	}
+	chains, err := getLogsChainIDs(logsFlags.Chains)
+	if err != nil {
+		return err
+	}
+	filter, err := localnet.NewLogsFilter(logsFlags.NodeIDs, chains, logsFlags.Level, logsFlags.Regex)
+	if err != nil {
+		return err
+	}
+	if logsFlags.Follow {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		deduper := &subnet.ErrorLogsDeduper{}
+		return localnet.FollowLogEntries(ctx, sources, filter, func(entry localnet.LogEntry) {
+			if logsFlags.ErrorsOnly && (!isErrorLogEntry(entry) || !deduper.IsNew(errorLogKey(entry))) {
+				return
+			}
+			printLogEntry(entry)
+		})
+	}
+	entries, err := localnet.GetLogEntries(sources, filter)
+	if err != nil {
+		return err
+	}
+	if logsFlags.ErrorsOnly {
+		printErrorsSummary(entries)
+		return nil
+	}
+	for _, entry := range entries {
+		printLogEntry(entry)
+	}
+	return nil
+}
+
+// translates blockchain names into local network blockchain IDs. P, C, X and
+// blockchain IDs are kept as is
+func getLogsChainIDs(chains []string) ([]string, error) {
+	chainIDs := []string{}
+	for _, chain := range chains {
+		if !app.SidecarExists(chain) {
+			chainIDs = append(chainIDs, chain)
+			continue
+		}
+		sc, err := app.LoadSidecar(chain)
+		if err != nil {
+			return nil, err
+		}
+		blockchainID := sc.Networks[models.NewLocalNetwork().Name()].BlockchainID
+		if blockchainID == ids.Empty {
+			return nil, fmt.Errorf("blockchain %s has not been deployed to %s", chain, models.NewLocalNetwork().Name())
+		}
+		chainIDs = append(chainIDs, blockchainID.String())
+	}
+	return chainIDs, nil
+}
+
+func printLogEntry(entry localnet.LogEntry) {
+	fmt.Printf("%s | %s\n", logging.Blue.Wrap(entry.Source.Name), entry.Line)
+}
+
+func isErrorLogEntry(entry localnet.LogEntry) bool {
+	if level, err := logging.ToLevel(entry.Level); err == nil && level >= logging.Error {
+		return true
+	}
+	return subnet.IsErrorLog(entry.Line)
+}
+
+// key used to dedupe error logs. json logs are compared without its timestamp field,
+// plain logs get their timestamp removed
+func errorLogKey(entry localnet.LogEntry) string {
+	if strings.HasPrefix(entry.Line, "{") {
+		logMap := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(entry.Line), &logMap); err == nil {
+			delete(logMap, "timestamp")
+			if bs, err := json.Marshal(logMap); err == nil {
+				return string(bs)
+			}
+		}
+	}
+	return subnet.RemoveTimestamp(entry.Line)
+}
+
+func printErrorsSummary(entries []localnet.LogEntry) {
+	type errorSummary struct {
+		line    string
+		count   int
+		sources map[string]struct{}
+	}
+	deduper := &subnet.ErrorLogsDeduper{}
+	summaries := map[string]*errorSummary{}
+	keys := []string{}
+	for _, entry := range entries {
+		if !isErrorLogEntry(entry) {
+			continue
+		}
+		key := errorLogKey(entry)
+		if deduper.IsNew(key) {
+			keys = append(keys, key)
+			summaries[key] = &errorSummary{
+				line:    entry.Line,
+				sources: map[string]struct{}{},
+			}
+		}
+		summaries[key].count++
+		summaries[key].sources[entry.Source.Name] = struct{}{}
+	}
+	if len(keys) == 0 {
+		ux.Logger.PrintToUser("No errors found on the local network logs")
+		return
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Count", "Sources", "Error"})
+	table.SetRowLine(true)
+	for _, key := range keys {
+		summary := summaries[key]
+		sources := maps.Keys(summary.sources)
+		sort.Strings(sources)
+		table.Append([]string{
+			fmt.Sprintf("%d", summary.count),
+			strings.Join(sources, "\n"),
+			summary.line,
+		})
+	}
+	table.Render()
+}
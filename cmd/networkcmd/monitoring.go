@@ -0,0 +1,92 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package networkcmd
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/localnet"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanchego/utils/logging"
+
+	"github.com/spf13/cobra"
+)
+
+// avalanche network monitoring
+func newMonitoringCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "monitoring",
+		Short: "Manage monitoring of the local network",
+		Long: `The network monitoring command suite runs Prometheus, Loki and Grafana on the local docker,
+so the local network nodes metrics and logs can be inspected with the same dashboards
+used for cloud clusters.`,
+		RunE: cobrautils.CommandSuiteUsage,
+		Args: cobrautils.ExactArgs(0),
+	}
+	// network monitoring start
+	cmd.AddCommand(newMonitoringStartCmd())
+	// network monitoring stop
+	cmd.AddCommand(newMonitoringStopCmd())
+	return cmd
+}
+
+// avalanche network monitoring start
+func newMonitoringStartCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "start",
+		Short: "Start monitoring of the local network",
+		Long: `The network monitoring start command starts Prometheus, Loki, Promtail and Grafana on
+the local docker. Prometheus is configured to scrape all local network nodes, and the nodes
+of the running local clusters connected to it, and Promtail ships their logs to Loki.
+
+As node endpoints and log locations change when the local network is restarted, the
+command should be executed again after a network or local cluster start.`,
+		RunE: startMonitoring,
+		Args: cobrautils.ExactArgs(0),
+	}
+}
+
+// avalanche network monitoring stop
+func newMonitoringStopCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "stop",
+		Short: "Stop monitoring of the local network",
+		Long: `The network monitoring stop command stops the local monitoring services.
+Collected metrics and logs are preserved for the next start.`,
+		RunE: stopMonitoring,
+		Args: cobrautils.ExactArgs(0),
+	}
+}
+
+func startMonitoring(*cobra.Command, []string) error {
+	if !utils.E2EDocker() {
+		return fmt.Errorf("docker is needed to run local network monitoring")
+	}
+	isRunning, err := localnet.IsLocalNetworkRunning(app)
+	if err != nil {
+		return err
+	}
+	if !isRunning {
+		return fmt.Errorf("local network is not running")
+	}
+	ux.Logger.PrintToUser("Starting local network monitoring...")
+	if err := localnet.LocalNetworkMonitoringStart(app); err != nil {
+		return err
+	}
+	ux.Logger.GreenCheckmarkToUser("Local network monitoring started")
+	ux.Logger.PrintToUser("")
+	ux.Logger.PrintToUser("To view the dashboards, open (user admin, password admin):")
+	ux.Logger.PrintToUser(logging.Green.Wrap(fmt.Sprintf("http://127.0.0.1:%d/dashboards", constants.AvalancheGoGrafanaPort)))
+	return nil
+}
+
+func stopMonitoring(*cobra.Command, []string) error {
+	if err := localnet.LocalNetworkMonitoringStop(app); err != nil {
+		return err
+	}
+	ux.Logger.GreenCheckmarkToUser("Local network monitoring stopped")
+	return nil
+}
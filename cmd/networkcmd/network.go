@@ -34,5 +34,11 @@ This network currently supports multiple, concurrently deployed Blockchains.`,
 	cmd.AddCommand(newCleanCmd())
 	// network status
 	cmd.AddCommand(newStatusCmd())
+	// network logs
+	cmd.AddCommand(newLogsCmd())
+	// network export
+	cmd.AddCommand(newExportCmd())
+	// network monitoring
+	cmd.AddCommand(newMonitoringCmd())
 	return cmd
 }
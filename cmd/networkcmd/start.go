@@ -32,6 +32,8 @@ type StartFlags struct {
 	RelayerBinaryPath        string
 	RelayerVersion           string
 	NumNodes                 uint32
+	GenesisAllocationsPath   string
+	SkipEwoqFunding          bool
 }
 
 var startFlags StartFlags
@@ -44,7 +46,20 @@ func newStartCmd() *cobra.Command {
 
 By default, the command loads the default snapshot. If you provide the --snapshot-name
 flag, the network loads that snapshot instead. The command fails if the local network is
-already running.`,
+already running.
+
+When a new network is created, the --genesis-allocations flag can be used to fund
+P-Chain, X-Chain and C-Chain addresses, or CLI managed keys, on genesis. The file
+is expected to have the following format (amounts in nAVAX):
+
+{
+  "allocations": [
+    {"key": "mykey", "pChainAmount": 1000000000000, "cChainAmount": 1000000000000},
+    {"xChainAddress": "X-local1...", "xChainAmount": 1000000000000}
+  ]
+}
+
+The --skip-ewoq-funding flag leaves the well known EWOQ key unfunded.`,
 
 		RunE: start,
 		Args: cobrautils.ExactArgs(0),
@@ -66,6 +81,18 @@ already running.`,
 		constants.DefaultRelayerVersion,
 		"use this relayer version",
 	)
+	cmd.Flags().StringVar(
+		&startFlags.GenesisAllocationsPath,
+		"genesis-allocations",
+		"",
+		"file with P-Chain, X-Chain and C-Chain allocations to be funded on network genesis",
+	)
+	cmd.Flags().BoolVar(
+		&startFlags.SkipEwoqFunding,
+		"skip-ewoq-funding",
+		false,
+		"do not fund EWOQ key on network genesis",
+	)
 
 	return cmd
 }
@@ -99,6 +126,10 @@ func Start(flags StartFlags, printEndpoints bool) error {
 
 	networkDir := ""
 	if sdkutils.DirExists(snapshotPath) {
+		if flags.GenesisAllocationsPath != "" || flags.SkipEwoqFunding {
+			return fmt.Errorf("genesis allocations can only be set on a new network. Use 'avalanche network clean' to remove snapshot %s", flags.SnapshotName)
+		}
+
 		ux.Logger.PrintToUser("Starting previously deployed and stopped snapshot")
 
 		if autoSave {
@@ -175,6 +206,16 @@ func Start(flags StartFlags, printEndpoints bool) error {
 			return fmt.Errorf("snapshot %s does not exists", flags.SnapshotName)
 		}
 
+		genesisAllocations, err := localnet.LoadGenesisAllocationsFile(
+			app,
+			models.NewLocalNetwork(),
+			flags.GenesisAllocationsPath,
+			!flags.SkipEwoqFunding,
+		)
+		if err != nil {
+			return err
+		}
+
 		// starting a new network from scratch
 		if autoSave {
 			networkDir = snapshotPath
@@ -194,6 +235,9 @@ func Start(flags StartFlags, printEndpoints bool) error {
 		if err != nil {
 			return err
 		}
+		if err := localnet.ApplyGenesisAllocations(unparsedGenesis, genesisAllocations); err != nil {
+			return err
+		}
 		// add node flags on CLI config info default network flags
 		flagsFromCLIConfigJSON, err := app.Conf.LoadNodeConfig()
 		if err != nil {
@@ -230,6 +274,10 @@ func Start(flags StartFlags, printEndpoints bool) error {
 			_ = localnet.TmpNetStop(networkDir)
 			return err
 		}
+		// persist genesis allocations alongside the network
+		if err := localnet.WriteLocalNetworkGenesisAllocations(app, networkDir, genesisAllocations); err != nil {
+			return err
+		}
 		// save network directory
 		if err := localnet.SaveLocalNetworkMeta(app, networkDir); err != nil {
 			return err
@@ -44,6 +44,12 @@ func stop(*cobra.Command, []string) error {
 	return Stop(stopFlags)
 }
 
+// StopAndSave stops the local network, saving its state onto the default snapshot,
+// so it is loaded by the next Start
+func StopAndSave() error {
+	return Stop(StopFlags{snapshotName: constants.DefaultSnapshotName})
+}
+
 func Stop(flags StopFlags) error {
 	if err := stopAndSaveNetwork(flags); err != nil {
 		return err
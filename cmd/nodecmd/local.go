@@ -24,6 +24,7 @@ import (
 	"github.com/ava-labs/avalanche-cli/pkg/utils"
 	"github.com/ava-labs/avalanche-cli/pkg/ux"
 	"github.com/ava-labs/avalanche-cli/pkg/validatormanager"
+	"github.com/ava-labs/avalanche-cli/pkg/vm"
 	sdkutils "github.com/ava-labs/avalanche-cli/sdk/utils"
 	"github.com/ava-labs/avalanchego/api/info"
 	"github.com/ava-labs/avalanchego/config"
@@ -322,11 +323,16 @@ func localDestroyNode(_ *cobra.Command, args []string) error {
 func localTrack(_ *cobra.Command, args []string) error {
 	clusterName := args[0]
 	blockchainName := args[1]
+	vmBinaryPath, err := vm.SetupBlockchainVMBinary(app, blockchainName)
+	if err != nil {
+		return err
+	}
 	return localnet.LocalClusterTrackSubnet(
 		app,
 		ux.Logger.PrintToUser,
 		clusterName,
 		blockchainName,
+		vmBinaryPath,
 	)
 }
 
@@ -636,6 +642,7 @@ func addAsValidator(
 		extraAggregatorPeers,
 		aggregatorLogger,
 		true,
+		"",
 		delegationFee,
 		time.Duration(minimumStakeDuration)*time.Second,
 		validatorManagerAddressStr,
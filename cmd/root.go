@@ -13,6 +13,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/ava-labs/avalanche-cli/cmd/applycmd"
 	"github.com/ava-labs/avalanche-cli/cmd/blockchaincmd"
 	"github.com/ava-labs/avalanche-cli/cmd/configcmd"
 	"github.com/ava-labs/avalanche-cli/cmd/contractcmd"
@@ -119,6 +120,9 @@ in with avalanche blockchain create myNewBlockchain.`,
 	rootCmd.AddCommand(contractcmd.NewCmd(app))
 	// add validator command
 	rootCmd.AddCommand(validatorcmd.NewCmd(app))
+	// add apply and plan commands
+	rootCmd.AddCommand(applycmd.NewApplyCmd(app))
+	rootCmd.AddCommand(applycmd.NewPlanCmd(app))
 
 	cobrautils.ConfigureRootCmd(rootCmd)
 
@@ -0,0 +1,324 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatorcmd
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	"github.com/ava-labs/avalanche-cli/pkg/keychain"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/networkoptions"
+	"github.com/ava-labs/avalanche-cli/pkg/subnet"
+	"github.com/ava-labs/avalanche-cli/pkg/topup"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/sdk/validator"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/api"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+const day = 24 * time.Hour
+
+var (
+	minRunwayDays    uint
+	targetRunwayDays uint
+	spendingCapAVAX  float64
+	watch            bool
+	watchInterval    time.Duration
+)
+
+func NewAutoTopUpCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "autotopup",
+		Short: "Automatically increases the P-Chain balance of the validators of an L1",
+		Long: `This command watches the P-Chain balance of every validator of an L1, and estimates
+its time to depletion from the current continuous fee rate. Validators that fall below
+the given runway, get their balance increased from the funding key, up to the target runway.
+
+By default, balances are checked once. Use --watch to keep checking them periodically. The
+total amount spent by the command, tx fees included, is limited by --spending-cap.`,
+		RunE: autoTopUp,
+		Args: cobrautils.ExactArgs(0),
+	}
+	networkoptions.AddNetworkFlagsToCmd(cmd, &globalNetworkFlags, true, networkoptions.DefaultSupportedNetworkOptions)
+	cmd.Flags().StringVar(&l1, "l1", "", "name of L1")
+	cmd.Flags().StringVarP(&keyName, "key", "k", "", "select the funding key to use [fuji/devnet only]")
+	cmd.Flags().BoolVarP(&useLedger, "ledger", "g", false, "use ledger instead of key (always true on mainnet, defaults to false on fuji/devnet)")
+	cmd.Flags().StringSliceVar(&ledgerAddresses, "ledger-addrs", []string{}, "use the given ledger addresses")
+	cmd.Flags().UintVar(&minRunwayDays, "min-runway", 7, "top up validators with less than this number of days of balance")
+	cmd.Flags().UintVar(&targetRunwayDays, "target-runway", 30, "number of days of balance to top up validators to")
+	cmd.Flags().Float64Var(&spendingCapAVAX, "spending-cap", 0, "max amount of AVAX to spend on top ups, including tx fees")
+	cmd.Flags().BoolVar(&watch, "watch", false, "keep running, checking balances periodically")
+	cmd.Flags().DurationVar(&watchInterval, "interval", time.Hour, "time between balance checks when using --watch")
+	_ = cmd.MarkFlagRequired("l1")
+	_ = cmd.MarkFlagRequired("spending-cap")
+	return cmd
+}
+
+func autoTopUp(_ *cobra.Command, _ []string) error {
+	if spendingCapAVAX <= 0 {
+		return fmt.Errorf("--spending-cap must be positive")
+	}
+	if targetRunwayDays <= minRunwayDays {
+		return fmt.Errorf("--target-runway must be greater than --min-runway")
+	}
+	policy := topup.Policy{
+		MinRunway:    time.Duration(minRunwayDays) * day,
+		TargetRunway: time.Duration(targetRunwayDays) * day,
+	}
+	sc, err := app.LoadSidecar(l1)
+	if err != nil {
+		return fmt.Errorf("failed to load sidecar: %w", err)
+	}
+	if !sc.Sovereign {
+		return fmt.Errorf("avalanche validator commands are only applicable to sovereign L1s")
+	}
+	network, err := networkoptions.GetNetworkFromCmdLineFlags(
+		app,
+		"",
+		globalNetworkFlags,
+		true,
+		false,
+		networkoptions.GetNetworkFromSidecar(sc, networkoptions.DefaultSupportedNetworkOptions),
+		"",
+	)
+	if err != nil {
+		return err
+	}
+	if sc.Networks[network.Name()].ValidatorManagerAddress == "" {
+		return fmt.Errorf("unable to find Validator Manager address")
+	}
+	// TODO: will estimate fee in subsecuent PR
+	fee := uint64(0)
+	kc, err := keychain.GetKeychainFromCmdLineFlags(
+		app,
+		"to fund validator balances",
+		network,
+		keyName,
+		useEwoq,
+		useLedger,
+		ledgerAddresses,
+		fee,
+	)
+	if err != nil {
+		return err
+	}
+	deployer := subnet.NewPublicDeployer(app, kc, network)
+	spendingCap := uint64(spendingCapAVAX * float64(units.Avax))
+	return runTopUpRounds(spendingCap, func(budget uint64) (uint64, error) {
+		return topUpRound(network, sc, kc, deployer, policy, budget)
+	})
+}
+
+// runTopUpRounds calls [round] once, or periodically if on --watch, giving it the part of
+// [spendingCap] not yet spent, until the cap is reached
+func runTopUpRounds(spendingCap uint64, round func(budget uint64) (uint64, error)) error {
+	spent := uint64(0)
+	for {
+		roundSpent, err := round(spendingCap - spent)
+		spent += roundSpent
+		if err != nil {
+			if !watch {
+				return err
+			}
+			ux.Logger.RedXToUser("failure checking validator balances: %s", err)
+		}
+		ux.Logger.PrintToUser(
+			"Spent %.5f AVAX out of a %.5f AVAX cap, including tx fees",
+			float64(spent)/float64(units.Avax),
+			float64(spendingCap)/float64(units.Avax),
+		)
+		if !watch {
+			return nil
+		}
+		if spent >= spendingCap {
+			return fmt.Errorf("spending cap of %.5f AVAX reached", float64(spendingCap)/float64(units.Avax))
+		}
+		ux.Logger.PrintToUser("Next check at %s", time.Now().Add(watchInterval).Format(constants.TimeParseLayout))
+		time.Sleep(watchInterval)
+	}
+}
+
+// topUpRound checks all validator balances once, and tops up the ones below the [policy]
+// runway, spending up to [budget] nAVAX, tx fees included. Returns the amount spent
+func topUpRound(
+	network models.Network,
+	sc models.Sidecar,
+	kc *keychain.Keychain,
+	deployer *subnet.PublicDeployer,
+	policy topup.Policy,
+	budget uint64,
+) (uint64, error) {
+	pClient := platformvm.NewClient(network.Endpoint)
+	ctx, cancel := utils.GetAPIContext()
+	defer cancel()
+	_, feeRate, _, err := pClient.GetValidatorFeeState(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failure getting P-Chain validator fee state: %w", err)
+	}
+	validators, err := getValidatorBalances(network, sc)
+	if err != nil {
+		return 0, err
+	}
+	t := ux.DefaultTable(
+		fmt.Sprintf("%s Validator Balances (fee rate %d nAVAX/s)", l1, feeRate),
+		table.Row{"Node ID", "Validation ID", "Balance (AVAX)", "Runway (days)"},
+	)
+	for _, v := range validators {
+		runway := fmt.Sprintf("%.1f", topup.Runway(v.Balance, uint64(feeRate)).Hours()/24)
+		if feeRate == 0 {
+			runway = "unlimited"
+		}
+		t.AppendRow(table.Row{v.NodeID, v.ValidationID, float64(v.Balance) / float64(units.Avax), runway})
+	}
+	ux.Logger.PrintToUser(t.Render())
+	candidates := policy.Plan(validators, uint64(feeRate), 0, math.MaxUint64)
+	if len(candidates) == 0 {
+		ux.Logger.GreenCheckmarkToUser("No validator needs a top up")
+		return 0, nil
+	}
+	availableBalance, err := utils.GetNetworkBalance(kc.Addresses().List(), network.Endpoint)
+	if err != nil {
+		return 0, err
+	}
+	budget = min(budget, availableBalance)
+	// top up txs have about the same fee, so it is estimated once for planning
+	txFee, err := deployer.IncreaseValidatorPChainBalanceFee(candidates[0].ValidationID, candidates[0].Amount)
+	if err != nil {
+		return 0, err
+	}
+	topUps := policy.Plan(validators, uint64(feeRate), txFee, budget)
+	if len(topUps) == 0 {
+		ux.Logger.RedXToUser("Remaining budget of %.5f AVAX is not enough for any top up", float64(budget)/float64(units.Avax))
+		return 0, nil
+	}
+	return issueTopUps(
+		topUps,
+		budget,
+		func(topUp topup.TopUp) (uint64, error) {
+			return deployer.IncreaseValidatorPChainBalanceFee(topUp.ValidationID, topUp.Amount)
+		},
+		func(topUp topup.TopUp) error {
+			_, err := deployer.IncreaseValidatorPChainBalance(topUp.ValidationID, topUp.Amount)
+			return err
+		},
+	)
+}
+
+// issueTopUps issues [topUps] in order with [increaseBalance], skipping the ones whose amount
+// plus fee, as given by [getFee], do not fit into the remaining [budget]. Returns the amount
+// spent, tx fees included
+func issueTopUps(
+	topUps []topup.TopUp,
+	budget uint64,
+	getFee func(topup.TopUp) (uint64, error),
+	increaseBalance func(topup.TopUp) error,
+) (uint64, error) {
+	spent := uint64(0)
+	for _, topUp := range topUps {
+		fee, err := getFee(topUp)
+		if err != nil {
+			return spent, err
+		}
+		remaining := budget - spent
+		if topUp.Amount > remaining || fee > remaining-topUp.Amount {
+			ux.Logger.RedXToUser(
+				"Skipping top up of %s: %.5f AVAX plus %.9f AVAX fee exceed the remaining budget of %.5f AVAX",
+				topUp.NodeID,
+				float64(topUp.Amount)/float64(units.Avax),
+				float64(fee)/float64(units.Avax),
+				float64(remaining)/float64(units.Avax),
+			)
+			continue
+		}
+		ux.Logger.PrintToUser(
+			"Increasing balance of %s by %.5f AVAX (%.1f days of runway left)",
+			topUp.NodeID,
+			float64(topUp.Amount)/float64(units.Avax),
+			topUp.Runway.Hours()/24,
+		)
+		if err := increaseBalance(topUp); err != nil {
+			return spent, err
+		}
+		spent += topUp.Amount + fee
+	}
+	return spent, nil
+}
+
+// getValidatorBalances returns the balances of the current L1 validators, and of the
+// bootstrap validators, that may have been deactivated after running out of balance
+func getValidatorBalances(network models.Network, sc models.Sidecar) ([]topup.ValidatorBalance, error) {
+	chainSpec := contract.ChainSpec{
+		BlockchainName: l1,
+	}
+	rpcURL, _, err := contract.GetBlockchainEndpoints(app, network, chainSpec, true, false)
+	if err != nil {
+		return nil, err
+	}
+	subnetID, err := contract.GetSubnetID(app, network, chainSpec)
+	if err != nil {
+		return nil, err
+	}
+	pClient := platformvm.NewClient(network.Endpoint)
+	ctx, cancel := utils.GetAPIContext()
+	defer cancel()
+	currentValidators, err := pClient.GetValidatorsAt(ctx, subnetID, api.ProposedHeight)
+	if err != nil {
+		return nil, err
+	}
+	managerAddress := common.HexToAddress(sc.Networks[network.Name()].ValidatorManagerAddress)
+	validationIDs := map[ids.ID]ids.NodeID{}
+	for nodeID := range currentValidators {
+		validationID, err := validator.GetValidationID(rpcURL, managerAddress, nodeID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get validation ID for node %s: %w", nodeID, err)
+		}
+		if validationID != ids.Empty {
+			validationIDs[validationID] = nodeID
+		}
+	}
+	for _, bootstrapValidator := range sc.Networks[network.Name()].BootstrapValidators {
+		validationID, err := ids.FromString(bootstrapValidator.ValidationID)
+		if err != nil {
+			continue
+		}
+		nodeID, err := ids.NodeIDFromString(bootstrapValidator.NodeID)
+		if err != nil {
+			return nil, err
+		}
+		validationIDs[validationID] = nodeID
+	}
+	balances := []topup.ValidatorBalance{}
+	for validationID, nodeID := range validationIDs {
+		balance, err := validator.GetValidatorBalance(network.SDKNetwork(), validationID)
+		if err != nil {
+			// bootstrap validators may have been removed
+			if !strings.Contains(err.Error(), "not found") {
+				ux.Logger.RedXToUser("could not get balance for node %s due to %s", nodeID, err)
+			}
+			continue
+		}
+		balances = append(balances, topup.ValidatorBalance{
+			ValidationID: validationID,
+			NodeID:       nodeID,
+			Balance:      balance,
+		})
+	}
+	sort.Slice(balances, func(i, j int) bool {
+		return balances[i].NodeID.Compare(balances[j].NodeID) < 0
+	})
+	return balances, nil
+}
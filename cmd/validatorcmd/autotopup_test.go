@@ -0,0 +1,182 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatorcmd
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/pkg/topup"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueTopUps(t *testing.T) {
+	ux.NewUserLog(logging.NoLog{}, io.Discard)
+	const fee = 10
+	topUps := []topup.TopUp{
+		{ValidationID: ids.GenerateTestID(), NodeID: ids.GenerateTestNodeID(), Amount: 100},
+		{ValidationID: ids.GenerateTestID(), NodeID: ids.GenerateTestNodeID(), Amount: 200},
+		{ValidationID: ids.GenerateTestID(), NodeID: ids.GenerateTestNodeID(), Amount: 50},
+	}
+	getFee := func(topup.TopUp) (uint64, error) {
+		return fee, nil
+	}
+	tests := []struct {
+		name     string
+		budget   uint64
+		issued   []int
+		expSpent uint64
+	}{
+		{
+			name:     "budget covers amounts and fees",
+			budget:   380,
+			issued:   []int{0, 1, 2},
+			expSpent: 380,
+		},
+		{
+			// the last top up amount fits, but not its fee
+			name:     "fee exceeds remaining budget",
+			budget:   379,
+			issued:   []int{0, 1},
+			expSpent: 320,
+		},
+		{
+			// the second top up does not fit, but the third one does
+			name:     "top ups that do not fit are skipped",
+			budget:   200,
+			issued:   []int{0, 2},
+			expSpent: 170,
+		},
+		{
+			// the first top up amount fits, but not its fee
+			name:     "only smaller top up fits with its fee",
+			budget:   100,
+			issued:   []int{2},
+			expSpent: 60,
+		},
+		{
+			name:   "no top up fits",
+			budget: 59,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			issued := []int{}
+			spent, err := issueTopUps(topUps, tt.budget, getFee, func(topUp topup.TopUp) error {
+				for i := range topUps {
+					if topUps[i].ValidationID == topUp.ValidationID {
+						issued = append(issued, i)
+					}
+				}
+				return nil
+			})
+			require.NoError(err)
+			require.Equal(tt.expSpent, spent)
+			require.LessOrEqual(spent, tt.budget)
+			if tt.issued == nil {
+				tt.issued = []int{}
+			}
+			require.Equal(tt.issued, issued)
+		})
+	}
+
+	// failures return the amount already spent
+	issueErr := errors.New("issue failure")
+	calls := 0
+	spent, err := issueTopUps(topUps, 1000, getFee, func(topup.TopUp) error {
+		calls++
+		if calls == 2 {
+			return issueErr
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, issueErr)
+	require.Equal(t, uint64(110), spent)
+	feeErr := errors.New("fee failure")
+	spent, err = issueTopUps(topUps, 1000, func(topup.TopUp) (uint64, error) {
+		return 0, feeErr
+	}, func(topup.TopUp) error {
+		return nil
+	})
+	require.ErrorIs(t, err, feeErr)
+	require.Zero(t, spent)
+}
+
+func TestRunTopUpRounds(t *testing.T) {
+	require := require.New(t)
+	ux.NewUserLog(logging.NoLog{}, io.Discard)
+	defer func(watchValue bool, interval time.Duration) {
+		watch = watchValue
+		watchInterval = interval
+	}(watch, watchInterval)
+	watchInterval = 0
+
+	// each round spends a 30 top up plus a 5 fee, while the budget allows it
+	const spendingCap = 100
+	newRound := func(budgets *[]uint64) func(uint64) (uint64, error) {
+		return func(budget uint64) (uint64, error) {
+			*budgets = append(*budgets, budget)
+			return issueTopUps(
+				[]topup.TopUp{{Amount: 30}},
+				budget,
+				func(topup.TopUp) (uint64, error) {
+					return 5, nil
+				},
+				func(topup.TopUp) error {
+					return nil
+				},
+			)
+		}
+	}
+
+	// a single round is run if not watching
+	watch = false
+	budgets := []uint64{}
+	require.NoError(runTopUpRounds(spendingCap, newRound(&budgets)))
+	require.Equal([]uint64{spendingCap}, budgets)
+
+	// when watching, rounds get the remaining budget until the cap is reached
+	watch = true
+	budgets = []uint64{}
+	rounds := 0
+	round := newRound(&budgets)
+	err := runTopUpRounds(spendingCap, func(budget uint64) (uint64, error) {
+		rounds++
+		if rounds == 5 {
+			// the last 30 left are spent on a top up with no fee
+			budgets = append(budgets, budget)
+			return budget, nil
+		}
+		return round(budget)
+	})
+	require.ErrorContains(err, "spending cap of")
+	// 35 are spent on each of the first two rounds. The next ones do not fit a top up
+	// plus fee, until the last one spends the rest
+	require.Equal([]uint64{100, 65, 30, 30, 30}, budgets)
+
+	// round failures are retried when watching
+	budgets = []uint64{}
+	rounds = 0
+	err = runTopUpRounds(spendingCap, func(budget uint64) (uint64, error) {
+		rounds++
+		budgets = append(budgets, budget)
+		if rounds == 1 {
+			return 35, errors.New("partial failure")
+		}
+		return budget, nil
+	})
+	require.ErrorContains(err, "spending cap")
+	require.Equal([]uint64{100, 65}, budgets)
+
+	watch = false
+	roundErr := errors.New("round failure")
+	require.ErrorIs(runTopUpRounds(spendingCap, func(uint64) (uint64, error) {
+		return 0, roundErr
+	}), roundErr)
+}
@@ -4,14 +4,18 @@ package validatorcmd
 
 import (
 	"fmt"
+	"math/big"
 	"sort"
 
 	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
 	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	"github.com/ava-labs/avalanche-cli/pkg/ictt"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
 	"github.com/ava-labs/avalanche-cli/pkg/networkoptions"
 	"github.com/ava-labs/avalanche-cli/pkg/utils"
 	"github.com/ava-labs/avalanche-cli/pkg/ux"
 	"github.com/ava-labs/avalanche-cli/sdk/validator"
+	validatorManagerSDK "github.com/ava-labs/avalanche-cli/sdk/validatormanager"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/platformvm"
@@ -92,9 +96,18 @@ func list(_ *cobra.Command, args []string) error {
 	}
 	managerAddress := common.HexToAddress(validatorManagerAddress)
 
+	header := table.Row{"Node ID", "Validation ID", "Weight", "Remaining Balance (AVAX)"}
+	var stakeToken tokenInfo
+	if sc.PoS() {
+		stakeToken, err = getStakeTokenInfo(rpcURL, sc, blockchainName)
+		if err != nil {
+			return err
+		}
+		header = append(header, fmt.Sprintf("Stake (%s)", stakeToken.symbol))
+	}
 	t := ux.DefaultTable(
 		fmt.Sprintf("%s Validators", blockchainName),
-		table.Row{"Node ID", "Validation ID", "Weight", "Remaining Balance (AVAX)"},
+		header,
 	)
 
 	nodeIDs := maps.Keys(validators)
@@ -116,8 +129,49 @@ func list(_ *cobra.Command, args []string) error {
 				ux.Logger.RedXToUser("could not get balance for node %s due to %s", nodeID, err)
 			}
 		}
-		t.AppendRow(table.Row{nodeID, validationID, validators[nodeID].Weight, float64(balance) / float64(units.Avax)})
+		row := table.Row{nodeID, validationID, validators[nodeID].Weight, float64(balance) / float64(units.Avax)}
+		if sc.PoS() {
+			stake, err := validatorManagerSDK.PoSWeightToValue(rpcURL, managerAddress, validators[nodeID].Weight)
+			if err != nil {
+				ux.Logger.RedXToUser("could not get stake for node %s due to %s", nodeID, err)
+				row = append(row, "")
+			} else {
+				row = append(row, stakeToken.format(stake))
+			}
+		}
+		t.AppendRow(row)
 	}
 	fmt.Println(t.Render())
 	return nil
 }
+
+// tokenInfo is the token staked on a PoS L1
+type tokenInfo struct {
+	symbol   string
+	decimals uint8
+}
+
+// format returns [amount] base units in token units
+func (t tokenInfo) format(amount *big.Int) string {
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(t.decimals)), nil)
+	return new(big.Float).Quo(new(big.Float).SetInt(amount), new(big.Float).SetInt(divisor)).Text('f', -1)
+}
+
+// parse returns [amount] token units in base units
+func (t tokenInfo) parse(amount float64) *big.Int {
+	multiplier := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(t.decimals)), nil)
+	value, _ := new(big.Float).Mul(big.NewFloat(amount), new(big.Float).SetInt(multiplier)).Int(nil)
+	return value
+}
+
+// getStakeTokenInfo returns the ERC20 staking token of the L1, or its native token
+func getStakeTokenInfo(rpcURL string, sc models.Sidecar, blockchainName string) (tokenInfo, error) {
+	if sc.StakingTokenAddress == "" {
+		return tokenInfo{symbol: app.GetTokenSymbol(blockchainName), decimals: 18}, nil
+	}
+	symbol, _, decimals, err := ictt.GetTokenParams(rpcURL, common.HexToAddress(sc.StakingTokenAddress))
+	if err != nil {
+		return tokenInfo{}, fmt.Errorf("failure getting staking token %s info: %w", sc.StakingTokenAddress, err)
+	}
+	return tokenInfo{symbol: symbol, decimals: decimals}, nil
+}
@@ -0,0 +1,419 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatorcmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/cmd/flags"
+	"github.com/ava-labs/avalanche-cli/pkg/blockchain"
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	"github.com/ava-labs/avalanche-cli/pkg/keychain"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/networkoptions"
+	"github.com/ava-labs/avalanche-cli/pkg/signatureaggregator"
+	"github.com/ava-labs/avalanche-cli/pkg/subnet"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/pkg/validatormanager"
+	sdkutils "github.com/ava-labs/avalanche-cli/sdk/utils"
+	"github.com/ava-labs/avalanche-cli/sdk/validator"
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+const (
+	completeOption = "Complete"
+	abortOption    = "Abort"
+	skipOption     = "Skip"
+)
+
+var reconcileFlags ValidatorReconcileFlags
+
+type ValidatorReconcileFlags struct {
+	RPC         string
+	SigAggFlags flags.SignatureAggregatorFlags
+}
+
+// inconsistent validator set entry, and what is needed to complete or abort it
+type pendingValidatorOperation struct {
+	registration validatormanager.L1ValidatorRegistration
+	status       validatormanager.ValidatorStatus
+	onPChain     bool
+	kind         validatormanager.PendingOperationKind
+}
+
+// reconciliation context shared by all operations of an L1
+type reconciler struct {
+	network              models.Network
+	sc                   models.Sidecar
+	chainSpec            contract.ChainSpec
+	rpcURL               string
+	managerAddress       common.Address
+	ownerPrivateKey      string
+	extraAggregatorPeers []info.Peer
+	aggregatorLogger     logging.Logger
+	deployer             *subnet.PublicDeployer
+}
+
+func NewReconcileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile",
+		Short: "Reconciles the validator set of an L1 between its validator manager and P-Chain",
+		Long: `This command compares every validator registered on the L1 validator manager against
+the P-Chain, and lists the entries left inconsistent by partial failures: registrations
+initiated but never completed, or removals that are still pending.
+
+For each entry, it offers to complete or abort the pending operation, when possible.`,
+		RunE: reconcileValidators,
+		Args: cobrautils.ExactArgs(0),
+	}
+	networkoptions.AddNetworkFlagsToCmd(cmd, &globalNetworkFlags, true, networkoptions.DefaultSupportedNetworkOptions)
+	flags.AddRPCFlagToCmd(cmd, app, &reconcileFlags.RPC)
+	flags.AddSignatureAggregatorFlagsToCmd(cmd, &reconcileFlags.SigAggFlags)
+	cmd.Flags().StringVar(&l1, "l1", "", "name of L1")
+	cmd.Flags().StringVarP(&keyName, "key", "k", "", "select the key to use to pay for P-Chain fees [fuji/devnet only]")
+	cmd.Flags().BoolVarP(&useLedger, "ledger", "g", false, "use ledger instead of key (always true on mainnet, defaults to false on fuji/devnet)")
+	cmd.Flags().StringSliceVar(&ledgerAddresses, "ledger-addrs", []string{}, "use the given ledger addresses")
+	_ = cmd.MarkFlagRequired("l1")
+	return cmd
+}
+
+func reconcileValidators(_ *cobra.Command, _ []string) error {
+	sc, err := app.LoadSidecar(l1)
+	if err != nil {
+		return fmt.Errorf("failed to load sidecar: %w", err)
+	}
+	if !sc.Sovereign {
+		return fmt.Errorf("avalanche validator commands are only applicable to sovereign L1s")
+	}
+	network, err := networkoptions.GetNetworkFromCmdLineFlags(
+		app,
+		"",
+		globalNetworkFlags,
+		true,
+		false,
+		networkoptions.GetNetworkFromSidecar(sc, networkoptions.DefaultSupportedNetworkOptions),
+		"",
+	)
+	if err != nil {
+		return err
+	}
+	if sc.Networks[network.Name()].ValidatorManagerAddress == "" {
+		return fmt.Errorf("unable to find Validator Manager address")
+	}
+	r := reconciler{
+		network: network,
+		sc:      sc,
+		chainSpec: contract.ChainSpec{
+			BlockchainName: l1,
+		},
+		rpcURL:         reconcileFlags.RPC,
+		managerAddress: common.HexToAddress(sc.Networks[network.Name()].ValidatorManagerAddress),
+	}
+	if r.rpcURL == "" {
+		r.rpcURL, _, err = contract.GetBlockchainEndpoints(app, network, r.chainSpec, true, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	ux.Logger.PrintToUser("Searching for validator registrations on %s...", r.rpcURL)
+	registrations, err := validatormanager.GetL1ValidatorRegistrations(r.rpcURL, r.managerAddress)
+	if err != nil {
+		return err
+	}
+	ops, err := getPendingValidatorOperations(
+		registrations,
+		func(validationID ids.ID) (validatormanager.ValidatorStatus, error) {
+			return validatormanager.GetValidatorStatus(r.rpcURL, r.managerAddress, validationID)
+		},
+		func(validationID ids.ID) (bool, error) {
+			return isValidationOnPChain(network, validationID)
+		},
+		uint64(time.Now().Unix()),
+	)
+	if err != nil {
+		return err
+	}
+	if len(ops) == 0 {
+		ux.Logger.GreenCheckmarkToUser("Validator manager and P-Chain agree on the %d validator registrations of %s", len(registrations), l1)
+		return nil
+	}
+
+	t := ux.DefaultTable(
+		fmt.Sprintf("%d inconsistent validator set entries", len(ops)),
+		table.Row{"Node ID", "Validation ID", "Validator Manager", "P-Chain", "Pending Operation"},
+	)
+	for _, op := range ops {
+		pChainStatus := "not found"
+		if op.onPChain {
+			pChainStatus = "registered"
+		}
+		t.AppendRow(table.Row{op.registration.NodeID, op.registration.ValidationID, op.status, pChainStatus, op.kind})
+	}
+	ux.Logger.PrintToUser(t.Render())
+
+	for _, op := range ops {
+		ux.Logger.PrintLineSeparator()
+		ux.Logger.PrintToUser("Node %s (validation ID %s): %s", op.registration.NodeID, op.registration.ValidationID, op.kind)
+		options := []string{}
+		if op.kind.CanComplete() {
+			options = append(options, completeOption)
+		}
+		if op.kind.CanAbort() {
+			options = append(options, abortOption)
+		}
+		if len(options) == 0 {
+			ux.Logger.PrintToUser(reconcileHint(op))
+			continue
+		}
+		options = append(options, skipOption)
+		option, err := app.Prompt.CaptureList("What do you want to do with this operation?", options)
+		if err != nil {
+			return err
+		}
+		if option == skipOption {
+			continue
+		}
+		if err := r.init(); err != nil {
+			return err
+		}
+		if err := r.reconcile(op); err != nil {
+			ux.Logger.RedXToUser("failure reconciling validation %s: %s", op.registration.ValidationID, err)
+			continue
+		}
+		ux.Logger.GreenCheckmarkToUser("Validation %s reconciled", op.registration.ValidationID)
+	}
+	return nil
+}
+
+// compares each one of [registrations] between the validator manager, where its status is
+// given by [getStatus], and the P-Chain, where [isOnPChain] tells if it is registered.
+// Returns the ones left inconsistent at unix time [now]
+func getPendingValidatorOperations(
+	registrations []validatormanager.L1ValidatorRegistration,
+	getStatus func(ids.ID) (validatormanager.ValidatorStatus, error),
+	isOnPChain func(ids.ID) (bool, error),
+	now uint64,
+) ([]pendingValidatorOperation, error) {
+	ops := []pendingValidatorOperation{}
+	for _, registration := range registrations {
+		status, err := getStatus(registration.ValidationID)
+		if err != nil {
+			return nil, fmt.Errorf("failure getting validation %s status on validator manager: %w", registration.ValidationID, err)
+		}
+		onPChain, err := isOnPChain(registration.ValidationID)
+		if err != nil {
+			return nil, fmt.Errorf("failure getting validation %s info on P-Chain: %w", registration.ValidationID, err)
+		}
+		kind := validatormanager.GetPendingOperation(registration, status, onPChain, now)
+		if kind == validatormanager.NoPendingOperation {
+			continue
+		}
+		ops = append(ops, pendingValidatorOperation{
+			registration: registration,
+			status:       status,
+			onPChain:     onPChain,
+			kind:         kind,
+		})
+	}
+	return ops, nil
+}
+
+// tells if [validationID] is registered on the P-Chain of [network]
+func isValidationOnPChain(network models.Network, validationID ids.ID) (bool, error) {
+	if _, err := validator.GetValidatorInfo(network.SDKNetwork(), validationID); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// what to do with operations that can not be completed or aborted from its current state
+func reconcileHint(op pendingValidatorOperation) string {
+	switch op.kind {
+	case validatormanager.RegistrationNotIssued:
+		return fmt.Sprintf(
+			"Either complete it with `avalanche blockchain addValidator %s --node-id %s`, or wait until its expiry at %s to abort it",
+			l1,
+			op.registration.NodeID,
+			time.Unix(int64(op.registration.Expiry), 0).Format(constants.TimeParseLayout),
+		)
+	case validatormanager.RemovedFromPChain:
+		return fmt.Sprintf(
+			"Remove it from the validator manager with `avalanche blockchain removeValidator %s --node-id %s`",
+			l1,
+			op.registration.NodeID,
+		)
+	default:
+		return "No action available"
+	}
+}
+
+// sets up validator manager owner key and signature aggregation, only once
+func (r *reconciler) init() error {
+	if r.aggregatorLogger != nil {
+		return nil
+	}
+	found, _, _, ownerPrivateKey, err := contract.SearchForManagedKey(
+		app,
+		r.network,
+		common.HexToAddress(r.sc.ValidatorManagerOwner),
+		true,
+	)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("not private key found for Validator manager owner %s", r.sc.ValidatorManagerOwner)
+	}
+	r.ownerPrivateKey = ownerPrivateKey
+	clusterName := r.sc.Networks[r.network.Name()].ClusterName
+	r.extraAggregatorPeers, err = blockchain.GetAggregatorExtraPeers(app, clusterName)
+	if err != nil {
+		return err
+	}
+	r.aggregatorLogger, err = signatureaggregator.NewSignatureAggregatorLoggerNewLogger(
+		reconcileFlags.SigAggFlags.AggregatorLogLevel,
+		reconcileFlags.SigAggFlags.AggregatorLogToStdout,
+		app.GetAggregatorLogDir(clusterName),
+	)
+	return err
+}
+
+func (r *reconciler) reconcile(op pendingValidatorOperation) error {
+	switch op.kind {
+	case validatormanager.RegistrationNotCompleted:
+		return r.finishRegistration(op.registration.ValidationID)
+	case validatormanager.RemovalNotIssued:
+		if err := r.issueRemoval(op.registration.ValidationID); err != nil {
+			return err
+		}
+		return r.finishRemoval(op.registration.ValidationID)
+	case validatormanager.RegistrationExpired, validatormanager.RemovalNotCompleted:
+		// expired registrations are aborted the same way removals are completed: with
+		// a P-Chain proof of the validation not being registered
+		return r.finishRemoval(op.registration.ValidationID)
+	}
+	return fmt.Errorf("unexpected operation %s", op.kind)
+}
+
+func (r *reconciler) finishRegistration(validationID ids.ID) error {
+	aggregatorCtx, aggregatorCancel := sdkutils.GetTimedContext(constants.SignatureAggregatorTimeout)
+	defer aggregatorCancel()
+	_, err := validatormanager.FinishValidatorRegistration(
+		aggregatorCtx,
+		app,
+		r.network,
+		r.rpcURL,
+		r.chainSpec,
+		false,
+		r.sc.ValidatorManagerOwner,
+		r.ownerPrivateKey,
+		validationID,
+		r.extraAggregatorPeers,
+		r.aggregatorLogger,
+		r.managerAddress.Hex(),
+	)
+	return err
+}
+
+// issues on the P-Chain the removal already initiated on the validator manager
+func (r *reconciler) issueRemoval(validationID ids.ID) error {
+	if r.deployer == nil {
+		// TODO: will estimate fee in subsecuent PR
+		fee := uint64(0)
+		kc, err := keychain.GetKeychainFromCmdLineFlags(
+			app,
+			constants.PayTxsFeesMsg,
+			r.network,
+			keyName,
+			useEwoq,
+			useLedger,
+			ledgerAddresses,
+			fee,
+		)
+		if err != nil {
+			return err
+		}
+		r.deployer = subnet.NewPublicDeployer(app, kc, r.network)
+	}
+	unsignedMessage, err := validatormanager.SearchForL1ValidatorWeightMessage(r.rpcURL, validationID, 0)
+	if err != nil {
+		return err
+	}
+	var nonce uint64
+	if unsignedMessage == nil {
+		nonce, err = validatormanager.GetValidatorNonce(r.rpcURL, validationID)
+		if err != nil {
+			return err
+		}
+	}
+	subnetID, err := contract.GetSubnetID(app, r.network, r.chainSpec)
+	if err != nil {
+		return err
+	}
+	blockchainID, err := contract.GetBlockchainID(app, r.network, r.chainSpec)
+	if err != nil {
+		return err
+	}
+	aggregatorCtx, aggregatorCancel := sdkutils.GetTimedContext(constants.SignatureAggregatorTimeout)
+	defer aggregatorCancel()
+	signedMessage, err := validatormanager.GetL1ValidatorWeightMessage(
+		aggregatorCtx,
+		r.network,
+		r.aggregatorLogger,
+		0,
+		r.extraAggregatorPeers,
+		unsignedMessage,
+		subnetID,
+		blockchainID,
+		r.managerAddress,
+		validationID,
+		nonce,
+		0,
+	)
+	if err != nil {
+		return err
+	}
+	txID, _, err := r.deployer.SetL1ValidatorWeight(signedMessage)
+	if err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("SetL1ValidatorWeightTx ID: %s", txID)
+	return blockchain.UpdatePChainHeight(
+		"Waiting for P-Chain to update validator information ...",
+	)
+}
+
+func (r *reconciler) finishRemoval(validationID ids.ID) error {
+	aggregatorCtx, aggregatorCancel := sdkutils.GetTimedContext(constants.SignatureAggregatorTimeout)
+	defer aggregatorCancel()
+	_, err := validatormanager.FinishValidatorRemoval(
+		aggregatorCtx,
+		app,
+		r.network,
+		r.rpcURL,
+		r.chainSpec,
+		false,
+		r.sc.ValidatorManagerOwner,
+		r.ownerPrivateKey,
+		validationID,
+		r.extraAggregatorPeers,
+		r.aggregatorLogger,
+		r.managerAddress.Hex(),
+		r.sc.PoA() && r.sc.UseACP99,
+	)
+	return err
+}
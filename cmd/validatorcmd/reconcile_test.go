@@ -0,0 +1,139 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatorcmd
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/validatormanager"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPendingValidatorOperations(t *testing.T) {
+	require := require.New(t)
+	now := uint64(1_000_000)
+	newRegistration := func(expiry uint64) validatormanager.L1ValidatorRegistration {
+		return validatormanager.L1ValidatorRegistration{
+			ValidationID: ids.GenerateTestID(),
+			NodeID:       ids.GenerateTestNodeID(),
+			Weight:       20,
+			Expiry:       expiry,
+		}
+	}
+	active := newRegistration(now - 10)
+	completed := newRegistration(now - 10)
+	notCompleted := newRegistration(now + 10)
+	expired := newRegistration(now - 10)
+	removedFromPChain := newRegistration(now - 10)
+	registrations := []validatormanager.L1ValidatorRegistration{active, completed, notCompleted, expired, removedFromPChain}
+	statuses := map[ids.ID]validatormanager.ValidatorStatus{
+		active.ValidationID:            validatormanager.ActiveValidatorStatus,
+		completed.ValidationID:         validatormanager.CompletedValidatorStatus,
+		notCompleted.ValidationID:      validatormanager.PendingAddedValidatorStatus,
+		expired.ValidationID:           validatormanager.PendingAddedValidatorStatus,
+		removedFromPChain.ValidationID: validatormanager.ActiveValidatorStatus,
+	}
+	onPChain := map[ids.ID]bool{
+		active.ValidationID:       true,
+		notCompleted.ValidationID: true,
+	}
+	getStatus := func(validationID ids.ID) (validatormanager.ValidatorStatus, error) {
+		return statuses[validationID], nil
+	}
+	isOnPChain := func(validationID ids.ID) (bool, error) {
+		return onPChain[validationID], nil
+	}
+
+	ops, err := getPendingValidatorOperations(registrations, getStatus, isOnPChain, now)
+	require.NoError(err)
+	require.Equal([]pendingValidatorOperation{
+		{
+			registration: notCompleted,
+			status:       validatormanager.PendingAddedValidatorStatus,
+			onPChain:     true,
+			kind:         validatormanager.RegistrationNotCompleted,
+		},
+		{
+			registration: expired,
+			status:       validatormanager.PendingAddedValidatorStatus,
+			kind:         validatormanager.RegistrationExpired,
+		},
+		{
+			registration: removedFromPChain,
+			status:       validatormanager.ActiveValidatorStatus,
+			kind:         validatormanager.RemovedFromPChain,
+		},
+	}, ops)
+
+	ops, err = getPendingValidatorOperations(nil, getStatus, isOnPChain, now)
+	require.NoError(err)
+	require.Empty(ops)
+
+	lookupErr := errors.New("lookup failure")
+	_, err = getPendingValidatorOperations(
+		registrations,
+		func(ids.ID) (validatormanager.ValidatorStatus, error) {
+			return validatormanager.UnknownValidatorStatus, lookupErr
+		},
+		isOnPChain,
+		now,
+	)
+	require.ErrorIs(err, lookupErr)
+	require.ErrorContains(err, "status on validator manager")
+	_, err = getPendingValidatorOperations(
+		registrations,
+		getStatus,
+		func(ids.ID) (bool, error) {
+			return false, lookupErr
+		},
+		now,
+	)
+	require.ErrorIs(err, lookupErr)
+	require.ErrorContains(err, "info on P-Chain")
+}
+
+func TestReconcileHint(t *testing.T) {
+	require := require.New(t)
+	l1 = "testl1"
+	defer func() {
+		l1 = ""
+	}()
+	nodeID := ids.GenerateTestNodeID()
+	expiry := uint64(1_700_000_000)
+	newOperation := func(kind validatormanager.PendingOperationKind) pendingValidatorOperation {
+		return pendingValidatorOperation{
+			registration: validatormanager.L1ValidatorRegistration{
+				ValidationID: ids.GenerateTestID(),
+				NodeID:       nodeID,
+				Expiry:       expiry,
+			},
+			kind: kind,
+		}
+	}
+	hint := reconcileHint(newOperation(validatormanager.RegistrationNotIssued))
+	require.Contains(hint, "avalanche blockchain addValidator testl1 --node-id "+nodeID.String())
+	require.Contains(hint, time.Unix(int64(expiry), 0).Format(constants.TimeParseLayout))
+	require.Equal(
+		"Remove it from the validator manager with `avalanche blockchain removeValidator testl1 --node-id "+nodeID.String()+"`",
+		reconcileHint(newOperation(validatormanager.RemovedFromPChain)),
+	)
+	require.Equal("No action available", reconcileHint(newOperation(validatormanager.NoPendingOperation)))
+}
+
+func TestReconcileRejectsOperationsWithoutAction(t *testing.T) {
+	require := require.New(t)
+	r := reconciler{}
+	for _, kind := range []validatormanager.PendingOperationKind{
+		validatormanager.NoPendingOperation,
+		validatormanager.RegistrationNotIssued,
+		validatormanager.RemovedFromPChain,
+	} {
+		require.False(kind.CanComplete() || kind.CanAbort())
+		err := r.reconcile(pendingValidatorOperation{kind: kind})
+		require.ErrorContains(err, "unexpected operation "+kind.String())
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatorcmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/cmd/flags"
+	"github.com/ava-labs/avalanche-cli/pkg/cobrautils"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	"github.com/ava-labs/avalanche-cli/pkg/networkoptions"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/pkg/validatormanager"
+	validatorManagerSDK "github.com/ava-labs/avalanche-cli/sdk/validatormanager"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+type RewardsEstimateFlags struct {
+	RPC                     string
+	Stake                   float64
+	Duration                time.Duration
+	Uptime                  float64
+	RewardCalculatorAddress string
+}
+
+var rewardsEstimateFlags RewardsEstimateFlags
+
+// avalanche validator rewards
+func NewRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards",
+		Short: "Query PoS L1 validator rewards",
+		Long:  `The validator rewards command suite provides tools to preview the rewards paid by the reward calculator of a PoS L1.`,
+		RunE:  cobrautils.CommandSuiteUsage,
+	}
+	// validator rewards estimate
+	cmd.AddCommand(newRewardsEstimateCmd())
+	return cmd
+}
+
+// avalanche validator rewards estimate
+func newRewardsEstimateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate",
+		Short: "Estimates the reward for staking on a PoS L1",
+		Long: `This command asks the reward calculator of a PoS L1 for the reward a validator would get
+for staking the given amount, during the given duration, with the given uptime.
+
+The staking period is assumed to start now. The reward calculator used is the one the
+L1 validator manager was initialized with, or the one given by --reward-calculator-address.`,
+		RunE: estimateRewards,
+		Args: cobrautils.ExactArgs(0),
+	}
+	networkoptions.AddNetworkFlagsToCmd(cmd, &globalNetworkFlags, true, networkoptions.DefaultSupportedNetworkOptions)
+	flags.AddRPCFlagToCmd(cmd, app, &rewardsEstimateFlags.RPC)
+	cmd.Flags().StringVar(&l1, "l1", "", "name of L1")
+	cmd.Flags().Float64Var(&rewardsEstimateFlags.Stake, "stake", 0, "amount to stake, in staking token units")
+	cmd.Flags().DurationVar(&rewardsEstimateFlags.Duration, "duration", 0, "staking duration (e.g. 720h)")
+	cmd.Flags().Float64Var(&rewardsEstimateFlags.Uptime, "uptime", 100, "validator uptime percentage during the staking period")
+	cmd.Flags().StringVar(&rewardsEstimateFlags.RewardCalculatorAddress, "reward-calculator-address", "", "use the reward calculator at this address")
+	return cmd
+}
+
+func estimateRewards(_ *cobra.Command, _ []string) error {
+	if rewardsEstimateFlags.Stake <= 0 {
+		return fmt.Errorf("--stake must be a positive amount")
+	}
+	if rewardsEstimateFlags.Duration <= 0 {
+		return fmt.Errorf("--duration must be a positive duration")
+	}
+	if rewardsEstimateFlags.Uptime < 0 || rewardsEstimateFlags.Uptime > 100 {
+		return fmt.Errorf("--uptime must be a percentage between 0 and 100")
+	}
+	if rewardsEstimateFlags.RewardCalculatorAddress != "" && !common.IsHexAddress(rewardsEstimateFlags.RewardCalculatorAddress) {
+		return fmt.Errorf("invalid reward calculator address %q", rewardsEstimateFlags.RewardCalculatorAddress)
+	}
+	network, err := networkoptions.GetNetworkFromCmdLineFlags(
+		app,
+		"",
+		globalNetworkFlags,
+		true,
+		false,
+		networkoptions.DefaultSupportedNetworkOptions,
+		"",
+	)
+	if err != nil {
+		return err
+	}
+	chainSpec := contract.ChainSpec{
+		BlockchainName: l1,
+	}
+	if l1 == "" {
+		chainSpec.SetEnabled(
+			true,  // prompt blockchain name
+			false, // do not prompt for PChain
+			false, // do not prompt for XChain
+			false, // do not prompt for CChain
+			false, // do not prompt blockchain ID
+		)
+		chainSpec.OnlySOV = true
+		if cancel, err := contract.PromptChain(
+			app,
+			network,
+			"Choose the L1",
+			"",
+			&chainSpec,
+		); err != nil {
+			return err
+		} else if cancel {
+			return nil
+		}
+		l1 = chainSpec.BlockchainName
+	}
+	sc, err := app.LoadSidecar(l1)
+	if err != nil {
+		return fmt.Errorf("failed to load sidecar: %w", err)
+	}
+	if !sc.PoS() {
+		return fmt.Errorf("rewards are only applicable to proof of stake L1s")
+	}
+	if rewardsEstimateFlags.RPC == "" {
+		rewardsEstimateFlags.RPC, _, err = contract.GetBlockchainEndpoints(
+			app,
+			network,
+			chainSpec,
+			true,
+			false,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	rewardCalculatorAddress := rewardsEstimateFlags.RewardCalculatorAddress
+	if rewardCalculatorAddress == "" {
+		rewardCalculatorAddress = sc.Networks[network.Name()].RewardCalculatorAddress
+	}
+	if rewardCalculatorAddress == "" {
+		rewardCalculatorAddress = validatorManagerSDK.RewardCalculatorAddress
+	}
+
+	stakeToken, err := getStakeTokenInfo(rewardsEstimateFlags.RPC, sc, l1)
+	if err != nil {
+		return err
+	}
+	stakeAmount := stakeToken.parse(rewardsEstimateFlags.Stake)
+	durationSeconds := uint64(rewardsEstimateFlags.Duration.Seconds())
+	uptimeSeconds := uint64(float64(durationSeconds) * rewardsEstimateFlags.Uptime / 100)
+	startTime := uint64(time.Now().Unix())
+	endTime := startTime + durationSeconds
+
+	reward, err := validatormanager.EstimateReward(
+		rewardsEstimateFlags.RPC,
+		common.HexToAddress(rewardCalculatorAddress),
+		stakeAmount,
+		startTime,
+		startTime,
+		endTime,
+		uptimeSeconds,
+	)
+	if err != nil {
+		return fmt.Errorf("failure calling reward calculator at %s: %w", rewardCalculatorAddress, err)
+	}
+
+	ux.Logger.PrintToUser("Reward Calculator: %s", rewardCalculatorAddress)
+	ux.Logger.PrintToUser("Stake: %s %s", stakeToken.format(stakeAmount), stakeToken.symbol)
+	ux.Logger.PrintToUser("Duration: %s", rewardsEstimateFlags.Duration)
+	ux.Logger.PrintToUser("Uptime: %v%%", rewardsEstimateFlags.Uptime)
+	ux.Logger.PrintLineSeparator()
+	ux.Logger.PrintToUser("Estimated Reward: %s %s", stakeToken.format(reward), stakeToken.symbol)
+	if reward.Sign() == 0 && rewardsEstimateFlags.Uptime < 100 {
+		ux.Logger.PrintToUser("The reward calculator pays no rewards for this uptime")
+	}
+	return nil
+}
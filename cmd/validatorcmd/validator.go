@@ -29,5 +29,11 @@ the validator will be considered inactive and will no longer participate in vali
 	cmd.AddCommand(NewGetBalanceCmd())
 	// validator increaseBalance
 	cmd.AddCommand(NewIncreaseBalanceCmd())
+	// validator reconcile
+	cmd.AddCommand(NewReconcileCmd())
+	// validator autotopup
+	cmd.AddCommand(NewAutoTopUpCmd())
+	// validator rewards
+	cmd.AddCommand(NewRewardsCmd())
 	return cmd
 }
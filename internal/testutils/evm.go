@@ -0,0 +1,130 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package testutils
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/eth/ethconfig"
+	"github.com/ava-labs/subnet-evm/ethclient"
+	"github.com/ava-labs/subnet-evm/ethclient/simulated"
+	"github.com/ava-labs/subnet-evm/node"
+	"github.com/ava-labs/subnet-evm/params"
+	"github.com/ava-labs/subnet-evm/precompile/contracts/warp"
+	"github.com/ava-labs/subnet-evm/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SimulatedEVM is an in memory subnet-evm chain, served through an HTTP RPC endpoint
+// so it can be used by code that connects to an RPC URL. A block is produced after
+// each transaction is sent. Warp is enabled, so messages can be sent but not received
+type SimulatedEVM struct {
+	Backend    *simulated.Backend
+	RPCURL     string
+	PrivateKey string
+	Address    common.Address
+}
+
+type jsonRPCRequest struct {
+	JSONRPC string            `json:"jsonrpc"`
+	ID      json.RawMessage   `json:"id"`
+	Method  string            `json:"method"`
+	Params  []json.RawMessage `json:"params"`
+}
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *jsonRPCError   `json:"error,omitempty"`
+}
+
+// NewSimulatedEVM starts a simulated EVM with a funded account, whose key is given
+// on the result. If given, [addAllocations] adds genesis allocations, that can depend
+// on the funded account
+func NewSimulatedEVM(
+	t *testing.T,
+	addAllocations func(alloc types.GenesisAlloc, funded common.Address),
+) *SimulatedEVM {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	alloc := types.GenesisAlloc{}
+	if addAllocations != nil {
+		addAllocations(alloc, address)
+	}
+	alloc[address] = types.Account{
+		Balance: new(big.Int).Mul(big.NewInt(1_000_000), big.NewInt(1e18)),
+	}
+	backend := simulated.NewBackend(alloc, func(_ *node.Config, ethConf *ethconfig.Config) {
+		// start at current time so all network upgrades are active
+		ethConf.Genesis.Timestamp = uint64(time.Now().Unix())
+		ethConf.Genesis.Config.SnowCtx = snowtest.Context(t, ids.GenerateTestID())
+		ethConf.Genesis.Config.GenesisPrecompiles = params.Precompiles{
+			warp.ConfigKey: warp.NewDefaultConfig(utils.NewUint64(0)),
+		}
+	})
+	// the simulated client embeds an ethclient over an in process RPC client, which is
+	// used to serve the HTTP requests
+	rpcClient := reflect.ValueOf(backend.Client()).Field(0).Interface().(ethclient.Client).Client()
+	var lock sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req jsonRPCRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		params := make([]interface{}, len(req.Params))
+		for i := range req.Params {
+			params[i] = req.Params[i]
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		resp := jsonRPCResponse{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+		}
+		var result json.RawMessage
+		if err := rpcClient.CallContext(r.Context(), &result, req.Method, params...); err != nil {
+			resp.Error = &jsonRPCError{Code: -32000, Message: err.Error()}
+		} else {
+			if result == nil {
+				result = json.RawMessage("null")
+			}
+			resp.Result = result
+			if req.Method == "eth_sendRawTransaction" {
+				backend.Commit(true)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(func() {
+		server.Close()
+		_ = backend.Close()
+	})
+	return &SimulatedEVM{
+		Backend:    backend,
+		RPCURL:     server.URL,
+		PrivateKey: common.Bytes2Hex(crypto.FromECDSA(key)),
+		Address:    address,
+	}
+}
@@ -157,6 +157,10 @@ func (app *Avalanche) GetICMRelayerBinDir() string {
 	return filepath.Join(app.baseDir, constants.AvalancheCliBinDir, constants.ICMRelayerInstallDir)
 }
 
+func (app *Avalanche) GetLocalMonitoringDir() string {
+	return filepath.Join(app.GetRunDir(), constants.LocalMonitoringDir)
+}
+
 func (app *Avalanche) GetLocalRelayerDir(networkKind models.NetworkKind) string {
 	networkDirName := strings.ReplaceAll(networkKind.String(), " ", "")
 	return filepath.Join(app.GetRunDir(), networkDirName, constants.LocalRelayerDir)
@@ -181,6 +185,14 @@ func (app *Avalanche) GetLocalRelayerRunPath(networkKind models.NetworkKind) str
 	return filepath.Join(app.GetLocalRelayerDir(networkKind), constants.ICMRelayerRunFilename)
 }
 
+func (app *Avalanche) GetLocalRelayerSupervisorPath(networkKind models.NetworkKind) string {
+	return filepath.Join(app.GetLocalRelayerDir(networkKind), constants.ICMRelayerSupervisorFilename)
+}
+
+func (app *Avalanche) GetLocalRelayerEventsPath(networkKind models.NetworkKind) string {
+	return filepath.Join(app.GetLocalRelayerDir(networkKind), constants.ICMRelayerEventsFilename)
+}
+
 func (app *Avalanche) GetICMRelayerServiceDir(baseDir string) string {
 	return filepath.Join(app.GetServicesDir(baseDir), constants.ICMRelayerInstallDir)
 }
@@ -200,6 +212,11 @@ func (app *Avalanche) GetSubnetEVMBinDir() string {
 	return filepath.Join(app.baseDir, constants.AvalancheCliBinDir, constants.SubnetEVMInstallDir)
 }
 
+// GetVMBinDir returns the dir where releases of VM [repoName] are installed
+func (app *Avalanche) GetVMBinDir(repoName string) string {
+	return filepath.Join(app.baseDir, constants.AvalancheCliBinDir, repoName)
+}
+
 func (app *Avalanche) GetUpgradeBytesFilepath(blockchainName string) string {
 	return filepath.Join(app.GetSubnetDir(), blockchainName, constants.UpgradeFileName)
 }
@@ -257,6 +274,10 @@ func (app *Avalanche) GetSidecarPath(blockchainName string) string {
 	return filepath.Join(app.GetSubnetDir(), blockchainName, constants.SidecarFileName)
 }
 
+func (app *Avalanche) GetDeployPlanPath(blockchainName string) string {
+	return filepath.Join(app.GetSubnetDir(), blockchainName, constants.DeployPlanFileName)
+}
+
 func (app *Avalanche) GetNodeConfigPath(nodeName string) string {
 	return filepath.Join(app.GetNodesDir(), nodeName, constants.NodeCloudConfigFileName)
 }
@@ -323,6 +344,14 @@ func (app *Avalanche) GetKeyDir() string {
 	return filepath.Join(app.baseDir, constants.KeyDir)
 }
 
+func (app *Avalanche) GetGenesisTemplatesDir() string {
+	return filepath.Join(app.baseDir, constants.GenesisTemplatesDir)
+}
+
+func (app *Avalanche) GetVMDescriptorsDir() string {
+	return filepath.Join(app.baseDir, constants.VMDescriptorsDir)
+}
+
 func (*Avalanche) GetTmpPluginDir() string {
 	return os.TempDir()
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/ava-labs/avalanche-cli/pkg/ux"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 type UsageError struct {
@@ -101,3 +102,40 @@ func ConfigureRootCmd(cmd *cobra.Command) {
 		return NewUsageError(cmd, err)
 	})
 }
+
+// RunCommand runs [cmd] with [args], setting its flags from [flagValues] as if they were
+// given on the command line. Flags set by previous runs are reset to their defaults
+func RunCommand(cmd *cobra.Command, args []string, flagValues map[string]string) error {
+	var resetErr error
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if !flag.Changed || resetErr != nil {
+			return
+		}
+		if sliceValue, ok := flag.Value.(pflag.SliceValue); ok {
+			resetErr = sliceValue.Replace(nil)
+		} else {
+			resetErr = flag.Value.Set(flag.DefValue)
+		}
+		flag.Changed = false
+	})
+	if resetErr != nil {
+		return resetErr
+	}
+	for name, value := range flagValues {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			return NewUsageError(cmd, fmt.Errorf("invalid value %q for flag --%s: %w", value, name, err))
+		}
+	}
+	if err := cmd.ValidateArgs(args); err != nil {
+		return err
+	}
+	if cmd.RunE == nil {
+		return fmt.Errorf("command %q is not runnable", cmd.CommandPath())
+	}
+	if cmd.PreRunE != nil {
+		if err := cmd.PreRunE(cmd, args); err != nil {
+			return err
+		}
+	}
+	return cmd.RunE(cmd, args)
+}
@@ -35,6 +35,7 @@ const (
 	GenesisFileName              = "genesis.json"
 	UpgradeFileName              = "upgrade.json"
 	AliasesFileName              = "aliases.json"
+	DeployPlanFileName           = "deploy_plan.json"
 	SidecarSuffix                = SuffixSeparator + SidecarFileName
 	GenesisSuffix                = SuffixSeparator + GenesisFileName
 	NodeFileName                 = "node.json"
@@ -215,10 +216,13 @@ const (
 	ICMContractsInstallDir        = "icm-contracts"
 	ICMRelayerBin                 = "icm-relayer"
 	LocalRelayerDir               = "local-relayer"
+	LocalMonitoringDir            = "local-monitoring"
 	ICMRelayerConfigFilename      = "icm-relayer-config.json"
 	ICMRelayerStorageDir          = "icm-relayer-storage"
 	ICMRelayerLogFilename         = "icm-relayer.log"
 	ICMRelayerRunFilename         = "icm-relayer-process.json"
+	ICMRelayerSupervisorFilename  = "icm-relayer-supervisor.json"
+	ICMRelayerEventsFilename      = "icm-relayer-events.jsonl"
 	ICMRelayerDockerDir           = "/.icm-relayer"
 
 	ICMKeyName           = "cli-teleporter-deployer"
@@ -248,10 +252,13 @@ const (
 	MetricsAPITokenEnvVarName = "AVALANCHE_CLI_METRICS_TOKEN"
 
 	ReposDir                    = "repos"
+	CustomVMBuildsDir           = "custom-vm-builds"
 	SubnetDir                   = "subnets"
 	NodesDir                    = "nodes"
 	VMDir                       = "vms"
 	ChainConfigDir              = "chains"
+	GenesisTemplatesDir         = "genesis-templates"
+	VMDescriptorsDir            = "vm-descriptors"
 	AVMKeyName                  = "avm"
 	EVMKeyName                  = "evm"
 	PlatformKeyName             = "platform"
@@ -332,7 +339,9 @@ const (
 	ICTTDir     = "icm-contracts"
 	ICTTURL     = "https://github.com/ava-labs/icm-contracts"
 	ICTTBranch  = "main"
-	ICTTVersion = "8012c2a90638c1b777622e6427dbe4a88e329539"
+	ICTTVersion = "v1.0.8"
+
+	ICTTTransferLegTimeout = 1 * time.Minute
 
 	// ICM
 	ICMVersion                       = "v1.0.0"
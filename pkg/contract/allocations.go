@@ -5,11 +5,13 @@ package contract
 import (
 	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/ava-labs/avalanche-cli/pkg/application"
 	"github.com/ava-labs/avalanche-cli/pkg/key"
 	"github.com/ava-labs/avalanche-cli/pkg/models"
 	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	"github.com/ava-labs/subnet-evm/core"
 	"github.com/ava-labs/subnet-evm/precompile/contracts/nativeminter"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -163,15 +165,56 @@ func GetBlockchainGenesis(
 func sumGenesisSupply(
 	genesisData []byte,
 ) (*big.Int, error) {
-	sum := new(big.Int)
 	genesis, err := utils.ByteSliceToSubnetEvmGenesis(genesisData)
 	if err != nil {
-		return sum, err
+		return new(big.Int), err
 	}
-	for _, allocation := range genesis.Alloc {
-		sum.Add(sum, allocation.Balance)
+	return GetGenesisSupplySummary(genesis.Alloc, 0).TotalSupply, nil
+}
+
+// GenesisHolder is an address funded at genesis
+type GenesisHolder struct {
+	Address common.Address
+	Balance *big.Int
+}
+
+// GenesisSupplySummary describes the distribution of the genesis token supply
+type GenesisSupplySummary struct {
+	Holders     int
+	TotalSupply *big.Int
+	// holders with the largest balances, in decreasing order
+	TopHolders []GenesisHolder
+}
+
+// GetGenesisSupplySummary returns the supply distribution of [alloc], including up
+// to [maxTopHolders] top holders. Accounts without balance are not considered holders
+func GetGenesisSupplySummary(
+	alloc core.GenesisAlloc,
+	maxTopHolders int,
+) GenesisSupplySummary {
+	summary := GenesisSupplySummary{
+		TotalSupply: new(big.Int),
+	}
+	holders := []GenesisHolder{}
+	for address, allocation := range alloc {
+		if allocation.Balance == nil || allocation.Balance.Sign() == 0 {
+			continue
+		}
+		summary.TotalSupply.Add(summary.TotalSupply, allocation.Balance)
+		holders = append(holders, GenesisHolder{Address: address, Balance: allocation.Balance})
+	}
+	sort.Slice(holders, func(i, j int) bool {
+		if c := holders[i].Balance.Cmp(holders[j].Balance); c != 0 {
+			return c > 0
+		}
+		return holders[i].Address.Hex() < holders[j].Address.Hex()
+	})
+	summary.Holders = len(holders)
+	if len(holders) > maxTopHolders {
+		holders = holders[:maxTopHolders]
 	}
-	return sum, nil
+	summary.TopHolders = holders
+	return summary
 }
 
 func GetEVMSubnetGenesisSupply(
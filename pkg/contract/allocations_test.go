@@ -3,8 +3,10 @@
 package contract
 
 import (
+	"math/big"
 	"testing"
 
+	"github.com/ava-labs/subnet-evm/core"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
@@ -192,3 +194,33 @@ func TestContractAddressIsInGenesisData(t *testing.T) {
 		require.Equal(t.expected, b, t.desc)
 	}
 }
+
+func TestGetGenesisSupplySummary(t *testing.T) {
+	require := require.New(t)
+
+	addrs := []common.Address{
+		common.HexToAddress("0x1000000000000000000000000000000000000001"),
+		common.HexToAddress("0x2000000000000000000000000000000000000002"),
+		common.HexToAddress("0x3000000000000000000000000000000000000003"),
+		common.HexToAddress("0x4000000000000000000000000000000000000004"),
+	}
+	alloc := core.GenesisAlloc{
+		addrs[0]: {Balance: big.NewInt(10)},
+		addrs[1]: {Balance: big.NewInt(30)},
+		addrs[2]: {Balance: big.NewInt(10)},
+		// contracts without balance are not holders
+		addrs[3]: {Code: []byte{0xfe}},
+	}
+	summary := GetGenesisSupplySummary(alloc, 2)
+	require.Equal(3, summary.Holders)
+	require.Equal(big.NewInt(50), summary.TotalSupply)
+	require.Equal([]GenesisHolder{
+		{Address: addrs[1], Balance: big.NewInt(30)},
+		{Address: addrs[0], Balance: big.NewInt(10)},
+	}, summary.TopHolders)
+
+	summary = GetGenesisSupplySummary(core.GenesisAlloc{}, 2)
+	require.Zero(summary.Holders)
+	require.Zero(summary.TotalSupply.Sign())
+	require.Empty(summary.TopHolders)
+}
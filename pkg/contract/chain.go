@@ -229,6 +229,36 @@ func GetBlockchainEndpoints(
 	return rpcEndpoint, wsEndpoint, nil
 }
 
+// GetKnownBlockchainEndpoints returns the RPC endpoints and descriptions, indexed by blockchain ID,
+// of the CLI blockchains deployed on [network] plus the C-Chain
+func GetKnownBlockchainEndpoints(
+	app *application.Avalanche,
+	network models.Network,
+) (map[ids.ID]string, map[ids.ID]string, error) {
+	rpcEndpoints := map[ids.ID]string{}
+	descs := map[ids.ID]string{}
+	blockchainNames, err := app.GetBlockchainNamesOnNetwork(network, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, blockchainName := range blockchainNames {
+		chainSpec := ChainSpec{BlockchainName: blockchainName}
+		blockchainID, err := GetBlockchainID(app, network, chainSpec)
+		if err != nil {
+			continue
+		}
+		descs[blockchainID] = blockchainName
+		if rpcEndpoint, _, err := GetBlockchainEndpoints(app, network, chainSpec, false, false); err == nil && rpcEndpoint != "" {
+			rpcEndpoints[blockchainID] = rpcEndpoint
+		}
+	}
+	if blockchainID, err := GetBlockchainID(app, network, ChainSpec{CChain: true}); err == nil {
+		descs[blockchainID] = "C-Chain"
+		rpcEndpoints[blockchainID] = network.CChainEndpoint()
+	}
+	return rpcEndpoints, descs, nil
+}
+
 func GetBlockchainID(
 	app *application.Avalanche,
 	network models.Network,
@@ -507,6 +507,26 @@ func handleFailedReceiptStatus(
 	return tx, receipt, ErrFailedReceiptStatus
 }
 
+// PackMethodCall gets method name and types from [methodSpec], and returns the
+// call data to call it with the given [params]
+func PackMethodCall(
+	methodSpec string,
+	params ...interface{},
+) ([]byte, error) {
+	methodName, methodABI, err := ParseSpec(methodSpec, nil, false, false, false, false, params...)
+	if err != nil {
+		return nil, err
+	}
+	metadata := &bind.MetaData{
+		ABI: methodABI,
+	}
+	abi, err := metadata.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return abi.Pack(methodName, params...)
+}
+
 func DebugTraceCall(
 	rpcURL string,
 	from common.Address,
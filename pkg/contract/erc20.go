@@ -0,0 +1,52 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package contract
+
+import (
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GetERC20Balance gets the [tokenAddress] balance of [address]
+func GetERC20Balance(
+	rpcURL string,
+	tokenAddress common.Address,
+	address common.Address,
+) (*big.Int, error) {
+	out, err := CallToMethod(
+		rpcURL,
+		tokenAddress,
+		"balanceOf(address)->(uint256)",
+		address,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return GetSmartContractCallResult[*big.Int]("balanceOf", out)
+}
+
+// ApproveERC20 allows [spender] to transfer [amount] of [tokenAddress] from the
+// address of [privateKey]
+func ApproveERC20(
+	rpcURL string,
+	privateKey string,
+	tokenAddress common.Address,
+	spender common.Address,
+	amount *big.Int,
+) (*types.Transaction, *types.Receipt, error) {
+	return TxToMethod(
+		rpcURL,
+		false,
+		common.Address{},
+		privateKey,
+		tokenAddress,
+		big.NewInt(0),
+		"approve ERC20 spending",
+		nil,
+		"approve(address,uint256)",
+		spender,
+		amount,
+	)
+}
@@ -2,7 +2,22 @@
 // See the file LICENSE for licensing terms.
 package contract
 
-import "github.com/ethereum/go-ethereum/common"
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	ErrOwnableInvalidOwner        = errors.New("invalid owner")
+	ErrOwnableUnauthorizedAccount = errors.New("unauthorized owner")
+	ownableErrorSignatureToError  = map[string]error{
+		"OwnableInvalidOwner(address)":        ErrOwnableInvalidOwner,
+		"OwnableUnauthorizedAccount(address)": ErrOwnableUnauthorizedAccount,
+	}
+)
 
 // GetContractOwner gets owner for https://docs.openzeppelin.com/contracts/2.x/api/ownership#Ownable-owner contracts
 func GetContractOwner(
@@ -19,3 +34,27 @@ func GetContractOwner(
 	}
 	return GetSmartContractCallResult[common.Address]("owner", out)
 }
+
+// TransferOwnership transfers ownership of https://docs.openzeppelin.com/contracts/2.x/api/ownership#Ownable contracts
+// to [newOwner]. If [generateRawTxOnly], the tx is not signed, but returned to be signed by [ownerAddress]
+func TransferOwnership(
+	rpcURL string,
+	generateRawTxOnly bool,
+	ownerAddress common.Address,
+	ownerPrivateKey string,
+	contractAddress common.Address,
+	newOwner common.Address,
+) (*types.Transaction, *types.Receipt, error) {
+	return TxToMethod(
+		rpcURL,
+		generateRawTxOnly,
+		ownerAddress,
+		ownerPrivateKey,
+		contractAddress,
+		big.NewInt(0),
+		"transfer ownership",
+		ownableErrorSignatureToError,
+		"transferOwnership(address)",
+		newOwner,
+	)
+}
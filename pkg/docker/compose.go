@@ -8,6 +8,7 @@ import (
 	"embed"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"text/template"
@@ -27,6 +28,30 @@ type DockerComposeInputs struct {
 	E2E                bool
 	E2EIP              string
 	E2ESuffix          string
+	// local network export
+	LocalNetworkNodes  []LocalNetworkComposeNode
+	LocalNetworkSubnet string
+	WithICMRelayer     bool
+	// local network monitoring
+	LocalMonitoringDir  string
+	LocalMonitoringUser string
+	LocalMonitoringLogs []LocalMonitoringLogsSource
+}
+
+// LocalNetworkComposeNode is an avalanchego service of an exported local network
+type LocalNetworkComposeNode struct {
+	Name        string
+	IP          string
+	HTTPPort    uint16
+	StakingPort uint16
+	// service that must be started before this one, if any
+	DependsOn string
+}
+
+// LocalMonitoringLogsSource is a local network node whose logs are shipped to loki
+type LocalMonitoringLogsSource struct {
+	NodeID  string
+	LogsDir string
 }
 
 //go:embed templates/*.docker-compose.yml
@@ -48,6 +73,40 @@ func renderComposeFile(composePath string, composeDesc string, templateVars Dock
 	return composeBytes.Bytes(), nil
 }
 
+// RenderLocalNetworkComposeFile returns the content of a standalone docker-compose file
+// that runs the exported local network nodes and, optionally, its relayer
+func RenderLocalNetworkComposeFile(composeVars DockerComposeInputs) ([]byte, error) {
+	return renderComposeFile("templates/localnet.docker-compose.yml", "local network", composeVars)
+}
+
+// RenderLocalMonitoringComposeFile returns the content of a docker-compose file that runs
+// prometheus, loki, promtail and grafana for the local network
+func RenderLocalMonitoringComposeFile(composeVars DockerComposeInputs) ([]byte, error) {
+	return renderComposeFile("templates/localmonitoring.docker-compose.yml", "local monitoring", composeVars)
+}
+
+// StartLocalDockerCompose starts the services of [composeFile] on the local docker
+func StartLocalDockerCompose(composeFile string) error {
+	return runLocalDockerCompose(composeFile, "up", "-d")
+}
+
+// StopLocalDockerCompose stops and removes the services of [composeFile] on the local docker
+func StopLocalDockerCompose(composeFile string) error {
+	return runLocalDockerCompose(composeFile, "down")
+}
+
+func runLocalDockerCompose(composeFile string, args ...string) error {
+	if !utils.FileExists(composeFile) {
+		return fmt.Errorf("compose file %s does not exist", composeFile)
+	}
+	cmd := exec.Command("docker", append([]string{"compose", "-f", composeFile}, args...)...)
+	cmd.Env = os.Environ()
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: %s", err, string(output))
+	}
+	return nil
+}
+
 func pushComposeFile(host *models.Host, localFile string, remoteFile string, merge bool) error {
 	if !utils.FileExists(localFile) {
 		return fmt.Errorf("file %s does not exist to be uploaded to host: %s", localFile, host.NodeID)
@@ -0,0 +1,216 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package drift
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+// ErrRPCEndpointMismatch is observed for RPC endpoints that serve a different blockchain
+var ErrRPCEndpointMismatch = errors.New("endpoint serves a different blockchain")
+
+// Observed is the state of a blockchain deployment, as seen on the P-Chain and on the
+// blockchain itself
+type Observed struct {
+	// whether the sidecar subnet ID exists on the P-Chain
+	SubnetFound bool
+	// subnet validating the sidecar blockchain ID, or ids.Empty if there is no such blockchain
+	BlockchainSubnetID ids.ID
+	// subnet to L1 conversion info. ConversionID is ids.Empty for non converted subnets
+	ConversionID   ids.ID
+	ManagerChainID ids.ID
+	ManagerAddress string
+	// error reaching the blockchain RPC. If set, the following contract settings were not
+	// observed
+	RPCErr error
+	// whether the validator manager is behind the CLI validator manager proxy, and the
+	// implementation the proxy points to. Empty if not set up
+	ManagerIsProxy      bool
+	ProxyImplementation string
+	// whether the sidecar ICM messenger and registry addresses hold deployed contracts
+	MessengerDeployed bool
+	RegistryDeployed  bool
+	// errors found on the sidecar RPC endpoints, by endpoint
+	RPCEndpointErrs map[string]error
+	// validation IDs of the sidecar bootstrap validators that are active L1 validators, by node ID
+	BootstrapValidationIDs map[string]ids.ID
+}
+
+// Mismatch is a sidecar setting that does not match the observed state
+type Mismatch struct {
+	Field    string
+	Sidecar  string
+	Observed string
+	// what to do when the mismatch can not be fixed by updating the sidecar
+	Hint string
+	fix  func(sc *models.Sidecar, networkData *models.NetworkData)
+}
+
+// Fixable tells if the mismatch is fixed by updating the sidecar to the observed state
+func (m Mismatch) Fixable() bool {
+	return m.fix != nil
+}
+
+// Detect compares the [networkName] deployment recorded on [sc] against [observed]
+func Detect(sc models.Sidecar, networkName string, observed Observed) []Mismatch {
+	networkData := sc.Networks[networkName]
+	mismatches := []Mismatch{}
+	if !observed.SubnetFound {
+		return append(mismatches, Mismatch{
+			Field:    "SubnetID",
+			Sidecar:  networkData.SubnetID.String(),
+			Observed: "not found",
+			Hint:     "the blockchain may have been deployed on another network, or the sidecar belongs to another machine",
+		})
+	}
+	if observed.BlockchainSubnetID != networkData.SubnetID {
+		mismatch := Mismatch{
+			Field:    "BlockchainID",
+			Sidecar:  networkData.BlockchainID.String(),
+			Observed: "not found on subnet",
+			Hint:     "redeploy the blockchain into the subnet",
+		}
+		if observed.BlockchainSubnetID != ids.Empty {
+			mismatch.Observed = fmt.Sprintf("validated by subnet %s", observed.BlockchainSubnetID)
+		}
+		if observed.ManagerChainID != ids.Empty && observed.ManagerChainID != networkData.BlockchainID {
+			managerChainID := observed.ManagerChainID
+			mismatch.Observed = managerChainID.String()
+			mismatch.Hint = ""
+			mismatch.fix = func(_ *models.Sidecar, networkData *models.NetworkData) {
+				networkData.BlockchainID = managerChainID
+			}
+		}
+		mismatches = append(mismatches, mismatch)
+	}
+	converted := observed.ConversionID != ids.Empty
+	if converted != sc.Sovereign {
+		mismatches = append(mismatches, Mismatch{
+			Field:    "Sovereign",
+			Sidecar:  fmt.Sprintf("%t", sc.Sovereign),
+			Observed: fmt.Sprintf("%t", converted),
+			fix: func(sc *models.Sidecar, _ *models.NetworkData) {
+				sc.Sovereign = converted
+			},
+		})
+	}
+	if converted && !strings.EqualFold(observed.ManagerAddress, networkData.ValidatorManagerAddress) {
+		managerAddress := observed.ManagerAddress
+		mismatches = append(mismatches, Mismatch{
+			Field:    "ValidatorManagerAddress",
+			Sidecar:  networkData.ValidatorManagerAddress,
+			Observed: managerAddress,
+			fix: func(_ *models.Sidecar, networkData *models.NetworkData) {
+				networkData.ValidatorManagerAddress = managerAddress
+			},
+		})
+	}
+	for _, endpoint := range networkData.RPCEndpoints {
+		err, ok := observed.RPCEndpointErrs[endpoint]
+		if !ok || err == nil {
+			continue
+		}
+		mismatch := Mismatch{
+			Field:    "RPCEndpoints",
+			Sidecar:  endpoint,
+			Observed: err.Error(),
+			Hint:     "check the endpoint node",
+		}
+		if errors.Is(err, ErrRPCEndpointMismatch) {
+			mismatch.Hint = ""
+			mismatch.fix = func(_ *models.Sidecar, networkData *models.NetworkData) {
+				networkData.RPCEndpoints = slices.DeleteFunc(
+					networkData.RPCEndpoints,
+					func(e string) bool { return e == endpoint },
+				)
+			}
+		}
+		mismatches = append(mismatches, mismatch)
+	}
+	if observed.RPCErr != nil {
+		if converted || networkData.TeleporterMessengerAddress != "" || networkData.TeleporterRegistryAddress != "" {
+			mismatches = append(mismatches, Mismatch{
+				Field:    "blockchain RPC",
+				Observed: observed.RPCErr.Error(),
+				Hint:     "contract settings can not be verified until the blockchain RPC is reachable",
+			})
+		}
+	} else {
+		if converted && observed.ManagerIsProxy && observed.ProxyImplementation == "" {
+			mismatches = append(mismatches, Mismatch{
+				Field:    "validator manager proxy",
+				Sidecar:  observed.ManagerAddress,
+				Observed: "no implementation set",
+				Hint:     fmt.Sprintf("call `avalanche contract initValidatorManager %s`", sc.Name),
+			})
+		}
+		if networkData.TeleporterMessengerAddress != "" && !observed.MessengerDeployed {
+			mismatches = append(mismatches, Mismatch{
+				Field:    "TeleporterMessengerAddress",
+				Sidecar:  networkData.TeleporterMessengerAddress,
+				Observed: "no contract deployed",
+				fix: func(_ *models.Sidecar, networkData *models.NetworkData) {
+					networkData.TeleporterMessengerAddress = ""
+				},
+			})
+		}
+		if networkData.TeleporterRegistryAddress != "" && !observed.RegistryDeployed {
+			mismatches = append(mismatches, Mismatch{
+				Field:    "TeleporterRegistryAddress",
+				Sidecar:  networkData.TeleporterRegistryAddress,
+				Observed: "no contract deployed",
+				fix: func(_ *models.Sidecar, networkData *models.NetworkData) {
+					networkData.TeleporterRegistryAddress = ""
+				},
+			})
+		}
+	}
+	if converted {
+		for i, validator := range networkData.BootstrapValidators {
+			validationID, ok := observed.BootstrapValidationIDs[validator.NodeID]
+			if !ok {
+				mismatches = append(mismatches, Mismatch{
+					Field:    "BootstrapValidators",
+					Sidecar:  validator.NodeID,
+					Observed: "not an active L1 validator",
+					Hint:     "the validator may have been removed afterwards",
+				})
+				continue
+			}
+			if validator.ValidationID != validationID.String() {
+				mismatches = append(mismatches, Mismatch{
+					Field:    fmt.Sprintf("BootstrapValidators[%s].ValidationID", validator.NodeID),
+					Sidecar:  validator.ValidationID,
+					Observed: validationID.String(),
+					fix: func(_ *models.Sidecar, networkData *models.NetworkData) {
+						networkData.BootstrapValidators[i].ValidationID = validationID.String()
+					},
+				})
+			}
+		}
+	}
+	return mismatches
+}
+
+// Fix updates the [networkName] deployment recorded on [sc] with the observed state of the
+// fixable [mismatches]. Returns the number of fixed mismatches
+func Fix(sc *models.Sidecar, networkName string, mismatches []Mismatch) int {
+	networkData := sc.Networks[networkName]
+	networkData.RPCEndpoints = slices.Clone(networkData.RPCEndpoints)
+	networkData.BootstrapValidators = slices.Clone(networkData.BootstrapValidators)
+	fixed := 0
+	for _, mismatch := range mismatches {
+		if mismatch.Fixable() {
+			mismatch.fix(sc, &networkData)
+			fixed++
+		}
+	}
+	sc.Networks[networkName] = networkData
+	return fixed
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package drift
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testNetwork      = "Fuji"
+	testNodeID       = "NodeID-7Xhw2mDxuDS44j42TCB6U5579esbSt3Lg"
+	testProxyAddress = "0x0Feedc0de0000000000000000000000000000000"
+)
+
+func testSidecar(subnetID ids.ID, blockchainID ids.ID) models.Sidecar {
+	return models.Sidecar{
+		Name:      "testl1",
+		Sovereign: true,
+		Networks: map[string]models.NetworkData{
+			testNetwork: {
+				SubnetID:                   subnetID,
+				BlockchainID:               blockchainID,
+				ValidatorManagerAddress:    testProxyAddress,
+				TeleporterMessengerAddress: "0x253b2784c75e510dD0fF1da844684a1aC0aa5fcf",
+				RPCEndpoints:               []string{"http://127.0.0.1:9650/ext/bc/testl1/rpc"},
+				BootstrapValidators:        []models.SubnetValidator{{NodeID: testNodeID}},
+			},
+		},
+	}
+}
+
+func fields(mismatches []Mismatch) []string {
+	fields := []string{}
+	for _, mismatch := range mismatches {
+		fields = append(fields, mismatch.Field)
+	}
+	return fields
+}
+
+func TestDetect(t *testing.T) {
+	subnetID := ids.GenerateTestID()
+	blockchainID := ids.GenerateTestID()
+	validationID := subnetID.Append(0)
+	sc := testSidecar(subnetID, blockchainID)
+	sc.Networks[testNetwork].BootstrapValidators[0].ValidationID = validationID.String()
+	observed := Observed{
+		SubnetFound:            true,
+		BlockchainSubnetID:     subnetID,
+		ConversionID:           ids.GenerateTestID(),
+		ManagerChainID:         blockchainID,
+		ManagerAddress:         testProxyAddress,
+		ManagerIsProxy:         true,
+		ProxyImplementation:    "0x0C0DEBA5E0000000000000000000000000000000",
+		MessengerDeployed:      true,
+		RPCEndpointErrs:        map[string]error{},
+		BootstrapValidationIDs: map[string]ids.ID{testNodeID: validationID},
+	}
+	require.Empty(t, Detect(sc, testNetwork, observed))
+
+	// nothing else is checked for unknown subnets
+	unknownSubnet := observed
+	unknownSubnet.SubnetFound = false
+	mismatches := Detect(sc, testNetwork, unknownSubnet)
+	require.Equal(t, []string{"SubnetID"}, fields(mismatches))
+	require.False(t, mismatches[0].Fixable())
+
+	// contract settings are not checked while the RPC is unreachable
+	unreachable := observed
+	unreachable.RPCErr = errors.New("connection refused")
+	unreachable.MessengerDeployed = false
+	unreachable.ProxyImplementation = ""
+	require.Equal(t, []string{"blockchain RPC"}, fields(Detect(sc, testNetwork, unreachable)))
+
+	drifted := observed
+	drifted.ManagerAddress = "0x1111111111111111111111111111111111111111"
+	drifted.MessengerDeployed = false
+	drifted.RPCEndpointErrs = map[string]error{sc.Networks[testNetwork].RPCEndpoints[0]: ErrRPCEndpointMismatch}
+	drifted.BootstrapValidationIDs = map[string]ids.ID{testNodeID: subnetID.Append(1)}
+	mismatches = Detect(sc, testNetwork, drifted)
+	require.Equal(
+		t,
+		[]string{
+			"ValidatorManagerAddress",
+			"RPCEndpoints",
+			"TeleporterMessengerAddress",
+			"BootstrapValidators[" + testNodeID + "].ValidationID",
+		},
+		fields(mismatches),
+	)
+	for _, mismatch := range mismatches {
+		require.True(t, mismatch.Fixable())
+	}
+
+	require.Equal(t, 4, Fix(&sc, testNetwork, mismatches))
+	networkData := sc.Networks[testNetwork]
+	require.Equal(t, drifted.ManagerAddress, networkData.ValidatorManagerAddress)
+	require.Empty(t, networkData.RPCEndpoints)
+	require.Empty(t, networkData.TeleporterMessengerAddress)
+	require.Equal(t, subnetID.Append(1).String(), networkData.BootstrapValidators[0].ValidationID)
+	require.Empty(t, Detect(sc, testNetwork, drifted))
+}
+
+func TestDetectNonSovereign(t *testing.T) {
+	subnetID := ids.GenerateTestID()
+	blockchainID := ids.GenerateTestID()
+	sc := testSidecar(subnetID, blockchainID)
+	sc.Sovereign = false
+	networkData := sc.Networks[testNetwork]
+	networkData.ValidatorManagerAddress = ""
+	networkData.TeleporterMessengerAddress = ""
+	sc.Networks[testNetwork] = networkData
+	observed := Observed{
+		SubnetFound:        true,
+		BlockchainSubnetID: subnetID,
+	}
+	require.Empty(t, Detect(sc, testNetwork, observed))
+
+	// converted outside the CLI
+	newBlockchainID := ids.GenerateTestID()
+	observed.BlockchainSubnetID = ids.GenerateTestID()
+	observed.ConversionID = ids.GenerateTestID()
+	observed.ManagerChainID = newBlockchainID
+	observed.ManagerAddress = testProxyAddress
+	observed.ManagerIsProxy = true
+	observed.ProxyImplementation = "0x0C0DEBA5E0000000000000000000000000000000"
+	observed.BootstrapValidationIDs = map[string]ids.ID{testNodeID: subnetID.Append(0)}
+	mismatches := Detect(sc, testNetwork, observed)
+	require.Equal(
+		t,
+		[]string{"BlockchainID", "Sovereign", "ValidatorManagerAddress", "BootstrapValidators[" + testNodeID + "].ValidationID"},
+		fields(mismatches),
+	)
+	require.Equal(t, 4, Fix(&sc, testNetwork, mismatches))
+	require.True(t, sc.Sovereign)
+	require.Equal(t, newBlockchainID, sc.Networks[testNetwork].BlockchainID)
+}
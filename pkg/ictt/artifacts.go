@@ -0,0 +1,181 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package ictt
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+
+	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+)
+
+type Contract string
+
+const (
+	ERC20TokenHomeContract     Contract = "ERC20TokenHome"
+	NativeTokenHomeContract    Contract = "NativeTokenHome"
+	ERC20TokenRemoteContract   Contract = "ERC20TokenRemote"
+	NativeTokenRemoteContract  Contract = "NativeTokenRemote"
+	WrappedNativeTokenContract Contract = "WrappedNativeToken"
+
+	embeddedContractsDir = "contracts"
+	bytecodeExtension    = ".bin"
+	abiExtension         = ".abi.json"
+
+	// constructor parameter added by ICTT v1.0.0
+	minTeleporterVersionParam = "minTeleporterVersion"
+)
+
+// contracts needed to deploy ICTT homes and remotes
+var Contracts = []Contract{
+	ERC20TokenHomeContract,
+	NativeTokenHomeContract,
+	ERC20TokenRemoteContract,
+	NativeTokenRemoteContract,
+	WrappedNativeTokenContract,
+}
+
+// compiled ICTT contracts, one directory per ICTT version, as generated
+// by scripts/build_ictt_contracts.sh
+//
+//go:embed contracts
+var embeddedContracts embed.FS
+
+// Artifacts gives access to the compiled ICTT contracts, either the ones embedded
+// on the CLI for a given version, or the ones built by forge on a source directory
+type Artifacts struct {
+	Version string
+	// forge project dir, only set for custom builds
+	SrcDir string
+}
+
+// EmbeddedVersions returns the ICTT versions embedded on the CLI
+func EmbeddedVersions() []string {
+	entries, err := fs.ReadDir(embeddedContracts, embeddedContractsDir)
+	if err != nil {
+		return nil
+	}
+	versions := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			versions = append(versions, entry.Name())
+		}
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// HasEmbeddedVersion indicates if all ICTT contracts for [version] are embedded on the CLI
+func HasEmbeddedVersion(version string) bool {
+	for _, contract := range Contracts {
+		if _, err := fs.Stat(embeddedContracts, embeddedContractPath(version, contract, bytecodeExtension)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// GetEmbeddedArtifacts returns the ICTT contracts embedded on the CLI for [version]
+func GetEmbeddedArtifacts(version string) (Artifacts, error) {
+	if !HasEmbeddedVersion(version) {
+		return Artifacts{}, fmt.Errorf("ICTT version %s is not embedded on the CLI. Available versions: %v", version, EmbeddedVersions())
+	}
+	return Artifacts{Version: version}, nil
+}
+
+// BuildArtifacts downloads ICTT [version] and compiles it with forge, installing foundry if needed
+func BuildArtifacts(
+	app *application.Avalanche,
+	version string,
+) (Artifacts, error) {
+	if !FoundryIsInstalled() {
+		if err := InstallFoundry(); err != nil {
+			return Artifacts{}, err
+		}
+	}
+	ux.Logger.PrintToUser("Downloading Avalanche ICTT Contracts")
+	if err := DownloadRepo(app, version); err != nil {
+		return Artifacts{}, err
+	}
+	ux.Logger.PrintToUser("Compiling Avalanche ICTT Contracts")
+	if err := BuildContracts(app); err != nil {
+		return Artifacts{}, err
+	}
+	srcDir, err := RepoDir(app)
+	if err != nil {
+		return Artifacts{}, err
+	}
+	return Artifacts{Version: version, SrcDir: srcDir}, nil
+}
+
+// Bytecode returns the hex encoded creation bytecode of [contract]
+func (a Artifacts) Bytecode(contract Contract) ([]byte, error) {
+	if a.SrcDir != "" {
+		return os.ReadFile(forgeOutputPath(a.SrcDir, contract, bytecodeExtension))
+	}
+	return embeddedContracts.ReadFile(embeddedContractPath(a.Version, contract, bytecodeExtension))
+}
+
+// ABI returns the json encoded ABI of [contract]
+func (a Artifacts) ABI(contract Contract) ([]byte, error) {
+	if a.SrcDir != "" {
+		// forge output includes the abi together with other compilation outputs
+		bs, err := os.ReadFile(forgeOutputPath(a.SrcDir, contract, ".json"))
+		if err != nil {
+			return nil, err
+		}
+		var forgeOutput struct {
+			ABI json.RawMessage `json:"abi"`
+		}
+		if err := json.Unmarshal(bs, &forgeOutput); err != nil {
+			return nil, err
+		}
+		return forgeOutput.ABI, nil
+	}
+	return embeddedContracts.ReadFile(embeddedContractPath(a.Version, contract, abiExtension))
+}
+
+// takesMinTeleporterVersion indicates if the constructor of [contract] expects the minimum
+// ICM messenger version, either directly or as part of the remote settings. ICTT versions
+// previous to v1.0.0 do not expect it
+func (a Artifacts) takesMinTeleporterVersion(contract Contract) (bool, error) {
+	abiBytes, err := a.ABI(contract)
+	if err != nil {
+		return false, err
+	}
+	contractABI, err := abi.JSON(bytes.NewReader(abiBytes))
+	if err != nil {
+		return false, fmt.Errorf("invalid ABI for %s: %w", contract, err)
+	}
+	for _, input := range contractABI.Constructor.Inputs {
+		if input.Name == minTeleporterVersionParam || slices.Contains(input.Type.TupleRawNames, minTeleporterVersionParam) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func embeddedContractPath(version string, contract Contract, extension string) string {
+	return path.Join(embeddedContractsDir, version, string(contract)+extension)
+}
+
+func forgeOutputPath(srcDir string, contract Contract, extension string) string {
+	return filepath.Join(
+		utils.ExpandHome(srcDir),
+		"contracts",
+		"out",
+		string(contract)+".sol",
+		string(contract)+extension,
+	)
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package ictt
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddedArtifacts(t *testing.T) {
+	require := require.New(t)
+	require.True(HasEmbeddedVersion(constants.ICTTVersion), "default version %s is not embedded", constants.ICTTVersion)
+	require.Contains(EmbeddedVersions(), constants.ICTTVersion)
+	for _, version := range EmbeddedVersions() {
+		require.True(HasEmbeddedVersion(version), "version %s is missing contracts", version)
+		artifacts, err := GetEmbeddedArtifacts(version)
+		require.NoError(err)
+		for _, contract := range Contracts {
+			bytecode, err := artifacts.Bytecode(contract)
+			require.NoError(err)
+			require.NotEmpty(bytecode)
+			abi, err := artifacts.ABI(contract)
+			require.NoError(err)
+			require.NotEmpty(abi)
+			_, err = artifacts.takesMinTeleporterVersion(contract)
+			require.NoError(err)
+		}
+	}
+	_, err := GetEmbeddedArtifacts("unknown-version")
+	require.Error(err)
+}
+
+func TestBuiltArtifacts(t *testing.T) {
+	require := require.New(t)
+	srcDir := t.TempDir()
+	outDir := filepath.Join(srcDir, "contracts", "out", "ERC20TokenHome.sol")
+	require.NoError(os.MkdirAll(outDir, 0o755))
+	require.NoError(os.WriteFile(filepath.Join(outDir, "ERC20TokenHome.bin"), []byte("0x6080"), 0o600))
+	require.NoError(os.WriteFile(filepath.Join(outDir, "ERC20TokenHome.json"), []byte(`{"abi":[],"bytecode":{}}`), 0o600))
+	artifacts := Artifacts{SrcDir: srcDir}
+	bytecode, err := artifacts.Bytecode(ERC20TokenHomeContract)
+	require.NoError(err)
+	require.Equal("0x6080", string(bytecode))
+	abi, err := artifacts.ABI(ERC20TokenHomeContract)
+	require.NoError(err)
+	require.Equal("[]", string(abi))
+	_, err = artifacts.Bytecode(NativeTokenHomeContract)
+	require.Error(err)
+}
+
+func TestTakesMinTeleporterVersion(t *testing.T) {
+	require := require.New(t)
+	artifacts, err := GetEmbeddedArtifacts(constants.ICTTVersion)
+	require.NoError(err)
+	for _, contract := range []Contract{
+		ERC20TokenHomeContract,
+		NativeTokenHomeContract,
+		ERC20TokenRemoteContract,
+		NativeTokenRemoteContract,
+	} {
+		takesMinVersion, err := artifacts.takesMinTeleporterVersion(contract)
+		require.NoError(err)
+		require.True(takesMinVersion, contract)
+	}
+	takesMinVersion, err := artifacts.takesMinTeleporterVersion(WrappedNativeTokenContract)
+	require.NoError(err)
+	require.False(takesMinVersion)
+
+	// ICTT versions previous to v1.0.0
+	srcDir := t.TempDir()
+	outDir := filepath.Join(srcDir, "contracts", "out", "ERC20TokenHome.sol")
+	require.NoError(os.MkdirAll(outDir, 0o755))
+	require.NoError(os.WriteFile(
+		filepath.Join(outDir, "ERC20TokenHome.json"),
+		[]byte(`{"abi":[{"type":"constructor","inputs":[{"name":"teleporterRegistryAddress","type":"address"},{"name":"teleporterManager","type":"address"},{"name":"tokenAddress","type":"address"},{"name":"tokenDecimals","type":"uint8"}]}]}`),
+		0o600,
+	))
+	takesMinVersion, err = Artifacts{SrcDir: srcDir}.takesMinTeleporterVersion(ERC20TokenHomeContract)
+	require.NoError(err)
+	require.False(takesMinVersion)
+}
@@ -5,11 +5,8 @@ package ictt
 import (
 	_ "embed"
 	"math/big"
-	"os"
-	"path/filepath"
 
 	"github.com/ava-labs/avalanche-cli/pkg/contract"
-	"github.com/ava-labs/avalanche-cli/pkg/utils"
 	"github.com/ava-labs/avalanche-cli/pkg/ux"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -27,6 +24,44 @@ type TokenRemoteSettings struct {
 	TokenHomeDecimals     uint8
 }
 
+// remote settings as expected by ICTT v1.0.0 and later
+type tokenRemoteSettingsWithMinVersion struct {
+	ICMRegistryAddress    common.Address
+	ICMManager            common.Address
+	MinTeleporterVersion  *big.Int
+	TokenHomeBlockchainID [32]byte
+	TokenHomeAddress      common.Address
+	TokenHomeDecimals     uint8
+}
+
+// oldest ICM messenger version allowed to deliver messages to the deployed contracts.
+// the ICM registry deployed by the CLI starts at version 1
+var minTeleporterVersion = big.NewInt(1)
+
+// returns the spec and value of the remote [settings] constructor parameter, as expected by
+// [contract] on [artifacts]
+func getRemoteSettingsParam(
+	artifacts Artifacts,
+	contract Contract,
+	settings TokenRemoteSettings,
+) (string, interface{}, error) {
+	withMinVersion, err := artifacts.takesMinTeleporterVersion(contract)
+	if err != nil {
+		return "", nil, err
+	}
+	if !withMinVersion {
+		return "(address, address, bytes32, address, uint8)", settings, nil
+	}
+	return "(address, address, uint256, bytes32, address, uint8)", tokenRemoteSettingsWithMinVersion{
+		ICMRegistryAddress:    settings.ICMRegistryAddress,
+		ICMManager:            settings.ICMManager,
+		MinTeleporterVersion:  minTeleporterVersion,
+		TokenHomeBlockchainID: settings.TokenHomeBlockchainID,
+		TokenHomeAddress:      settings.TokenHomeAddress,
+		TokenHomeDecimals:     settings.TokenHomeDecimals,
+	}, nil
+}
+
 func RegisterRemote(
 	rpcURL string,
 	privateKey string,
@@ -52,7 +87,7 @@ func RegisterRemote(
 }
 
 func DeployERC20Remote(
-	srcDir string,
+	artifacts Artifacts,
 	rpcURL string,
 	privateKey string,
 	icmRegistryAddress common.Address,
@@ -64,23 +99,25 @@ func DeployERC20Remote(
 	tokenRemoteSymbol string,
 	tokenRemoteDecimals uint8,
 ) (common.Address, error) {
-	binPath := filepath.Join(srcDir, "contracts/out/ERC20TokenRemote.sol/ERC20TokenRemote.bin")
-	binBytes, err := os.ReadFile(binPath)
+	binBytes, err := artifacts.Bytecode(ERC20TokenRemoteContract)
 	if err != nil {
 		return common.Address{}, err
 	}
-	tokenRemoteSettings := TokenRemoteSettings{
+	settingsSpec, tokenRemoteSettings, err := getRemoteSettingsParam(artifacts, ERC20TokenRemoteContract, TokenRemoteSettings{
 		ICMRegistryAddress:    icmRegistryAddress,
 		ICMManager:            icmManagerAddress,
 		TokenHomeBlockchainID: tokenHomeBlockchainID,
 		TokenHomeAddress:      tokenHomeAddress,
 		TokenHomeDecimals:     tokenHomeDecimals,
+	})
+	if err != nil {
+		return common.Address{}, err
 	}
 	return contract.DeployContract(
 		rpcURL,
 		privateKey,
 		binBytes,
-		"((address, address, bytes32, address, uint8), string, string, uint8)",
+		"("+settingsSpec+", string, string, uint8)",
 		tokenRemoteSettings,
 		tokenRemoteName,
 		tokenRemoteSymbol,
@@ -89,7 +126,7 @@ func DeployERC20Remote(
 }
 
 func DeployNativeRemote(
-	srcDir string,
+	artifacts Artifacts,
 	rpcURL string,
 	privateKey string,
 	icmRegistryAddress common.Address,
@@ -101,23 +138,25 @@ func DeployNativeRemote(
 	initialReserveImbalance *big.Int,
 	burnedFeesReportingRewardPercentage *big.Int,
 ) (common.Address, error) {
-	binPath := filepath.Join(srcDir, "contracts/out/NativeTokenRemote.sol/NativeTokenRemote.bin")
-	binBytes, err := os.ReadFile(binPath)
+	binBytes, err := artifacts.Bytecode(NativeTokenRemoteContract)
 	if err != nil {
 		return common.Address{}, err
 	}
-	tokenRemoteSettings := TokenRemoteSettings{
+	settingsSpec, tokenRemoteSettings, err := getRemoteSettingsParam(artifacts, NativeTokenRemoteContract, TokenRemoteSettings{
 		ICMRegistryAddress:    icmRegistryAddress,
 		ICMManager:            icmManagerAddress,
 		TokenHomeBlockchainID: tokenHomeBlockchainID,
 		TokenHomeAddress:      tokenHomeAddress,
 		TokenHomeDecimals:     tokenHomeDecimals,
+	})
+	if err != nil {
+		return common.Address{}, err
 	}
 	return contract.DeployContract(
 		rpcURL,
 		privateKey,
 		binBytes,
-		"((address, address, bytes32, address, uint8), string, uint256, uint256)",
+		"("+settingsSpec+", string, uint256, uint256)",
 		tokenRemoteSettings,
 		nativeAssetSymbol,
 		initialReserveImbalance,
@@ -126,7 +165,7 @@ func DeployNativeRemote(
 }
 
 func DeployERC20Home(
-	srcDir string,
+	artifacts Artifacts,
 	rpcURL string,
 	privateKey string,
 	icmRegistryAddress common.Address,
@@ -134,11 +173,27 @@ func DeployERC20Home(
 	erc20TokenAddress common.Address,
 	erc20TokenDecimals uint8,
 ) (common.Address, error) {
-	binPath := filepath.Join(srcDir, "contracts/out/ERC20TokenHome.sol/ERC20TokenHome.bin")
-	binBytes, err := os.ReadFile(binPath)
+	binBytes, err := artifacts.Bytecode(ERC20TokenHomeContract)
+	if err != nil {
+		return common.Address{}, err
+	}
+	withMinVersion, err := artifacts.takesMinTeleporterVersion(ERC20TokenHomeContract)
 	if err != nil {
 		return common.Address{}, err
 	}
+	if withMinVersion {
+		return contract.DeployContract(
+			rpcURL,
+			privateKey,
+			binBytes,
+			"(address, address, uint256, address, uint8)",
+			icmRegistryAddress,
+			icmManagerAddress,
+			minTeleporterVersion,
+			erc20TokenAddress,
+			erc20TokenDecimals,
+		)
+	}
 	return contract.DeployContract(
 		rpcURL,
 		privateKey,
@@ -152,18 +207,33 @@ func DeployERC20Home(
 }
 
 func DeployNativeHome(
-	srcDir string,
+	artifacts Artifacts,
 	rpcURL string,
 	privateKey string,
 	icmRegistryAddress common.Address,
 	icmManagerAddress common.Address,
 	wrappedNativeTokenAddress common.Address,
 ) (common.Address, error) {
-	binPath := filepath.Join(srcDir, "contracts/out/NativeTokenHome.sol/NativeTokenHome.bin")
-	binBytes, err := os.ReadFile(binPath)
+	binBytes, err := artifacts.Bytecode(NativeTokenHomeContract)
+	if err != nil {
+		return common.Address{}, err
+	}
+	withMinVersion, err := artifacts.takesMinTeleporterVersion(NativeTokenHomeContract)
 	if err != nil {
 		return common.Address{}, err
 	}
+	if withMinVersion {
+		return contract.DeployContract(
+			rpcURL,
+			privateKey,
+			binBytes,
+			"(address, address, uint256, address)",
+			icmRegistryAddress,
+			icmManagerAddress,
+			minTeleporterVersion,
+			wrappedNativeTokenAddress,
+		)
+	}
 	return contract.DeployContract(
 		rpcURL,
 		privateKey,
@@ -176,13 +246,12 @@ func DeployNativeHome(
 }
 
 func DeployWrappedNativeToken(
-	srcDir string,
+	artifacts Artifacts,
 	rpcURL string,
 	privateKey string,
 	tokenSymbol string,
 ) (common.Address, error) {
-	binPath := filepath.Join(utils.ExpandHome(srcDir), "contracts/out/WrappedNativeToken.sol/WrappedNativeToken.bin")
-	binBytes, err := os.ReadFile(binPath)
+	binBytes, err := artifacts.Bytecode(WrappedNativeTokenContract)
 	if err != nil {
 		return common.Address{}, err
 	}
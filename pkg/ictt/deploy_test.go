@@ -0,0 +1,151 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package ictt
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/internal/testutils"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	"github.com/ava-labs/avalanchego/ids"
+	teleporterregistry "github.com/ava-labs/icm-contracts/abi-bindings/go/teleporter/registry/TeleporterRegistry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// deploys an ICM registry on [simEVM], with [messengerAddress] as ICM version 1
+func deployTestRegistry(
+	t *testing.T,
+	simEVM *testutils.SimulatedEVM,
+	messengerAddress common.Address,
+) common.Address {
+	type ProtocolRegistryEntry struct {
+		Version         *big.Int
+		ProtocolAddress common.Address
+	}
+	registryAddress, err := contract.DeployContract(
+		simEVM.RPCURL,
+		simEVM.PrivateKey,
+		[]byte(teleporterregistry.TeleporterRegistryMetaData.Bin),
+		"([(uint256, address)])",
+		[]ProtocolRegistryEntry{
+			{
+				Version:         big.NewInt(1),
+				ProtocolAddress: messengerAddress,
+			},
+		},
+	)
+	require.NoError(t, err)
+	return registryAddress
+}
+
+func TestDeployEmbeddedArtifacts(t *testing.T) {
+	require := testutils.SetupTest(t)
+	artifacts, err := GetEmbeddedArtifacts(constants.ICTTVersion)
+	require.NoError(err)
+	simEVM := testutils.NewSimulatedEVM(t, nil)
+	registryAddress := deployTestRegistry(t, simEVM, common.HexToAddress("0x253b2784c75e510dD0fF1da844684a1aC0aa5fcf"))
+
+	wrappedNativeTokenAddress, err := DeployWrappedNativeToken(artifacts, simEVM.RPCURL, simEVM.PrivateKey, "TEST")
+	require.NoError(err)
+	nativeHomeAddress, err := DeployNativeHome(
+		artifacts,
+		simEVM.RPCURL,
+		simEVM.PrivateKey,
+		registryAddress,
+		simEVM.Address,
+		wrappedNativeTokenAddress,
+	)
+	require.NoError(err)
+	kind, err := GetEndpointKind(simEVM.RPCURL, nativeHomeAddress)
+	require.NoError(err)
+	require.Equal(NativeTokenHome, kind)
+	tokenAddress, err := NativeTokenHomeGetTokenAddress(simEVM.RPCURL, nativeHomeAddress)
+	require.NoError(err)
+	require.Equal(wrappedNativeTokenAddress, tokenAddress)
+
+	erc20HomeAddress, err := DeployERC20Home(
+		artifacts,
+		simEVM.RPCURL,
+		simEVM.PrivateKey,
+		registryAddress,
+		simEVM.Address,
+		wrappedNativeTokenAddress,
+		18,
+	)
+	require.NoError(err)
+	kind, err = GetEndpointKind(simEVM.RPCURL, erc20HomeAddress)
+	require.NoError(err)
+	require.Equal(ERC20TokenHome, kind)
+	tokenAddress, err = ERC20TokenHomeGetTokenAddress(simEVM.RPCURL, erc20HomeAddress)
+	require.NoError(err)
+	require.Equal(wrappedNativeTokenAddress, tokenAddress)
+	decimals, err := TokenHomeGetDecimals(simEVM.RPCURL, erc20HomeAddress)
+	require.NoError(err)
+	require.Equal(uint8(18), decimals)
+
+	// remotes can't be on the same blockchain as their home
+	homeBlockchainID := ids.GenerateTestID()
+	erc20RemoteAddress, err := DeployERC20Remote(
+		artifacts,
+		simEVM.RPCURL,
+		simEVM.PrivateKey,
+		registryAddress,
+		simEVM.Address,
+		homeBlockchainID,
+		erc20HomeAddress,
+		18,
+		"Test Token",
+		"TEST",
+		18,
+	)
+	require.NoError(err)
+	kind, err = GetEndpointKind(simEVM.RPCURL, erc20RemoteAddress)
+	require.NoError(err)
+	require.Equal(ERC20TokenRemote, kind)
+	blockchainID, err := TokenRemoteGetTokenHomeBlockchainID(simEVM.RPCURL, erc20RemoteAddress)
+	require.NoError(err)
+	require.Equal(homeBlockchainID, blockchainID)
+	homeAddress, err := ERC20TokenRemoteGetTokenHomeAddress(simEVM.RPCURL, erc20RemoteAddress)
+	require.NoError(err)
+	require.Equal(erc20HomeAddress, homeAddress)
+	collateralized, err := TokenRemoteIsCollateralized(simEVM.RPCURL, erc20RemoteAddress)
+	require.NoError(err)
+	require.True(collateralized)
+	// the remote is registered on the home by an ICM message, that is not delivered here
+	registeredRemote, err := TokenHomeGetRegisteredRemote(simEVM.RPCURL, erc20HomeAddress, homeBlockchainID, erc20RemoteAddress)
+	require.NoError(err)
+	require.False(registeredRemote.Registered)
+	transferredBalance, err := TokenHomeGetTransferredBalance(simEVM.RPCURL, erc20HomeAddress, homeBlockchainID, erc20RemoteAddress)
+	require.NoError(err)
+	require.Zero(transferredBalance.Sign())
+
+	nativeRemoteAddress, err := DeployNativeRemote(
+		artifacts,
+		simEVM.RPCURL,
+		simEVM.PrivateKey,
+		registryAddress,
+		simEVM.Address,
+		homeBlockchainID,
+		nativeHomeAddress,
+		18,
+		"TEST",
+		big.NewInt(1e18),
+		big.NewInt(1),
+	)
+	require.NoError(err)
+	kind, err = GetEndpointKind(simEVM.RPCURL, nativeRemoteAddress)
+	require.NoError(err)
+	require.Equal(NativeTokenRemote, kind)
+	blockchainID, err = TokenRemoteGetTokenHomeBlockchainID(simEVM.RPCURL, nativeRemoteAddress)
+	require.NoError(err)
+	require.Equal(homeBlockchainID, blockchainID)
+	initialReserveImbalance, err := NativeTokenRemoteGetInitialReserveImbalance(simEVM.RPCURL, nativeRemoteAddress)
+	require.NoError(err)
+	require.Equal(big.NewInt(1e18), initialReserveImbalance)
+	collateralized, err = TokenRemoteIsCollateralized(simEVM.RPCURL, nativeRemoteAddress)
+	require.NoError(err)
+	require.False(collateralized)
+}
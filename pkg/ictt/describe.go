@@ -0,0 +1,229 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package ictt
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanche-cli/sdk/evm"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/interfaces"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// token home event emitted when a remote registers itself
+const remoteRegisteredEventSignature = "RemoteRegistered(bytes32,address,uint256,uint8)"
+
+var remoteRegisteredEventID = crypto.Keccak256Hash([]byte(remoteRegisteredEventSignature))
+
+// RemoteRegistration is the information emitted by a token home when a remote registers
+type RemoteRegistration struct {
+	BlockchainID            ids.ID
+	Address                 common.Address
+	InitialCollateralNeeded *big.Int
+	TokenDecimals           uint8
+}
+
+// RemoteDescription is the state of a remote registered on a token home, as seen
+// both from the home and, if reachable, from the remote
+type RemoteDescription struct {
+	RemoteRegistration
+	Home RegisteredRemote
+	// amount transferred from home into the remote, in home token units
+	TransferredBalance *big.Int
+	// remote side information, only set if RemoteRPCEndpoint is known
+	RemoteRPCEndpoint string
+	Kind              EndpointKind
+	IsCollateralized  bool
+	// amount of bridged tokens in circulation on the remote, in remote token units
+	OutstandingSupply *big.Int
+	// inconsistencies found between home and remote
+	Issues []string
+}
+
+// TokenHomeDescription is the state of a token home and all its registered remotes
+type TokenHomeDescription struct {
+	Address       common.Address
+	Kind          EndpointKind
+	TokenDecimals uint8
+	Remotes       []RemoteDescription
+	// per remote errors found while querying the chains
+	Errors []string
+}
+
+// TokenHomeGetRemoteRegistrations returns all remotes registered on token home [address],
+// as found on the events emitted starting at [fromBlock]
+func TokenHomeGetRemoteRegistrations(
+	rpcURL string,
+	address common.Address,
+	fromBlock uint64,
+) ([]RemoteRegistration, error) {
+	client, err := evm.GetClient(rpcURL)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+	logs, err := client.FilterLogs(interfaces.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		Addresses: []common.Address{address},
+		Topics:    [][]common.Hash{{remoteRegisteredEventID}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	registrations := []RemoteRegistration{}
+	for _, log := range logs {
+		registration, err := parseRemoteRegisteredLog(log)
+		if err != nil {
+			return nil, err
+		}
+		registrations = append(registrations, registration)
+	}
+	return registrations, nil
+}
+
+func parseRemoteRegisteredLog(log types.Log) (RemoteRegistration, error) {
+	if len(log.Topics) != 3 || len(log.Data) != 64 {
+		return RemoteRegistration{}, fmt.Errorf("unexpected RemoteRegistered event format at tx %s", log.TxHash)
+	}
+	return RemoteRegistration{
+		BlockchainID:            ids.ID(log.Topics[1]),
+		Address:                 common.BytesToAddress(log.Topics[2].Bytes()),
+		InitialCollateralNeeded: new(big.Int).SetBytes(log.Data[:32]),
+		TokenDecimals:           log.Data[63],
+	}, nil
+}
+
+// ScaleHomeAmountToRemote converts [amount] in home token units into remote token units,
+// given the remote settings registered at home
+func ScaleHomeAmountToRemote(amount *big.Int, remote RegisteredRemote) *big.Int {
+	if remote.TokenMultiplier == nil || remote.TokenMultiplier.Sign() == 0 {
+		return new(big.Int).Set(amount)
+	}
+	if remote.MultiplyOnRemote {
+		return new(big.Int).Mul(amount, remote.TokenMultiplier)
+	}
+	return new(big.Int).Quo(amount, remote.TokenMultiplier)
+}
+
+// DescribeTokenHome returns the state of token home [homeAddress] at [homeRPCURL] and of all its
+// registered remotes, flagging undercollateralized remotes and mismatches between the amount
+// locked at home and the supply minted on the remote. Remotes are only inspected on their side
+// if their blockchain ID is found on [remoteRPCEndpoints]. Registrations are searched for
+// starting at [fromBlock]
+func DescribeTokenHome(
+	homeRPCURL string,
+	homeAddress common.Address,
+	remoteRPCEndpoints map[ids.ID]string,
+	fromBlock uint64,
+) (TokenHomeDescription, error) {
+	description := TokenHomeDescription{
+		Address: homeAddress,
+	}
+	var err error
+	description.Kind, err = GetEndpointKind(homeRPCURL, homeAddress)
+	if err != nil {
+		return description, err
+	}
+	if description.Kind != ERC20TokenHome && description.Kind != NativeTokenHome {
+		return description, fmt.Errorf("%s is not a token home", homeAddress)
+	}
+	description.TokenDecimals, err = TokenHomeGetDecimals(homeRPCURL, homeAddress)
+	if err != nil {
+		return description, err
+	}
+	registrations, err := TokenHomeGetRemoteRegistrations(homeRPCURL, homeAddress, fromBlock)
+	if err != nil {
+		return description, err
+	}
+	for _, registration := range registrations {
+		remote := RemoteDescription{
+			RemoteRegistration: registration,
+		}
+		remote.Home, err = TokenHomeGetRegisteredRemote(homeRPCURL, homeAddress, registration.BlockchainID, registration.Address)
+		if err != nil {
+			description.Errors = append(description.Errors, fmt.Sprintf("remote %s: %s", registration.Address, err))
+			continue
+		}
+		remote.TransferredBalance, err = TokenHomeGetTransferredBalance(homeRPCURL, homeAddress, registration.BlockchainID, registration.Address)
+		if err != nil {
+			description.Errors = append(description.Errors, fmt.Sprintf("remote %s: %s", registration.Address, err))
+			continue
+		}
+		if remote.Home.CollateralNeeded.Sign() > 0 {
+			remote.Issues = append(remote.Issues, fmt.Sprintf("undercollateralized: %s home token units still needed", remote.Home.CollateralNeeded))
+		}
+		remote.RemoteRPCEndpoint = remoteRPCEndpoints[registration.BlockchainID]
+		if remote.RemoteRPCEndpoint != "" {
+			if err := describeRemoteSide(&remote); err != nil {
+				description.Errors = append(description.Errors, fmt.Sprintf("remote %s: %s", registration.Address, err))
+			}
+		}
+		description.Remotes = append(description.Remotes, remote)
+	}
+	return description, nil
+}
+
+// fills the remote side information of [remote] and checks it against the home side
+func describeRemoteSide(remote *RemoteDescription) error {
+	var err error
+	remote.Kind, err = GetEndpointKind(remote.RemoteRPCEndpoint, remote.Address)
+	if err != nil {
+		return err
+	}
+	remote.IsCollateralized, err = TokenRemoteIsCollateralized(remote.RemoteRPCEndpoint, remote.Address)
+	if err != nil {
+		return err
+	}
+	checkSupply := true
+	switch remote.Kind {
+	case ERC20TokenRemote:
+		remote.OutstandingSupply, err = ERC20TokenRemoteGetTotalSupply(remote.RemoteRPCEndpoint, remote.Address)
+		if err != nil {
+			return err
+		}
+	case NativeTokenRemote:
+		totalSupply, err := NativeTokenRemoteGetTotalNativeAssetSupply(remote.RemoteRPCEndpoint, remote.Address)
+		if err != nil {
+			return err
+		}
+		initialReserveImbalance, err := NativeTokenRemoteGetInitialReserveImbalance(remote.RemoteRPCEndpoint, remote.Address)
+		if err != nil {
+			return err
+		}
+		// only the supply minted by the remote is backed by the home
+		remote.OutstandingSupply = new(big.Int).Sub(totalSupply, initialReserveImbalance)
+		// burned tx fees are deducted from the home balance only once reported
+		unreportedBurnedTxFees, err := NativeTokenRemoteGetUnreportedBurnedTxFees(remote.RemoteRPCEndpoint, remote.Address)
+		if err != nil {
+			// legacy remotes do not expose their last report
+			checkSupply = false
+		} else {
+			remote.OutstandingSupply.Add(remote.OutstandingSupply, unreportedBurnedTxFees)
+		}
+	default:
+		return fmt.Errorf("%s is not a token remote", remote.Address)
+	}
+	if remote.Home.CollateralNeeded.Sign() == 0 && !remote.IsCollateralized {
+		remote.Issues = append(remote.Issues, "home considers the remote collateralized but the remote does not")
+	}
+	if remote.Home.CollateralNeeded.Sign() > 0 && remote.IsCollateralized {
+		remote.Issues = append(remote.Issues, "remote considers itself collateralized but the home still needs collateral")
+	}
+	if !checkSupply {
+		return nil
+	}
+	locked := ScaleHomeAmountToRemote(remote.TransferredBalance, remote.Home)
+	if locked.Cmp(remote.OutstandingSupply) != 0 {
+		remote.Issues = append(remote.Issues, fmt.Sprintf(
+			"locked amount on home (%s remote units) differs from remote outstanding supply (%s)",
+			locked,
+			remote.OutstandingSupply,
+		))
+	}
+	return nil
+}
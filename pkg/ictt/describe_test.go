@@ -0,0 +1,129 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package ictt
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/internal/testutils"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	"github.com/ava-labs/avalanchego/ids"
+	subnetEVMConstants "github.com/ava-labs/subnet-evm/constants"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRemoteRegisteredLog(t *testing.T) {
+	require := require.New(t)
+	blockchainID := ids.GenerateTestID()
+	remoteAddress := common.HexToAddress("0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC")
+	data := make([]byte, 64)
+	big.NewInt(1000).FillBytes(data[:32])
+	data[63] = 6
+	registration, err := parseRemoteRegisteredLog(types.Log{
+		Topics: []common.Hash{
+			remoteRegisteredEventID,
+			common.Hash(blockchainID),
+			common.BytesToHash(remoteAddress.Bytes()),
+		},
+		Data: data,
+	})
+	require.NoError(err)
+	require.Equal(blockchainID, registration.BlockchainID)
+	require.Equal(remoteAddress, registration.Address)
+	require.Equal(big.NewInt(1000), registration.InitialCollateralNeeded)
+	require.Equal(uint8(6), registration.TokenDecimals)
+	_, err = parseRemoteRegisteredLog(types.Log{Topics: []common.Hash{remoteRegisteredEventID}})
+	require.Error(err)
+}
+
+func TestScaleHomeAmountToRemote(t *testing.T) {
+	require := require.New(t)
+	amount := big.NewInt(5_000_000)
+	multiplier := big.NewInt(1_000)
+	require.Equal(big.NewInt(5_000_000_000), ScaleHomeAmountToRemote(amount, RegisteredRemote{TokenMultiplier: multiplier, MultiplyOnRemote: true}))
+	require.Equal(big.NewInt(5_000), ScaleHomeAmountToRemote(amount, RegisteredRemote{TokenMultiplier: multiplier}))
+	require.Equal(amount, ScaleHomeAmountToRemote(amount, RegisteredRemote{TokenMultiplier: big.NewInt(1)}))
+}
+
+func TestDescribeNativeRemoteSide(t *testing.T) {
+	require := testutils.SetupTest(t)
+	deployerPrivateKey, err := crypto.GenerateKey()
+	require.NoError(err)
+	chain := newTestChain(t, common.Bytes2Hex(crypto.FromECDSA(deployerPrivateKey)))
+	artifacts, err := GetEmbeddedArtifacts(constants.ICTTVersion)
+	require.NoError(err)
+	initialReserveImbalance := new(big.Int).Mul(big.NewInt(1_000), big.NewInt(1e18))
+	remoteAddress, err := DeployNativeRemote(
+		artifacts,
+		chain.sim.RPCURL,
+		chain.sim.PrivateKey,
+		chain.registry,
+		chain.sim.Address,
+		ids.GenerateTestID(),
+		common.HexToAddress("0x253b2784c75e510dD0fF1da844684a1aC0aa5fcf"),
+		18,
+		"TEST",
+		initialReserveImbalance,
+		big.NewInt(0),
+	)
+	require.NoError(err)
+	ctx := context.Background()
+	client := chain.sim.Backend.Client()
+
+	// fees burned by the deployments are subtracted from the native asset supply, but not
+	// from the home balance, as they were not reported yet
+	burnedTxFees, err := client.BalanceAt(ctx, subnetEVMConstants.BlackholeAddr, nil)
+	require.NoError(err)
+	require.Positive(burnedTxFees.Sign())
+	totalSupply, err := NativeTokenRemoteGetTotalNativeAssetSupply(chain.sim.RPCURL, remoteAddress)
+	require.NoError(err)
+	require.Equal(new(big.Int).Sub(initialReserveImbalance, burnedTxFees), totalSupply)
+	unreportedBurnedTxFees, err := NativeTokenRemoteGetUnreportedBurnedTxFees(chain.sim.RPCURL, remoteAddress)
+	require.NoError(err)
+	require.Equal(burnedTxFees, unreportedBurnedTxFees)
+	remote := RemoteDescription{
+		RemoteRegistration: RemoteRegistration{
+			Address: remoteAddress,
+		},
+		Home: RegisteredRemote{
+			Registered:       true,
+			CollateralNeeded: initialReserveImbalance,
+			TokenMultiplier:  big.NewInt(1),
+		},
+		TransferredBalance: big.NewInt(0),
+		RemoteRPCEndpoint:  chain.sim.RPCURL,
+	}
+	require.NoError(describeRemoteSide(&remote))
+	require.Equal(NativeTokenRemote, remote.Kind)
+	require.False(remote.IsCollateralized)
+	require.Zero(remote.OutstandingSupply.Sign())
+	require.Empty(remote.Issues)
+
+	// once reported, only the fees burned afterwards are pending
+	_, receipt, err := contract.TxToMethod(
+		chain.sim.RPCURL,
+		false,
+		common.Address{},
+		chain.sim.PrivateKey,
+		remoteAddress,
+		nil,
+		"report burned tx fees",
+		nil,
+		"reportBurnedTxFees(uint256)",
+		big.NewInt(100_000),
+	)
+	require.NoError(err)
+	reportedBurnedTxFees, err := client.BalanceAt(ctx, subnetEVMConstants.BlackholeAddr, new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1)))
+	require.NoError(err)
+	burnedTxFees, err = client.BalanceAt(ctx, subnetEVMConstants.BlackholeAddr, nil)
+	require.NoError(err)
+	unreportedBurnedTxFees, err = NativeTokenRemoteGetUnreportedBurnedTxFees(chain.sim.RPCURL, remoteAddress)
+	require.NoError(err)
+	require.Equal(new(big.Int).Sub(burnedTxFees, reportedBurnedTxFees), unreportedBurnedTxFees)
+}
@@ -4,12 +4,17 @@ package ictt
 
 import (
 	_ "embed"
+	"errors"
 	"fmt"
 	"math/big"
 
 	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
 	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/sdk/evm"
 	"github.com/ava-labs/avalanchego/ids"
+	subnetEVMConstants "github.com/ava-labs/subnet-evm/constants"
+	"github.com/ava-labs/subnet-evm/core/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -27,10 +32,11 @@ func GetEndpointKind(
 	rpcURL string,
 	address common.Address,
 ) (EndpointKind, error) {
-	if _, err := ERC20TokenHomeGetTokenAddress(rpcURL, address); err == nil {
+	// since ICTT v1.0.0, both homes share the token getter, and are identified by their storage
+	if _, err := callGetter(rpcURL, address, "ERC20_TOKEN_HOME_STORAGE_LOCATION()->(bytes32)", "token()->(address)"); err == nil {
 		return ERC20TokenHome, nil
 	}
-	if _, err := NativeTokenHomeGetTokenAddress(rpcURL, address); err == nil {
+	if _, err := callGetter(rpcURL, address, "NATIVE_TOKEN_HOME_STORAGE_LOCATION()->(bytes32)", "wrappedToken()->(address)"); err == nil {
 		return NativeTokenHome, nil
 	}
 	if _, err := NativeTokenRemoteGetTotalNativeAssetSupply(rpcURL, address); err == nil {
@@ -43,13 +49,41 @@ func GetEndpointKind(
 	}
 }
 
+// calls the first of [methodSpecs] implemented by the ICTT contract at [address]. ICTT v1.0.0
+// renamed the contract getters, so the current method is expected first, and the legacy one next
+func callGetter(
+	rpcURL string,
+	address common.Address,
+	methodSpecs ...string,
+) ([]interface{}, error) {
+	return callGetterWithParams(rpcURL, address, methodSpecs, nil)
+}
+
+func callGetterWithParams(
+	rpcURL string,
+	address common.Address,
+	methodSpecs []string,
+	params []interface{},
+) ([]interface{}, error) {
+	var errs error
+	for _, methodSpec := range methodSpecs {
+		out, err := contract.CallToMethod(rpcURL, address, methodSpec, params...)
+		if err == nil {
+			return out, nil
+		}
+		errs = errors.Join(errs, err)
+	}
+	return nil, errs
+}
+
 func ERC20TokenHomeGetTokenAddress(
 	rpcURL string,
 	address common.Address,
 ) (common.Address, error) {
-	out, err := contract.CallToMethod(
+	out, err := callGetter(
 		rpcURL,
 		address,
+		"getTokenAddress()->(address)",
 		"token()->(address)",
 	)
 	if err != nil {
@@ -62,9 +96,10 @@ func NativeTokenHomeGetTokenAddress(
 	rpcURL string,
 	address common.Address,
 ) (common.Address, error) {
-	out, err := contract.CallToMethod(
+	out, err := callGetter(
 		rpcURL,
 		address,
+		"getTokenAddress()->(address)",
 		"wrappedToken()->(address)",
 	)
 	if err != nil {
@@ -77,9 +112,10 @@ func TokenRemoteIsCollateralized(
 	rpcURL string,
 	address common.Address,
 ) (bool, error) {
-	out, err := contract.CallToMethod(
+	out, err := callGetter(
 		rpcURL,
 		address,
+		"getIsCollateralized()->(bool)",
 		"isCollateralized()->(bool)",
 	)
 	if err != nil {
@@ -98,7 +134,16 @@ func TokenHomeGetDecimals(
 		"tokenDecimals()->(uint8)",
 	)
 	if err != nil {
-		return 0, err
+		// since ICTT v1.0.0 the home decimals are not exposed, being the ones of the home token
+		out, tokenErr := callGetter(rpcURL, address, "getTokenAddress()->(address)")
+		if tokenErr != nil {
+			return 0, errors.Join(err, tokenErr)
+		}
+		tokenAddress, tokenErr := contract.GetSmartContractCallResult[common.Address]("getTokenAddress", out)
+		if tokenErr != nil {
+			return 0, tokenErr
+		}
+		return GetTokenDecimals(rpcURL, tokenAddress)
 	}
 	return contract.GetSmartContractCallResult[uint8]("tokenDecimals", out)
 }
@@ -116,12 +161,15 @@ func TokenHomeGetRegisteredRemote(
 	remoteBlockchainID [32]byte,
 	remoteAddress common.Address,
 ) (RegisteredRemote, error) {
-	out, err := contract.CallToMethod(
+	// the v1.0.0 getter returns a static struct, encoded as the legacy getter multiple outputs
+	out, err := callGetterWithParams(
 		rpcURL,
 		address,
-		"registeredRemotes(bytes32, address)->(bool,uint256,uint256,bool)",
-		remoteBlockchainID,
-		remoteAddress,
+		[]string{
+			"getRemoteTokenTransferrerSettings(bytes32, address)->(bool,uint256,uint256,bool)",
+			"registeredRemotes(bytes32, address)->(bool,uint256,uint256,bool)",
+		},
+		[]interface{}{remoteBlockchainID, remoteAddress},
 	)
 	if err != nil {
 		return RegisteredRemote{}, err
@@ -156,9 +204,10 @@ func ERC20TokenRemoteGetTokenHomeAddress(
 	rpcURL string,
 	address common.Address,
 ) (common.Address, error) {
-	out, err := contract.CallToMethod(
+	out, err := callGetter(
 		rpcURL,
 		address,
+		"getTokenHomeAddress()->(address)",
 		"tokenHomeAddress()->(address)",
 	)
 	if err != nil {
@@ -167,6 +216,22 @@ func ERC20TokenRemoteGetTokenHomeAddress(
 	return contract.GetSmartContractCallResult[common.Address]("tokenHomeAddress", out)
 }
 
+func TokenRemoteGetTokenHomeBlockchainID(
+	rpcURL string,
+	address common.Address,
+) (ids.ID, error) {
+	out, err := callGetter(
+		rpcURL,
+		address,
+		"getTokenHomeBlockchainID()->(bytes32)",
+		"tokenHomeBlockchainID()->(bytes32)",
+	)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return contract.GetSmartContractCallResult[[32]byte]("tokenHomeBlockchainID", out)
+}
+
 func NativeTokenRemoteGetTotalNativeAssetSupply(
 	rpcURL string,
 	address common.Address,
@@ -182,6 +247,114 @@ func NativeTokenRemoteGetTotalNativeAssetSupply(
 	return contract.GetSmartContractCallResult[*big.Int]("totalNativeAssetSupply", out)
 }
 
+func TokenHomeGetTransferredBalance(
+	rpcURL string,
+	address common.Address,
+	remoteBlockchainID [32]byte,
+	remoteAddress common.Address,
+) (*big.Int, error) {
+	out, err := callGetterWithParams(
+		rpcURL,
+		address,
+		[]string{
+			"getTransferredBalance(bytes32, address)->(uint256)",
+			"transferredBalances(bytes32, address)->(uint256)",
+		},
+		[]interface{}{remoteBlockchainID, remoteAddress},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return contract.GetSmartContractCallResult[*big.Int]("transferredBalances", out)
+}
+
+func ERC20TokenRemoteGetTotalSupply(
+	rpcURL string,
+	address common.Address,
+) (*big.Int, error) {
+	out, err := contract.CallToMethod(
+		rpcURL,
+		address,
+		"totalSupply()->(uint256)",
+	)
+	if err != nil {
+		return nil, err
+	}
+	return contract.GetSmartContractCallResult[*big.Int]("totalSupply", out)
+}
+
+func NativeTokenRemoteGetInitialReserveImbalance(
+	rpcURL string,
+	address common.Address,
+) (*big.Int, error) {
+	out, err := callGetter(
+		rpcURL,
+		address,
+		"getInitialReserveImbalance()->(uint256)",
+		"initialReserveImbalance()->(uint256)",
+	)
+	if err != nil {
+		return nil, err
+	}
+	return contract.GetSmartContractCallResult[*big.Int]("initialReserveImbalance", out)
+}
+
+// NativeTokenRemoteGetUnreportedBurnedTxFees returns the transaction fees burned on the blockchain of
+// native remote [address] since they were last reported to the home. Those are already subtracted from
+// the remote native asset supply, but not yet from the balance transferred by the home. Only available
+// since ICTT v1.0.0, where the last report is kept on the remote ERC-7201 storage
+func NativeTokenRemoteGetUnreportedBurnedTxFees(
+	rpcURL string,
+	address common.Address,
+) (*big.Int, error) {
+	out, err := contract.CallToMethod(
+		rpcURL,
+		address,
+		"NATIVE_TOKEN_REMOTE_STORAGE_LOCATION()->(bytes32)",
+	)
+	if err != nil {
+		return nil, err
+	}
+	storageLocation, err := contract.GetSmartContractCallResult[[32]byte]("NATIVE_TOKEN_REMOTE_STORAGE_LOCATION", out)
+	if err != nil {
+		return nil, err
+	}
+	client, err := evm.GetClient(rpcURL)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+	// _lastestBurnedFeesReported is the third field of NativeTokenRemoteStorage
+	slot := new(big.Int).Add(new(big.Int).SetBytes(storageLocation[:]), big.NewInt(2))
+	ctx, cancel := utils.GetAPIContext()
+	defer cancel()
+	latestBurnedFeesReported, err := client.EthClient.StorageAt(ctx, address, common.BigToHash(slot), nil)
+	if err != nil {
+		return nil, err
+	}
+	burnedTxFees, err := client.GetAddressBalance(subnetEVMConstants.BlackholeAddr.Hex())
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).Sub(burnedTxFees, new(big.Int).SetBytes(latestBurnedFeesReported)), nil
+}
+
+// MultiHopSettings are the parameters of the second leg of a remote to remote
+// transfer, that is routed through the token home. Zero value means a single hop transfer
+type MultiHopSettings struct {
+	// receives the tokens on the home chain if the second leg can't be delivered
+	Fallback common.Address
+	// ICM fee for the second leg, in token units
+	SecondaryFee *big.Int
+}
+
+func (m MultiHopSettings) secondaryFee() *big.Int {
+	if m.SecondaryFee == nil {
+		return big.NewInt(0)
+	}
+	return m.SecondaryFee
+}
+
 func ERC20TokenHomeSend(
 	rpcURL string,
 	homeAddress common.Address,
@@ -190,7 +363,7 @@ func ERC20TokenHomeSend(
 	destinationICTTEndpoint common.Address,
 	amountRecipient common.Address,
 	amount *big.Int,
-) error {
+) (*types.Receipt, error) {
 	type Params struct {
 		DestinationBlockchainID [32]byte
 		DestinationICTTEndpoint common.Address
@@ -203,7 +376,7 @@ func ERC20TokenHomeSend(
 	}
 	tokenAddress, err := ERC20TokenHomeGetTokenAddress(rpcURL, homeAddress)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if _, _, err := contract.TxToMethod(
 		rpcURL,
@@ -218,7 +391,7 @@ func ERC20TokenHomeSend(
 		homeAddress,
 		amount,
 	); err != nil {
-		return err
+		return nil, err
 	}
 	params := Params{
 		DestinationBlockchainID: destinationBlockchainID,
@@ -230,7 +403,7 @@ func ERC20TokenHomeSend(
 		RequiredGasLimit:        big.NewInt(250000),
 		MultiHopFallback:        common.Address{},
 	}
-	_, _, err = contract.TxToMethod(
+	_, receipt, err := contract.TxToMethod(
 		rpcURL,
 		false,
 		common.Address{},
@@ -243,7 +416,7 @@ func ERC20TokenHomeSend(
 		params,
 		amount,
 	)
-	return err
+	return receipt, err
 }
 
 func NativeTokenHomeSend(
@@ -254,7 +427,7 @@ func NativeTokenHomeSend(
 	destinationICTTEndpoint common.Address,
 	amountRecipient common.Address,
 	amount *big.Int,
-) error {
+) (*types.Receipt, error) {
 	type Params struct {
 		DestinationBlockchainID [32]byte
 		DestinationICTTEndpoint common.Address
@@ -267,7 +440,7 @@ func NativeTokenHomeSend(
 	}
 	tokenAddress, err := NativeTokenHomeGetTokenAddress(rpcURL, homeAddress)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	params := Params{
 		DestinationBlockchainID: destinationBlockchainID,
@@ -279,7 +452,7 @@ func NativeTokenHomeSend(
 		RequiredGasLimit:        big.NewInt(250000),
 		MultiHopFallback:        common.Address{},
 	}
-	_, _, err = contract.TxToMethod(
+	_, receipt, err := contract.TxToMethod(
 		rpcURL,
 		false,
 		common.Address{},
@@ -291,7 +464,7 @@ func NativeTokenHomeSend(
 		"send((bytes32, address, address, address, uint256, uint256, uint256, address))",
 		params,
 	)
-	return err
+	return receipt, err
 }
 
 func ERC20TokenRemoteSend(
@@ -302,7 +475,8 @@ func ERC20TokenRemoteSend(
 	destinationICTTEndpoint common.Address,
 	amountRecipient common.Address,
 	amount *big.Int,
-) error {
+	multiHop MultiHopSettings,
+) (*types.Receipt, error) {
 	if _, _, err := contract.TxToMethod(
 		rpcURL,
 		false,
@@ -316,7 +490,7 @@ func ERC20TokenRemoteSend(
 		remoteAddress,
 		amount,
 	); err != nil {
-		return err
+		return nil, err
 	}
 	type Params struct {
 		DestinationBlockchainID [32]byte
@@ -334,11 +508,11 @@ func ERC20TokenRemoteSend(
 		AmountRecipient:         amountRecipient,
 		PrimaryFeeTokenAddress:  common.Address{},
 		PrimaryFee:              big.NewInt(0),
-		SecondaryFee:            big.NewInt(0),
+		SecondaryFee:            multiHop.secondaryFee(),
 		RequiredGasLimit:        big.NewInt(250000),
-		MultiHopFallback:        common.Address{},
+		MultiHopFallback:        multiHop.Fallback,
 	}
-	_, _, err := contract.TxToMethod(
+	_, receipt, err := contract.TxToMethod(
 		rpcURL,
 		false,
 		common.Address{},
@@ -351,7 +525,7 @@ func ERC20TokenRemoteSend(
 		params,
 		amount,
 	)
-	return err
+	return receipt, err
 }
 
 func NativeTokenRemoteSend(
@@ -362,7 +536,8 @@ func NativeTokenRemoteSend(
 	destinationICTTEndpoint common.Address,
 	amountRecipient common.Address,
 	amount *big.Int,
-) error {
+	multiHop MultiHopSettings,
+) (*types.Receipt, error) {
 	type Params struct {
 		DestinationBlockchainID [32]byte
 		DestinationICTTEndpoint common.Address
@@ -379,11 +554,11 @@ func NativeTokenRemoteSend(
 		AmountRecipient:         amountRecipient,
 		PrimaryFeeTokenAddress:  remoteAddress, // in theory this is optional
 		PrimaryFee:              big.NewInt(0),
-		SecondaryFee:            big.NewInt(0),
+		SecondaryFee:            multiHop.secondaryFee(),
 		RequiredGasLimit:        big.NewInt(250000),
-		MultiHopFallback:        common.Address{},
+		MultiHopFallback:        multiHop.Fallback,
 	}
-	_, _, err := contract.TxToMethod(
+	_, receipt, err := contract.TxToMethod(
 		rpcURL,
 		false,
 		common.Address{},
@@ -395,7 +570,7 @@ func NativeTokenRemoteSend(
 		"send((bytes32, address, address, address, uint256, uint256, uint256, address))",
 		params,
 	)
-	return err
+	return receipt, err
 }
 
 func NativeTokenHomeAddCollateral(
@@ -517,13 +692,17 @@ func Send(
 	destinationAddress common.Address,
 	amountRecipient common.Address,
 	amount *big.Int,
-) error {
+	multiHop MultiHopSettings,
+) (*types.Receipt, error) {
 	endpointKind, err := GetEndpointKind(
 		rpcURL,
 		address,
 	)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if multiHop.Fallback != (common.Address{}) && endpointKind != ERC20TokenRemote && endpointKind != NativeTokenRemote {
+		return nil, fmt.Errorf("multi-hop transfers can only be started from a token remote")
 	}
 	switch endpointKind {
 	case ERC20TokenRemote:
@@ -535,6 +714,7 @@ func Send(
 			destinationAddress,
 			amountRecipient,
 			amount,
+			multiHop,
 		)
 	case ERC20TokenHome:
 		return ERC20TokenHomeSend(
@@ -565,7 +745,8 @@ func Send(
 			destinationAddress,
 			amountRecipient,
 			amount,
+			multiHop,
 		)
 	}
-	return fmt.Errorf("unknown ictt endpoint")
+	return nil, fmt.Errorf("unknown ictt endpoint")
 }
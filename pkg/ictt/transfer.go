@@ -0,0 +1,227 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package ictt
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/pkg/interchain"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/sdk/evm"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/interfaces"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// ICM messenger event emitted on each received message, indexed by message ID, source blockchain ID and deliverer
+	receiveCrossChainMessageEventSignature = "ReceiveCrossChainMessage(bytes32,bytes32,address,address,(uint256,address,bytes32,address,uint256,address[],(uint256,address)[],bytes))"
+
+	legDeliveryCheckInterval = 500 * time.Millisecond
+)
+
+var receiveCrossChainMessageEventID = crypto.Keccak256Hash([]byte(receiveCrossChainMessageEventSignature))
+
+// TransferSpec describes an ICTT transfer from a home or remote into another endpoint.
+// If the source is a remote and the destination is not its home, the transfer is routed
+// through the home
+type TransferSpec struct {
+	SourceRPCURL            string
+	SourceBlockchainID      ids.ID
+	SourceAddress           common.Address
+	PrivateKey              string
+	DestinationBlockchainID ids.ID
+	DestinationAddress      common.Address
+	AmountRecipient         common.Address
+	Amount                  *big.Int
+	// only used for multi-hop transfers. fallback defaults to the sender address
+	MultiHop MultiHopSettings
+}
+
+// TransferLeg is one ICM message of an ICTT transfer
+type TransferLeg struct {
+	SourceBlockchainID      ids.ID
+	DestinationBlockchainID ids.ID
+	MessageID               ids.ID
+	Delivered               bool
+}
+
+// Transfer executes the transfer described by [spec], and tracks its legs until they are delivered
+// or [legTimeout] expires. Legs are only tracked on blockchains with a known endpoint at [rpcEndpoints].
+// If [legTimeout] is zero, the first leg is returned once sent, without tracking its delivery.
+// The ICM messenger is expected to be at the same address on all blockchains
+func Transfer(
+	spec TransferSpec,
+	rpcEndpoints map[ids.ID]string,
+	legTimeout time.Duration,
+) ([]TransferLeg, error) {
+	endpointKind, err := GetEndpointKind(spec.SourceRPCURL, spec.SourceAddress)
+	if err != nil {
+		return nil, err
+	}
+	homeBlockchainID := ids.Empty
+	isMultiHop := false
+	if endpointKind == ERC20TokenRemote || endpointKind == NativeTokenRemote {
+		homeBlockchainID, err = TokenRemoteGetTokenHomeBlockchainID(spec.SourceRPCURL, spec.SourceAddress)
+		if err != nil {
+			return nil, err
+		}
+		isMultiHop = homeBlockchainID != spec.DestinationBlockchainID
+	}
+	multiHop := MultiHopSettings{}
+	homeFromBlock := uint64(0)
+	track := legTimeout > 0
+	if isMultiHop {
+		multiHop = spec.MultiHop
+		if multiHop.Fallback == (common.Address{}) {
+			senderAddress, err := evm.PrivateKeyToAddress(spec.PrivateKey)
+			if err != nil {
+				return nil, err
+			}
+			multiHop.Fallback = senderAddress
+		}
+		if homeRPCURL := rpcEndpoints[homeBlockchainID]; track && homeRPCURL != "" {
+			homeFromBlock, err = getBlockchainHeight(homeRPCURL)
+			if err != nil {
+				return nil, err
+			}
+		}
+		ux.Logger.PrintToUser("Multi-hop transfer through home %s, with fallback %s", homeBlockchainID, multiHop.Fallback.Hex())
+	}
+	receipt, err := Send(
+		spec.SourceRPCURL,
+		spec.SourceAddress,
+		spec.PrivateKey,
+		spec.DestinationBlockchainID,
+		spec.DestinationAddress,
+		spec.AmountRecipient,
+		spec.Amount,
+		multiHop,
+	)
+	if err != nil {
+		return nil, err
+	}
+	leg, messengerAddress, err := getSentLeg(receipt.Logs, spec.SourceBlockchainID)
+	if err != nil {
+		return nil, err
+	}
+	legs := []TransferLeg{leg}
+	ux.Logger.PrintToUser("Leg 1: %s -> %s, ICM message ID %s", leg.SourceBlockchainID, leg.DestinationBlockchainID, leg.MessageID)
+	if !track {
+		return legs, nil
+	}
+	if err := waitForLegDelivery(rpcEndpoints, messengerAddress, &legs[0], legTimeout); err != nil {
+		return legs, err
+	}
+	if !isMultiHop || !legs[0].Delivered {
+		return legs, nil
+	}
+	leg, err = getForwardedLeg(rpcEndpoints[homeBlockchainID], messengerAddress, legs[0], homeFromBlock)
+	if err != nil {
+		return legs, err
+	}
+	legs = append(legs, leg)
+	ux.Logger.PrintToUser("Leg 2: %s -> %s, ICM message ID %s", leg.SourceBlockchainID, leg.DestinationBlockchainID, leg.MessageID)
+	if err := waitForLegDelivery(rpcEndpoints, messengerAddress, &legs[1], legTimeout); err != nil {
+		return legs, err
+	}
+	return legs, nil
+}
+
+// returns the ICM message sent on [logs] from [sourceBlockchainID], together with the messenger address
+func getSentLeg(logs []*types.Log, sourceBlockchainID ids.ID) (TransferLeg, common.Address, error) {
+	for _, log := range logs {
+		event, err := interchain.ParseSendCrossChainMessage(*log)
+		if err == nil {
+			return TransferLeg{
+				SourceBlockchainID:      sourceBlockchainID,
+				DestinationBlockchainID: event.DestinationBlockchainID,
+				MessageID:               event.MessageID,
+			}, log.Address, nil
+		}
+	}
+	return TransferLeg{}, common.Address{}, fmt.Errorf("no ICM message found on transfer logs")
+}
+
+// waits for [leg] to be received at its destination, if the destination endpoint is known
+func waitForLegDelivery(
+	rpcEndpoints map[ids.ID]string,
+	messengerAddress common.Address,
+	leg *TransferLeg,
+	timeout time.Duration,
+) error {
+	rpcURL := rpcEndpoints[leg.DestinationBlockchainID]
+	if rpcURL == "" {
+		ux.Logger.PrintToUser("  unknown endpoint for %s. not tracking delivery", leg.DestinationBlockchainID)
+		return nil
+	}
+	t0 := time.Now()
+	for {
+		received, err := interchain.MessageReceived(rpcURL, messengerAddress, leg.MessageID)
+		if err != nil {
+			return err
+		}
+		if received {
+			leg.Delivered = true
+			ux.Logger.PrintToUser("  delivered")
+			return nil
+		}
+		if time.Since(t0) > timeout {
+			return fmt.Errorf("timeout waiting for ICM message %s to be delivered to %s", leg.MessageID, leg.DestinationBlockchainID)
+		}
+		time.Sleep(legDeliveryCheckInterval)
+	}
+}
+
+// returns the ICM message sent by the home when processing the delivery of [firstLeg], searching
+// for its reception on the home blockchain at [homeRPCURL] starting at [fromBlock]
+func getForwardedLeg(
+	homeRPCURL string,
+	messengerAddress common.Address,
+	firstLeg TransferLeg,
+	fromBlock uint64,
+) (TransferLeg, error) {
+	client, err := evm.GetClient(homeRPCURL)
+	if err != nil {
+		return TransferLeg{}, err
+	}
+	defer client.Close()
+	logs, err := client.FilterLogs(interfaces.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		Addresses: []common.Address{messengerAddress},
+		Topics: [][]common.Hash{
+			{receiveCrossChainMessageEventID},
+			{common.Hash(firstLeg.MessageID)},
+		},
+	})
+	if err != nil {
+		return TransferLeg{}, err
+	}
+	if len(logs) == 0 {
+		return TransferLeg{}, fmt.Errorf("reception of ICM message %s not found on home", firstLeg.MessageID)
+	}
+	receipt, err := client.TransactionReceipt(logs[0].TxHash)
+	if err != nil {
+		return TransferLeg{}, err
+	}
+	leg, _, err := getSentLeg(receipt.Logs, firstLeg.DestinationBlockchainID)
+	if err != nil {
+		return TransferLeg{}, fmt.Errorf("home did not forward the transfer, tokens may have been sent to the fallback: %w", err)
+	}
+	return leg, nil
+}
+
+// returns the current height of the blockchain at [rpcURL]
+func getBlockchainHeight(rpcURL string) (uint64, error) {
+	client, err := evm.GetClient(rpcURL)
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+	return client.BlockNumber()
+}
@@ -0,0 +1,402 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package ictt
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/internal/testutils"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	"github.com/ava-labs/avalanche-cli/pkg/interchain"
+	"github.com/ava-labs/avalanche-cli/sdk/evm"
+	"github.com/ava-labs/avalanchego/ids"
+	teleportermessenger "github.com/ava-labs/icm-contracts/abi-bindings/go/teleporter/TeleporterMessenger"
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const warpPrecompileAddress = "0x0200000000000000000000000000000000000005"
+
+// testMessengerInitCode returns the creation code of an ICM messenger proxy, that delegates
+// to the messenger [implementation], and that also lets anyone deliver messages. It is used
+// on simulated blockchains, where warp messages can be sent but not received.
+// Calls with a zero selector, followed by the target address, the message ID, and the target
+// call data, mark the message as received, emit ReceiveCrossChainMessage with its ID, and
+// call the target as the messenger
+func testMessengerInitCode(implementation common.Address) []byte {
+	runtime := []byte{
+		0x60, 0x00, 0x35, 0x60, 0xe0, 0x1c, // selector
+		0x15, 0x60, 0x40, 0x57, // zero selector: jump to delivery
+		// delegate to the implementation, with the messenger storage
+		0x36, 0x60, 0x00, 0x60, 0x00, 0x37, // CALLDATACOPY(0, 0, CALLDATASIZE)
+		0x60, 0x00, 0x60, 0x00, 0x36, 0x60, 0x00, // retSize, retOffset, argsSize, argsOffset
+		0x73, // PUSH20 implementation
+	}
+	runtime = append(runtime, implementation.Bytes()...)
+	runtime = append(runtime,
+		0x5a, 0xf4, // DELEGATECALL
+		0x3d, 0x60, 0x00, 0x60, 0x00, 0x3e, // RETURNDATACOPY(0, 0, RETURNDATASIZE)
+		0x60, 0x3b, 0x57, // success: jump to return
+		0x3d, 0x60, 0x00, 0xfd, // REVERT(0, RETURNDATASIZE)
+		0x5b, 0x3d, 0x60, 0x00, 0xf3, // RETURN(0, RETURNDATASIZE)
+		// delivery: _receivedMessageNonces[messageID] = 1, at slot 7 of the messenger layout
+		0x5b,
+		0x60, 0x24, 0x35, 0x60, 0x00, 0x52, // MSTORE(0, messageID)
+		0x60, 0x07, 0x60, 0x20, 0x52, // MSTORE(0x20, 7)
+		0x60, 0x01, 0x60, 0x40, 0x60, 0x00, 0x20, 0x55, // SSTORE(KECCAK256(0, 0x40), 1)
+		0x60, 0x24, 0x35, // messageID topic
+		0x7f, // PUSH32 event topic
+	)
+	runtime = append(runtime, receiveCrossChainMessageEventID.Bytes()...)
+	runtime = append(runtime,
+		0x60, 0x00, 0x60, 0x00, 0xa2, // LOG2(0, 0, event, messageID)
+		0x60, 0x44, 0x36, 0x03, 0x80, // target call data size
+		0x60, 0x44, 0x60, 0x00, 0x37, // CALLDATACOPY(0, 0x44, size)
+		0x60, 0x00, 0x60, 0x00, 0x82, 0x60, 0x00, 0x60, 0x00, // retSize, retOffset, argsSize, argsOffset, value
+		0x60, 0x04, 0x35, 0x5a, 0xf1, // CALL(GAS, target, ...)
+		0x60, 0xa2, 0x57, // success: jump to stop
+		0x3d, 0x60, 0x00, 0x60, 0x00, 0x3e, 0x3d, 0x60, 0x00, 0xfd, // bubble up revert
+		0x5b, 0x00,
+	)
+	// the messenger constructor sets its reentrancy guards (slots 0 and 1) to not entered
+	initCode := []byte{
+		0x60, 0x01, 0x60, 0x00, 0x55, 0x60, 0x01, 0x60, 0x01, 0x55,
+		0x61, byte(len(runtime) >> 8), byte(len(runtime)), 0x80, 0x61, 0x00, 23, 0x60, 0x00, 0x39, 0x60, 0x00, 0xf3,
+	}
+	return append(initCode, runtime...)
+}
+
+// testChain is a simulated blockchain with an ICM messenger and registry. The messenger is
+// deployed by the shared deployer key, so it is at the same address on all test chains
+type testChain struct {
+	sim          *testutils.SimulatedEVM
+	blockchainID ids.ID
+	messenger    common.Address
+	registry     common.Address
+}
+
+func newTestChain(t *testing.T, deployerKey string) *testChain {
+	require := require.New(t)
+	deployerPrivateKey, err := crypto.HexToECDSA(deployerKey)
+	require.NoError(err)
+	simEVM := testutils.NewSimulatedEVM(t, func(allocs types.GenesisAlloc, _ common.Address) {
+		allocs[crypto.PubkeyToAddress(deployerPrivateKey.PublicKey)] = types.Account{
+			Balance: new(big.Int).Mul(big.NewInt(1_000), big.NewInt(1e18)),
+		}
+	})
+	out, err := contract.CallToMethod(simEVM.RPCURL, common.HexToAddress(warpPrecompileAddress), "getBlockchainID()->(bytes32)")
+	require.NoError(err)
+	blockchainID, err := contract.GetSmartContractCallResult[[32]byte]("getBlockchainID", out)
+	require.NoError(err)
+	implementation, err := contract.DeployContract(
+		simEVM.RPCURL,
+		deployerKey,
+		[]byte(teleportermessenger.TeleporterMessengerMetaData.Bin),
+		"()",
+	)
+	require.NoError(err)
+	messenger, err := contract.DeployContract(
+		simEVM.RPCURL,
+		deployerKey,
+		[]byte(hex.EncodeToString(testMessengerInitCode(implementation))),
+		"()",
+	)
+	require.NoError(err)
+	return &testChain{
+		sim:          simEVM,
+		blockchainID: blockchainID,
+		messenger:    messenger,
+		registry:     deployTestRegistry(t, simEVM, messenger),
+	}
+}
+
+// relays the ICM messages sent between [chains] until [ctx] is done, delivering them with [deployerKey]
+func relay(
+	ctx context.Context,
+	t *testing.T,
+	wg *sync.WaitGroup,
+	chains []*testChain,
+	deployerKey string,
+) {
+	defer wg.Done()
+	chainsByID := map[ids.ID]*testChain{}
+	nextBlock := map[ids.ID]uint64{}
+	for _, chain := range chains {
+		chainsByID[chain.blockchainID] = chain
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(50 * time.Millisecond):
+		}
+		for _, source := range chains {
+			client, err := evm.GetClient(source.sim.RPCURL)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			logs, err := client.FilterLogs(interfaces.FilterQuery{
+				FromBlock: new(big.Int).SetUint64(nextBlock[source.blockchainID]),
+				Addresses: []common.Address{source.messenger},
+			})
+			client.Close()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			for _, log := range logs {
+				nextBlock[source.blockchainID] = log.BlockNumber + 1
+				event, err := interchain.ParseSendCrossChainMessage(log)
+				if err != nil {
+					continue
+				}
+				destination := chainsByID[event.DestinationBlockchainID]
+				if destination == nil {
+					continue
+				}
+				if err := deliver(destination, deployerKey, source.blockchainID, event); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}
+	}
+}
+
+// delivers [event] on [destination], as the ICM messenger
+func deliver(
+	destination *testChain,
+	deployerKey string,
+	sourceBlockchainID ids.ID,
+	event *interchain.ICMMessengerSendCrossChainMessage,
+) error {
+	receiveCallData, err := contract.PackMethodCall(
+		"receiveTeleporterMessage(bytes32, address, bytes)",
+		sourceBlockchainID,
+		event.Message.OriginSenderAddress,
+		event.Message.Message,
+	)
+	if err != nil {
+		return err
+	}
+	callData := make([]byte, 4)
+	callData = append(callData, common.LeftPadBytes(event.Message.DestinationAddress.Bytes(), 32)...)
+	callData = append(callData, event.MessageID[:]...)
+	callData = append(callData, receiveCallData...)
+	client, err := evm.GetClient(destination.sim.RPCURL)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txOpts, err := client.GetTxOptsWithSigner(deployerKey)
+	if err != nil {
+		return err
+	}
+	messenger := bind.NewBoundContract(destination.messenger, abi.ABI{}, client.EthClient, client.EthClient, client.EthClient)
+	tx, err := messenger.RawTransact(txOpts, callData)
+	if err != nil {
+		return err
+	}
+	if _, success, err := client.WaitForTransaction(tx); err != nil {
+		return err
+	} else if !success {
+		return contract.ErrFailedReceiptStatus
+	}
+	return nil
+}
+
+func getTokenBalance(t *testing.T, rpcURL string, tokenAddress common.Address, address common.Address) *big.Int {
+	out, err := contract.CallToMethod(rpcURL, tokenAddress, "balanceOf(address)->(uint256)", address)
+	require.NoError(t, err)
+	balance, err := contract.GetSmartContractCallResult[*big.Int]("balanceOf", out)
+	require.NoError(t, err)
+	return balance
+}
+
+func TestTransfer(t *testing.T) {
+	require := testutils.SetupTest(t)
+	deployerPrivateKey, err := crypto.GenerateKey()
+	require.NoError(err)
+	deployerKey := common.Bytes2Hex(crypto.FromECDSA(deployerPrivateKey))
+	home := newTestChain(t, deployerKey)
+	remoteA := newTestChain(t, deployerKey)
+	remoteB := newTestChain(t, deployerKey)
+	require.Equal(home.messenger, remoteA.messenger)
+	require.Equal(home.messenger, remoteB.messenger)
+	rpcEndpoints := map[ids.ID]string{
+		home.blockchainID:    home.sim.RPCURL,
+		remoteA.blockchainID: remoteA.sim.RPCURL,
+		remoteB.blockchainID: remoteB.sim.RPCURL,
+	}
+
+	artifacts, err := GetEmbeddedArtifacts(constants.ICTTVersion)
+	require.NoError(err)
+	tokenAddress, err := DeployWrappedNativeToken(artifacts, home.sim.RPCURL, home.sim.PrivateKey, "TEST")
+	require.NoError(err)
+	homeAddress, err := DeployERC20Home(
+		artifacts,
+		home.sim.RPCURL,
+		home.sim.PrivateKey,
+		home.registry,
+		home.sim.Address,
+		tokenAddress,
+		18,
+	)
+	require.NoError(err)
+	remoteAddresses := map[ids.ID]common.Address{}
+	for _, remote := range []*testChain{remoteA, remoteB} {
+		remoteAddresses[remote.blockchainID], err = DeployERC20Remote(
+			artifacts,
+			remote.sim.RPCURL,
+			remote.sim.PrivateKey,
+			remote.registry,
+			remote.sim.Address,
+			home.blockchainID,
+			homeAddress,
+			18,
+			"Test Token",
+			"TEST",
+			18,
+		)
+		require.NoError(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go relay(ctx, t, &wg, []*testChain{home, remoteA, remoteB}, deployerKey)
+	stopRelayer := func() {
+		cancel()
+		wg.Wait()
+	}
+	defer stopRelayer()
+
+	for _, remote := range []*testChain{remoteA, remoteB} {
+		require.NoError(RegisterRemote(remote.sim.RPCURL, remote.sim.PrivateKey, remoteAddresses[remote.blockchainID]))
+		require.Eventually(func() bool {
+			registeredRemote, err := TokenHomeGetRegisteredRemote(home.sim.RPCURL, homeAddress, remote.blockchainID, remoteAddresses[remote.blockchainID])
+			return err == nil && registeredRemote.Registered
+		}, 10*time.Second, 50*time.Millisecond)
+	}
+
+	// home to remote, tracked until delivery
+	amount := new(big.Int).Mul(big.NewInt(10), big.NewInt(1e18))
+	_, _, err = contract.TxToMethod(
+		home.sim.RPCURL,
+		false,
+		common.Address{},
+		home.sim.PrivateKey,
+		tokenAddress,
+		amount,
+		"wrap native token",
+		nil,
+		"deposit()",
+	)
+	require.NoError(err)
+	legs, err := Transfer(
+		TransferSpec{
+			SourceRPCURL:            home.sim.RPCURL,
+			SourceBlockchainID:      home.blockchainID,
+			SourceAddress:           homeAddress,
+			PrivateKey:              home.sim.PrivateKey,
+			DestinationBlockchainID: remoteA.blockchainID,
+			DestinationAddress:      remoteAddresses[remoteA.blockchainID],
+			AmountRecipient:         remoteA.sim.Address,
+			Amount:                  amount,
+		},
+		rpcEndpoints,
+		10*time.Second,
+	)
+	require.NoError(err)
+	require.Len(legs, 1)
+	require.Equal(home.blockchainID, legs[0].SourceBlockchainID)
+	require.Equal(remoteA.blockchainID, legs[0].DestinationBlockchainID)
+	require.NotEqual(ids.Empty, legs[0].MessageID)
+	require.True(legs[0].Delivered)
+	require.Equal(amount, getTokenBalance(t, remoteA.sim.RPCURL, remoteAddresses[remoteA.blockchainID], remoteA.sim.Address))
+
+	// remote to remote, routed through the home and tracked on both legs
+	multiHopAmount := new(big.Int).Mul(big.NewInt(4), big.NewInt(1e18))
+	legs, err = Transfer(
+		TransferSpec{
+			SourceRPCURL:            remoteA.sim.RPCURL,
+			SourceBlockchainID:      remoteA.blockchainID,
+			SourceAddress:           remoteAddresses[remoteA.blockchainID],
+			PrivateKey:              remoteA.sim.PrivateKey,
+			DestinationBlockchainID: remoteB.blockchainID,
+			DestinationAddress:      remoteAddresses[remoteB.blockchainID],
+			AmountRecipient:         remoteB.sim.Address,
+			Amount:                  multiHopAmount,
+		},
+		rpcEndpoints,
+		10*time.Second,
+	)
+	require.NoError(err)
+	require.Len(legs, 2)
+	require.Equal(remoteA.blockchainID, legs[0].SourceBlockchainID)
+	require.Equal(home.blockchainID, legs[0].DestinationBlockchainID)
+	require.True(legs[0].Delivered)
+	require.Equal(home.blockchainID, legs[1].SourceBlockchainID)
+	require.Equal(remoteB.blockchainID, legs[1].DestinationBlockchainID)
+	require.NotEqual(legs[0].MessageID, legs[1].MessageID)
+	require.True(legs[1].Delivered)
+	require.Equal(multiHopAmount, getTokenBalance(t, remoteB.sim.RPCURL, remoteAddresses[remoteB.blockchainID], remoteB.sim.Address))
+	require.Equal(
+		new(big.Int).Sub(amount, multiHopAmount),
+		getTokenBalance(t, remoteA.sim.RPCURL, remoteAddresses[remoteA.blockchainID], remoteA.sim.Address),
+	)
+
+	stopRelayer()
+	remoteToHome := TransferSpec{
+		SourceRPCURL:            remoteA.sim.RPCURL,
+		SourceBlockchainID:      remoteA.blockchainID,
+		SourceAddress:           remoteAddresses[remoteA.blockchainID],
+		PrivateKey:              remoteA.sim.PrivateKey,
+		DestinationBlockchainID: home.blockchainID,
+		DestinationAddress:      homeAddress,
+		AmountRecipient:         home.sim.Address,
+		Amount:                  big.NewInt(1),
+	}
+
+	// without tracking, the transfer returns once sent
+	legs, err = Transfer(remoteToHome, rpcEndpoints, 0)
+	require.NoError(err)
+	require.Len(legs, 1)
+	require.Equal(home.blockchainID, legs[0].DestinationBlockchainID)
+	require.False(legs[0].Delivered)
+
+	// legs to blockchains with unknown endpoints are not tracked
+	legs, err = Transfer(remoteToHome, map[ids.ID]string{remoteA.blockchainID: remoteA.sim.RPCURL}, 10*time.Second)
+	require.NoError(err)
+	require.Len(legs, 1)
+	require.False(legs[0].Delivered)
+
+	// undelivered legs time out
+	legs, err = Transfer(remoteToHome, rpcEndpoints, time.Second)
+	require.ErrorContains(err, "timeout waiting for ICM message")
+	require.Len(legs, 1)
+	require.False(legs[0].Delivered)
+}
+
+func TestGetSentLeg(t *testing.T) {
+	require := require.New(t)
+	_, _, err := getSentLeg([]*types.Log{
+		{
+			Address: common.HexToAddress("0x253b2784c75e510dD0fF1da844684a1aC0aa5fcf"),
+			Topics:  []common.Hash{receiveCrossChainMessageEventID},
+		},
+	}, ids.GenerateTestID())
+	require.ErrorContains(err, "no ICM message found")
+}
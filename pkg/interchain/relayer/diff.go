@@ -0,0 +1,182 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package relayer
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/ava-labs/avalanche-cli/sdk/evm"
+	"github.com/ava-labs/icm-services/relayer/config"
+
+	"golang.org/x/exp/maps"
+)
+
+const (
+	RelayerConfigEntryAdded   = "added"
+	RelayerConfigEntryRemoved = "removed"
+	RelayerConfigEntryChanged = "changed"
+
+	RelayerConfigGlobal      = "global"
+	RelayerConfigSource      = "source"
+	RelayerConfigDestination = "destination"
+)
+
+// RelayerConfigDiff is a difference found between two relayer configs, for a given
+// source, destination, or for the global settings
+type RelayerConfigDiff struct {
+	Kind         string
+	Role         string
+	BlockchainID string
+	// changed fields, described as "field: old -> new"
+	Details []string
+}
+
+// DiffRelayerConfigs returns the differences needed to go from [current] into [other].
+// Private keys are compared by their address, so they are never shown
+func DiffRelayerConfigs(current *config.Config, other *config.Config) []RelayerConfigDiff {
+	diffs := []RelayerConfigDiff{}
+	if details := diffFields(relayerGlobalFields(current), relayerGlobalFields(other)); len(details) > 0 {
+		diffs = append(diffs, RelayerConfigDiff{
+			Kind:    RelayerConfigEntryChanged,
+			Role:    RelayerConfigGlobal,
+			Details: details,
+		})
+	}
+	currentSources := map[string]map[string]string{}
+	for _, source := range current.SourceBlockchains {
+		currentSources[source.BlockchainID] = relayerSourceFields(source)
+	}
+	otherSources := map[string]map[string]string{}
+	for _, source := range other.SourceBlockchains {
+		otherSources[source.BlockchainID] = relayerSourceFields(source)
+	}
+	diffs = append(diffs, diffEntries(RelayerConfigSource, currentSources, otherSources)...)
+	currentDestinations := map[string]map[string]string{}
+	for _, destination := range current.DestinationBlockchains {
+		currentDestinations[destination.BlockchainID] = relayerDestinationFields(destination)
+	}
+	otherDestinations := map[string]map[string]string{}
+	for _, destination := range other.DestinationBlockchains {
+		otherDestinations[destination.BlockchainID] = relayerDestinationFields(destination)
+	}
+	diffs = append(diffs, diffEntries(RelayerConfigDestination, currentDestinations, otherDestinations)...)
+	return diffs
+}
+
+// compares source or destination entries of [role], indexed by blockchain ID
+func diffEntries(role string, current map[string]map[string]string, other map[string]map[string]string) []RelayerConfigDiff {
+	diffs := []RelayerConfigDiff{}
+	for _, blockchainID := range sortedKeys(current) {
+		otherFields, ok := other[blockchainID]
+		if !ok {
+			diffs = append(diffs, RelayerConfigDiff{
+				Kind:         RelayerConfigEntryRemoved,
+				Role:         role,
+				BlockchainID: blockchainID,
+			})
+			continue
+		}
+		if details := diffFields(current[blockchainID], otherFields); len(details) > 0 {
+			diffs = append(diffs, RelayerConfigDiff{
+				Kind:         RelayerConfigEntryChanged,
+				Role:         role,
+				BlockchainID: blockchainID,
+				Details:      details,
+			})
+		}
+	}
+	for _, blockchainID := range sortedKeys(other) {
+		if _, ok := current[blockchainID]; !ok {
+			diffs = append(diffs, RelayerConfigDiff{
+				Kind:         RelayerConfigEntryAdded,
+				Role:         role,
+				BlockchainID: blockchainID,
+			})
+		}
+	}
+	return diffs
+}
+
+func diffFields(current map[string]string, other map[string]string) []string {
+	fields := map[string]string{}
+	for field := range current {
+		fields[field] = ""
+	}
+	for field := range other {
+		fields[field] = ""
+	}
+	details := []string{}
+	for _, field := range sortedKeys(fields) {
+		if current[field] != other[field] {
+			details = append(details, fmt.Sprintf("%s: %s -> %s", field, fieldValue(current[field]), fieldValue(other[field])))
+		}
+	}
+	return details
+}
+
+func fieldValue(value string) string {
+	if value == "" {
+		return "<unset>"
+	}
+	return value
+}
+
+func relayerGlobalFields(relayerConfig *config.Config) map[string]string {
+	fields := map[string]string{
+		"log-level":                 relayerConfig.LogLevel,
+		"storage-location":          relayerConfig.StorageLocation,
+		"process-missed-blocks":     fmt.Sprint(relayerConfig.ProcessMissedBlocks),
+		"api-port":                  fmt.Sprint(relayerConfig.APIPort),
+		"metrics-port":              fmt.Sprint(relayerConfig.MetricsPort),
+		"allow-private-ips":         fmt.Sprint(relayerConfig.AllowPrivateIPs),
+		"db-write-interval-seconds": fmt.Sprint(relayerConfig.DBWriteIntervalSeconds),
+	}
+	if relayerConfig.PChainAPI != nil {
+		fields["p-chain-api"] = relayerConfig.PChainAPI.BaseURL
+	}
+	if relayerConfig.InfoAPI != nil {
+		fields["info-api"] = relayerConfig.InfoAPI.BaseURL
+	}
+	return fields
+}
+
+func relayerSourceFields(source *config.SourceBlockchain) map[string]string {
+	fields := map[string]string{
+		"subnet-id":    source.SubnetID,
+		"vm":           source.VM,
+		"rpc-endpoint": source.RPCEndpoint.BaseURL,
+		"ws-endpoint":  source.WSEndpoint.BaseURL,
+	}
+	for address, messageContract := range source.MessageContracts {
+		settings, _ := json.Marshal(messageContract.Settings)
+		fields[fmt.Sprintf("message-contract %s", address)] = fmt.Sprintf("%s %s", messageContract.MessageFormat, settings)
+	}
+	for _, supportedDestination := range source.SupportedDestinations {
+		fields[fmt.Sprintf("supported-destination %s", supportedDestination.BlockchainID)] = fmt.Sprint(supportedDestination.Addresses)
+	}
+	return fields
+}
+
+func relayerDestinationFields(destination *config.DestinationBlockchain) map[string]string {
+	fields := map[string]string{
+		"subnet-id":    destination.SubnetID,
+		"vm":           destination.VM,
+		"rpc-endpoint": destination.RPCEndpoint.BaseURL,
+	}
+	if destination.AccountPrivateKey != "" {
+		if address, err := evm.PrivateKeyToAddress(destination.AccountPrivateKey); err == nil {
+			fields["account"] = address.Hex()
+		} else {
+			fields["account"] = "<invalid key>"
+		}
+	}
+	return fields
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := maps.Keys(m)
+	sort.Strings(keys)
+	return keys
+}
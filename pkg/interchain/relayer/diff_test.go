@@ -0,0 +1,68 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package relayer
+
+import (
+	"testing"
+
+	apiConfig "github.com/ava-labs/icm-services/config"
+	"github.com/ava-labs/icm-services/relayer/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffRelayerConfigs(t *testing.T) {
+	require := require.New(t)
+	current := &config.Config{
+		LogLevel: "info",
+		SourceBlockchains: []*config.SourceBlockchain{
+			{BlockchainID: "a", RPCEndpoint: apiConfig.APIConfig{BaseURL: "http://a/rpc"}},
+			{BlockchainID: "b", RPCEndpoint: apiConfig.APIConfig{BaseURL: "http://b/rpc"}},
+		},
+		DestinationBlockchains: []*config.DestinationBlockchain{
+			{BlockchainID: "a", RPCEndpoint: apiConfig.APIConfig{BaseURL: "http://a/rpc"}},
+		},
+	}
+	other := &config.Config{
+		LogLevel: "debug",
+		SourceBlockchains: []*config.SourceBlockchain{
+			{BlockchainID: "a", RPCEndpoint: apiConfig.APIConfig{BaseURL: "http://a2/rpc"}},
+		},
+		DestinationBlockchains: []*config.DestinationBlockchain{
+			{BlockchainID: "a", RPCEndpoint: apiConfig.APIConfig{BaseURL: "http://a/rpc"}},
+			{BlockchainID: "c", RPCEndpoint: apiConfig.APIConfig{BaseURL: "http://c/rpc"}},
+		},
+	}
+	diffs := DiffRelayerConfigs(current, other)
+	require.Equal([]RelayerConfigDiff{
+		{
+			Kind:    RelayerConfigEntryChanged,
+			Role:    RelayerConfigGlobal,
+			Details: []string{"log-level: info -> debug"},
+		},
+		{
+			Kind:         RelayerConfigEntryChanged,
+			Role:         RelayerConfigSource,
+			BlockchainID: "a",
+			Details:      []string{"rpc-endpoint: http://a/rpc -> http://a2/rpc"},
+		},
+		{
+			Kind:         RelayerConfigEntryRemoved,
+			Role:         RelayerConfigSource,
+			BlockchainID: "b",
+		},
+		{
+			Kind:         RelayerConfigEntryAdded,
+			Role:         RelayerConfigDestination,
+			BlockchainID: "c",
+		},
+	}, diffs)
+	require.Empty(DiffRelayerConfigs(current, current))
+}
+
+func TestCheckAddress(t *testing.T) {
+	require := require.New(t)
+	require.Error(checkAddress(""))
+	require.Error(checkAddress("0x1234"))
+	require.Error(checkAddress("0x0000000000000000000000000000000000000000"))
+	require.NoError(checkAddress("0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC"))
+}
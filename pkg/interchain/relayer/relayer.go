@@ -346,7 +346,7 @@ func getRelayerURL(version string) (string, error) {
 	), nil
 }
 
-func loadRelayerConfig(relayerConfigPath string) (*config.Config, error) {
+func LoadRelayerConfig(relayerConfigPath string) (*config.Config, error) {
 	awmRelayerConfig := config.Config{}
 	bs, err := os.ReadFile(relayerConfigPath)
 	if err != nil {
@@ -432,7 +432,7 @@ func AddSourceAndDestinationToRelayerConfig(
 	relayerRewardAddress string,
 	relayerPrivateKey string,
 ) error {
-	awmRelayerConfig, err := loadRelayerConfig(relayerConfigPath)
+	awmRelayerConfig, err := LoadRelayerConfig(relayerConfigPath)
 	if err != nil {
 		return err
 	}
@@ -466,7 +466,7 @@ func AddSourceToRelayerConfig(
 	icmMessengerAddress string,
 	relayerRewardAddress string,
 ) error {
-	awmRelayerConfig, err := loadRelayerConfig(relayerConfigPath)
+	awmRelayerConfig, err := LoadRelayerConfig(relayerConfigPath)
 	if err != nil {
 		return err
 	}
@@ -490,7 +490,7 @@ func AddDestinationToRelayerConfig(
 	blockchainID string,
 	relayerPrivateKey string,
 ) error {
-	awmRelayerConfig, err := loadRelayerConfig(relayerConfigPath)
+	awmRelayerConfig, err := LoadRelayerConfig(relayerConfigPath)
 	if err != nil {
 		return err
 	}
@@ -577,7 +577,7 @@ func waitForRelayerInitialization(
 	checkInterval time.Duration,
 	checkTimeout time.Duration,
 ) error {
-	config, err := loadRelayerConfig(relayerConfigPath)
+	config, err := LoadRelayerConfig(relayerConfigPath)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,390 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package relayer
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	"github.com/ava-labs/avalanche-cli/sdk/evm"
+	sdkutils "github.com/ava-labs/avalanche-cli/sdk/utils"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/icm-services/relayer/config"
+	"github.com/ava-labs/subnet-evm/interfaces"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// icm-services defaults, used when the config does not set the ports
+	defaultRelayerAPIPort     = uint16(8080)
+	defaultRelayerMetricsPort = uint16(9090)
+
+	relayerHealthPath  = "/health"
+	relayerMetricsPath = "/metrics"
+
+	successfulRelayMessageCountMetric = "successful_relay_message_count"
+	failedRelayMessageCountMetric     = "failed_relay_message_count"
+
+	// ICM messenger event emitted on each sent message, indexed by message ID and destination blockchain ID
+	sendCrossChainMessageEventSignature = "SendCrossChainMessage(bytes32,bytes32,(uint256,address,bytes32,address,uint256,address[],(uint256,address)[],bytes),(address,uint256))"
+
+	// key used by the relayer json storage to save the last processed height
+	latestProcessedBlockKey = "latestProcessedBlock"
+)
+
+var sendCrossChainMessageEventID = crypto.Keccak256Hash([]byte(sendCrossChainMessageEventSignature))
+
+// RelayerPairStatus is the relay state from a source blockchain to a destination blockchain
+type RelayerPairStatus struct {
+	SourceBlockchainID      string
+	DestinationBlockchainID string
+	// height of the source blockchain, and the last one processed by the relayer
+	SourceHeight        uint64
+	LastProcessedHeight uint64
+	HasLastProcessed    bool
+	Successful          uint64
+	Failed              uint64
+	// messages sent to destination on source blocks not yet processed by the relayer
+	Pending uint64
+}
+
+// RelayerBalance is the balance of the relayer key on a destination blockchain
+type RelayerBalance struct {
+	BlockchainID string
+	Address      string
+	Balance      *big.Int
+}
+
+// RelayerStatus summarizes the state of a locally running relayer
+type RelayerStatus struct {
+	HealthEndpoint  string
+	MetricsEndpoint string
+	Healthy         bool
+	// health check details, or the error obtained while querying the endpoint
+	HealthDetails string
+	Pairs         []RelayerPairStatus
+	Balances      []RelayerBalance
+	// per blockchain errors found while querying the chains
+	Errors []string
+}
+
+// relay metrics for a source/destination pair, as reported by the relayer
+type relayerPairMetrics struct {
+	successful uint64
+	failed     uint64
+}
+
+// GetRelayerStatus queries the health and metrics endpoints of the relayer running with config
+// [relayerConfigPath] at [host], and inspects all source and destination blockchains on the config.
+// Pending messages are searched for on source blocks not yet processed by the relayer, up to
+// the last [pendingBlocksWindow] blocks
+func GetRelayerStatus(
+	relayerConfigPath string,
+	host string,
+	pendingBlocksWindow uint64,
+) (RelayerStatus, error) {
+	status := RelayerStatus{}
+	relayerConfig, err := LoadRelayerConfig(relayerConfigPath)
+	if err != nil {
+		return status, err
+	}
+	apiPort := relayerConfig.APIPort
+	if apiPort == 0 {
+		apiPort = defaultRelayerAPIPort
+	}
+	metricsPort := relayerConfig.MetricsPort
+	if metricsPort == 0 {
+		metricsPort = defaultRelayerMetricsPort
+	}
+	status.HealthEndpoint = fmt.Sprintf("http://%s:%d%s", host, apiPort, relayerHealthPath)
+	status.MetricsEndpoint = fmt.Sprintf("http://%s:%d%s", host, metricsPort, relayerMetricsPath)
+	status.Healthy, status.HealthDetails = getRelayerHealth(status.HealthEndpoint)
+	pairsMetrics := map[string]relayerPairMetrics{}
+	if statusCode, metricsText, err := relayerHTTPGet(status.MetricsEndpoint); err != nil {
+		status.Errors = append(status.Errors, fmt.Sprintf("failure obtaining metrics: %s", err))
+	} else if statusCode != http.StatusOK {
+		status.Errors = append(status.Errors, fmt.Sprintf("failure obtaining metrics: unexpected status code %d", statusCode))
+	} else {
+		pairsMetrics = parseRelayerMetrics(metricsText)
+	}
+	for _, source := range relayerConfig.SourceBlockchains {
+		sourceHeight, err := getBlockchainHeight(source.RPCEndpoint.BaseURL)
+		if err != nil {
+			status.Errors = append(status.Errors, fmt.Sprintf("source %s: %s", source.BlockchainID, err))
+		}
+		for _, destination := range relayerConfig.DestinationBlockchains {
+			if !sourceSupportsDestination(source, destination.BlockchainID) {
+				continue
+			}
+			pair := RelayerPairStatus{
+				SourceBlockchainID:      source.BlockchainID,
+				DestinationBlockchainID: destination.BlockchainID,
+				SourceHeight:            sourceHeight,
+			}
+			pairMetrics := pairsMetrics[relayerPairKey(source.BlockchainID, destination.BlockchainID)]
+			pair.Successful = pairMetrics.successful
+			pair.Failed = pairMetrics.failed
+			pair.LastProcessedHeight, pair.HasLastProcessed, err = getRelayerLastProcessedHeight(
+				relayerConfig.StorageLocation,
+				source.BlockchainID,
+				destination.BlockchainID,
+			)
+			if err != nil {
+				status.Errors = append(status.Errors, fmt.Sprintf("storage for %s -> %s: %s", source.BlockchainID, destination.BlockchainID, err))
+			}
+			if sourceHeight == 0 {
+				status.Pairs = append(status.Pairs, pair)
+				continue
+			}
+			fromBlock := uint64(0)
+			if sourceHeight > pendingBlocksWindow {
+				fromBlock = sourceHeight - pendingBlocksWindow
+			}
+			if pair.HasLastProcessed && pair.LastProcessedHeight+1 > fromBlock {
+				fromBlock = pair.LastProcessedHeight + 1
+			}
+			if fromBlock <= sourceHeight {
+				pair.Pending, err = countSentMessages(source, destination.BlockchainID, fromBlock, sourceHeight)
+				if err != nil {
+					status.Errors = append(status.Errors, fmt.Sprintf("messages for %s -> %s: %s", source.BlockchainID, destination.BlockchainID, err))
+				}
+			}
+			status.Pairs = append(status.Pairs, pair)
+		}
+	}
+	for _, destination := range relayerConfig.DestinationBlockchains {
+		balance, err := getRelayerDestinationBalance(destination)
+		if err != nil {
+			status.Errors = append(status.Errors, fmt.Sprintf("destination %s balance: %s", destination.BlockchainID, err))
+			continue
+		}
+		status.Balances = append(status.Balances, balance)
+	}
+	return status, nil
+}
+
+// returns the relayer key address and balance on [destination]
+func getRelayerDestinationBalance(destination *config.DestinationBlockchain) (RelayerBalance, error) {
+	balance := RelayerBalance{
+		BlockchainID: destination.BlockchainID,
+	}
+	if destination.AccountPrivateKey == "" {
+		return balance, fmt.Errorf("no relayer key configured")
+	}
+	addr, err := evm.PrivateKeyToAddress(destination.AccountPrivateKey)
+	if err != nil {
+		return balance, err
+	}
+	balance.Address = addr.Hex()
+	client, err := evm.GetClient(destination.RPCEndpoint.BaseURL)
+	if err != nil {
+		return balance, err
+	}
+	defer client.Close()
+	balance.Balance, err = client.GetAddressBalance(balance.Address)
+	return balance, err
+}
+
+// returns the current height of the blockchain at [rpcURL]
+func getBlockchainHeight(rpcURL string) (uint64, error) {
+	client, err := evm.GetClient(rpcURL)
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+	return client.BlockNumber()
+}
+
+// counts the messages sent from [source] ICM messenger to [destinationBlockchainID]
+// between [fromBlock] and [toBlock]
+func countSentMessages(
+	source *config.SourceBlockchain,
+	destinationBlockchainID string,
+	fromBlock uint64,
+	toBlock uint64,
+) (uint64, error) {
+	messengerAddresses := []common.Address{}
+	for address, messageContract := range source.MessageContracts {
+		if messageContract.MessageFormat == config.TELEPORTER.String() {
+			messengerAddresses = append(messengerAddresses, common.HexToAddress(address))
+		}
+	}
+	if len(messengerAddresses) == 0 {
+		return 0, nil
+	}
+	destinationID, err := ids.FromString(destinationBlockchainID)
+	if err != nil {
+		return 0, err
+	}
+	client, err := evm.GetClient(source.RPCEndpoint.BaseURL)
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+	logs, err := client.FilterLogs(interfaces.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(toBlock),
+		Addresses: messengerAddresses,
+		Topics: [][]common.Hash{
+			{sendCrossChainMessageEventID},
+			nil,
+			{common.Hash(destinationID)},
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return uint64(len(logs)), nil
+}
+
+// indicates if the relayer relays messages from [source] into [destinationBlockchainID]
+func sourceSupportsDestination(source *config.SourceBlockchain, destinationBlockchainID string) bool {
+	if len(source.SupportedDestinations) == 0 {
+		return true
+	}
+	return utils.Any(source.SupportedDestinations, func(d *config.SupportedDestination) bool {
+		return d.BlockchainID == destinationBlockchainID
+	})
+}
+
+// returns the last height of [sourceBlockchainID] processed for [destinationBlockchainID], as saved
+// by the relayer json storage at [storageDir]. The CLI does not restrict sender nor destination
+// addresses, so the relayer ID is calculated the same way icm-services does it for that case
+func getRelayerLastProcessedHeight(
+	storageDir string,
+	sourceBlockchainID string,
+	destinationBlockchainID string,
+) (uint64, bool, error) {
+	zeroAddress := common.Address{}.Hex()
+	relayerID := crypto.Keccak256Hash([]byte(strings.Join([]string{
+		sourceBlockchainID,
+		destinationBlockchainID,
+		zeroAddress,
+		zeroAddress,
+	}, "-")))
+	storagePath := filepath.Join(storageDir, relayerID.Hex()+".json")
+	if !utils.FileExists(storagePath) {
+		return 0, false, nil
+	}
+	bs, err := os.ReadFile(storagePath)
+	if err != nil {
+		return 0, false, err
+	}
+	state := map[string]string{}
+	if err := json.Unmarshal(bs, &state); err != nil {
+		return 0, false, err
+	}
+	heightStr, ok := state[latestProcessedBlockKey]
+	if !ok {
+		return 0, false, nil
+	}
+	height, err := strconv.ParseUint(heightStr, 10, 64)
+	if err != nil {
+		return 0, false, err
+	}
+	return height, true, nil
+}
+
+// returns the health of the relayer, together with the health check response or error
+func getRelayerHealth(endpoint string) (bool, string) {
+	statusCode, body, err := relayerHTTPGet(endpoint)
+	if err != nil {
+		return false, err.Error()
+	}
+	return statusCode == http.StatusOK, strings.TrimSpace(body)
+}
+
+func relayerHTTPGet(endpoint string) (int, string, error) {
+	ctx, cancel := sdkutils.GetAPIContext()
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return 0, "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(body), err
+}
+
+func relayerPairKey(sourceBlockchainID string, destinationBlockchainID string) string {
+	return sourceBlockchainID + "-" + destinationBlockchainID
+}
+
+// parses the relay counters out of prometheus text exposition format [metricsText].
+// failures are added up for all failure reasons
+func parseRelayerMetrics(metricsText string) map[string]relayerPairMetrics {
+	pairsMetrics := map[string]relayerPairMetrics{}
+	scanner := bufio.NewScanner(strings.NewReader(metricsText))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, labels, value, ok := parseMetricLine(line)
+		if !ok || (name != successfulRelayMessageCountMetric && name != failedRelayMessageCountMetric) {
+			continue
+		}
+		key := relayerPairKey(labels["source_chain_id"], labels["destination_chain_id"])
+		pairMetrics := pairsMetrics[key]
+		if name == successfulRelayMessageCountMetric {
+			pairMetrics.successful += value
+		} else {
+			pairMetrics.failed += value
+		}
+		pairsMetrics[key] = pairMetrics
+	}
+	return pairsMetrics
+}
+
+// parses a prometheus sample line of the form name{label="value",...} number
+func parseMetricLine(line string) (string, map[string]string, uint64, bool) {
+	labels := map[string]string{}
+	name := line
+	rest := ""
+	if start := strings.Index(line, "{"); start != -1 {
+		end := strings.LastIndex(line, "}")
+		if end < start {
+			return "", nil, 0, false
+		}
+		name = line[:start]
+		for _, labelPair := range strings.Split(line[start+1:end], ",") {
+			labelName, labelValue, found := strings.Cut(labelPair, "=")
+			if !found {
+				continue
+			}
+			labels[strings.TrimSpace(labelName)] = strings.Trim(strings.TrimSpace(labelValue), `"`)
+		}
+		rest = line[end+1:]
+	} else {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return "", nil, 0, false
+		}
+		name = fields[0]
+		rest = strings.Join(fields[1:], " ")
+	}
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return "", nil, 0, false
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil || value < 0 {
+		return "", nil, 0, false
+	}
+	return name, labels, uint64(value), true
+}
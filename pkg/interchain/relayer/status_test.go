@@ -0,0 +1,64 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package relayer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRelayerMetrics(t *testing.T) {
+	require := require.New(t)
+	metricsText := `# HELP successful_relay_message_count Number of messages that relayed successfully
+# TYPE successful_relay_message_count counter
+successful_relay_message_count{destination_chain_id="dst",source_chain_id="src",source_subnet_id="subnet"} 5
+failed_relay_message_count{destination_chain_id="dst",failure_reason="failed to send",source_chain_id="src",source_subnet_id="subnet"} 2
+failed_relay_message_count{destination_chain_id="dst",failure_reason="failed to sign",source_chain_id="src",source_subnet_id="subnet"} 1
+fetch_signature_rpc_count{destination_chain_id="dst",source_chain_id="src",source_subnet_id="subnet"} 7
+go_goroutines 42
+`
+	pairsMetrics := parseRelayerMetrics(metricsText)
+	require.Len(pairsMetrics, 1)
+	pairMetrics := pairsMetrics[relayerPairKey("src", "dst")]
+	require.Equal(uint64(5), pairMetrics.successful)
+	require.Equal(uint64(3), pairMetrics.failed)
+
+	name, labels, value, ok := parseMetricLine("go_goroutines 42")
+	require.True(ok)
+	require.Equal("go_goroutines", name)
+	require.Empty(labels)
+	require.Equal(uint64(42), value)
+
+	_, _, _, ok = parseMetricLine("broken{")
+	require.False(ok)
+}
+
+func TestGetRelayerLastProcessedHeight(t *testing.T) {
+	require := require.New(t)
+	storageDir := t.TempDir()
+	sourceBlockchainID := "2ZzRQfUXDvZdc8b1zcPKKjBNnq4jXMcYhNZZEg6n8mBHQyBMXh"
+	destinationBlockchainID := "yH8D7ThNJkxmtkuv2jgBa4P1Rn3Qpr4pPr7QYNfcdoS6k6HWp"
+
+	_, found, err := getRelayerLastProcessedHeight(storageDir, sourceBlockchainID, destinationBlockchainID)
+	require.NoError(err)
+	require.False(found)
+
+	zeroAddress := common.Address{}.Hex()
+	relayerID := crypto.Keccak256Hash([]byte(sourceBlockchainID + "-" + destinationBlockchainID + "-" + zeroAddress + "-" + zeroAddress))
+	storagePath := filepath.Join(storageDir, relayerID.Hex()+".json")
+	require.NoError(os.WriteFile(storagePath, []byte(`{"latestProcessedBlock":"1234"}`), 0o600))
+
+	height, found, err := getRelayerLastProcessedHeight(storageDir, sourceBlockchainID, destinationBlockchainID)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(uint64(1234), height)
+
+	_, found, err = getRelayerLastProcessedHeight(storageDir, destinationBlockchainID, sourceBlockchainID)
+	require.NoError(err)
+	require.False(found)
+}
@@ -0,0 +1,388 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package relayer
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/key"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	"github.com/ava-labs/avalanche-cli/sdk/evm"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	supervisorMinBackoff = time.Second
+	supervisorMaxBackoff = time.Minute
+	// a relayer running longer than this is considered healthy, and the backoff is reset
+	supervisorStableRunTime = 5 * time.Minute
+	// time given to the relayer to exit on interrupt, before being killed
+	supervisorStopTimeout = 2 * time.Second
+
+	defaultSupervisorBalanceCheckInterval = time.Minute
+	defaultSupervisorLogMaxSizeMB         = 100
+	defaultSupervisorLogMaxBackups        = 5
+)
+
+// CLI command that runs the supervisor loop for a given supervisor config path
+var supervisorCommand = []string{"interchain", "relayer", "supervise"}
+
+const (
+	SupervisorEventStart         = "start"
+	SupervisorEventExit          = "exit"
+	SupervisorEventRestart       = "restart"
+	SupervisorEventStop          = "stop"
+	SupervisorEventTopUp         = "top-up"
+	SupervisorEventTopUpFailure  = "top-up-failure"
+	SupervisorEventBalanceFailed = "balance-failure"
+)
+
+// SupervisorConfig is the configuration of a supervised local relayer
+type SupervisorConfig struct {
+	BinPath    string `json:"binPath"`
+	ConfigPath string `json:"configPath"`
+	LogPath    string `json:"logPath"`
+	EventsPath string `json:"eventsPath"`
+	// relayer log is rotated when reaching this size, keeping the given amount of rotated files
+	LogMaxSizeMB  int `json:"logMaxSizeMB"`
+	LogMaxBackups int `json:"logMaxBackups"`
+	// relayer balances on destinations are topped up with [FundingKeyPath] when under [BalanceFloor].
+	// no top ups are made if the key is not set
+	FundingKeyPath       string        `json:"fundingKeyPath"`
+	BalanceFloor         *big.Int      `json:"balanceFloor"`
+	BalanceCheckInterval time.Duration `json:"balanceCheckInterval"`
+}
+
+// SupervisorEvent is an entry of the supervisor event log
+type SupervisorEvent struct {
+	Time    time.Time `json:"time"`
+	Kind    string    `json:"kind"`
+	Message string    `json:"message"`
+}
+
+// DeploySupervisedRelayer is like DeployRelayer, but the relayer is run under a supervisor
+// process that restarts it with backoff, rotates its logs, and tops up its balances.
+// The supervisor is saved as the relayer process on [runFilePath], so it is stopped
+// by RelayerCleanup, and its config is saved at [supervisorConfigPath]
+func DeploySupervisedRelayer(
+	version string,
+	binPath string,
+	binDir string,
+	configPath string,
+	logFilePath string,
+	runFilePath string,
+	storageDir string,
+	supervisorConfigPath string,
+	supervisorConfig SupervisorConfig,
+) (string, error) {
+	if supervisorConfig.BalanceFloor != nil && supervisorConfig.BalanceFloor.Cmp(relayerRequiredBalance) > 0 {
+		return "", fmt.Errorf("balance floor can't be greater than the relayer funding amount of %s wei", relayerRequiredBalance)
+	}
+	if err := RelayerCleanup(runFilePath, logFilePath, storageDir); err != nil {
+		return "", err
+	}
+	if binPath == "" {
+		var err error
+		binPath, err = InstallRelayer(binDir, version)
+		if err != nil {
+			return "", err
+		}
+	}
+	supervisorConfig.BinPath = binPath
+	supervisorConfig.ConfigPath = configPath
+	supervisorConfig.LogPath = logFilePath
+	if err := saveSupervisorConfig(supervisorConfigPath, supervisorConfig); err != nil {
+		return "", err
+	}
+	pid, err := executeSupervisor(supervisorConfigPath, configPath, logFilePath)
+	if err != nil {
+		return "", err
+	}
+	return binPath, saveRelayerRunFile(runFilePath, pid)
+}
+
+func executeSupervisor(supervisorConfigPath string, configPath string, logFile string) (int, error) {
+	if err := os.MkdirAll(filepath.Dir(logFile), constants.DefaultPerms755); err != nil {
+		return 0, err
+	}
+	// the supervisor appends into the log, so initialization can be checked on it from the start
+	if err := os.WriteFile(logFile, nil, constants.WriteReadReadPerms); err != nil {
+		return 0, err
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		return 0, err
+	}
+	args := append([]string{}, supervisorCommand...)
+	args = append(args, supervisorConfigPath, "--"+constants.SkipUpdateFlag)
+	cmd := exec.Command(executable, args...)
+	if err := cmd.Start(); err != nil {
+		return 0, err
+	}
+
+	ch := make(chan struct{})
+	go func() {
+		_ = cmd.Wait()
+		ch <- struct{}{}
+	}()
+	time.Sleep(localRelayerSetupTime)
+	select {
+	case <-ch:
+		return 0, fmt.Errorf("relayer supervisor process failed during setup")
+	default:
+	}
+
+	err = waitForRelayerInitialization(
+		configPath,
+		logFile,
+		0,
+		0,
+	)
+
+	return cmd.Process.Pid, err
+}
+
+// RunSupervisor runs the relayer with the supervisor config at [supervisorConfigPath] until an
+// interrupt is received. The relayer is restarted with exponential backoff when it exits,
+// and its balances are periodically checked and topped up. All restarts and top ups
+// are written into the supervisor event log
+func RunSupervisor(supervisorConfigPath string) error {
+	supervisorConfig, err := loadSupervisorConfig(supervisorConfigPath)
+	if err != nil {
+		return err
+	}
+	logWriter := &lumberjack.Logger{
+		Filename:   supervisorConfig.LogPath,
+		MaxSize:    supervisorConfig.LogMaxSizeMB,
+		MaxBackups: supervisorConfig.LogMaxBackups,
+	}
+	defer logWriter.Close()
+	signalCh := make(chan os.Signal, 1)
+	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM)
+	balanceTicker := time.NewTicker(supervisorConfig.BalanceCheckInterval)
+	defer balanceTicker.Stop()
+	supervisor := relayerSupervisor{config: supervisorConfig}
+	supervisor.topUpBalances()
+	backoff := supervisorMinBackoff
+	for restarts := 0; ; restarts++ {
+		cmd := exec.Command(supervisorConfig.BinPath, "--config-file", supervisorConfig.ConfigPath)
+		cmd.Stdout = logWriter
+		cmd.Stderr = logWriter
+		startTime := time.Now()
+		exitCh := make(chan error, 1)
+		if err := cmd.Start(); err != nil {
+			exitCh <- err
+		} else {
+			if restarts == 0 {
+				supervisor.writeEvent(SupervisorEventStart, "relayer started with pid %d", cmd.Process.Pid)
+			} else {
+				supervisor.writeEvent(SupervisorEventRestart, "relayer restarted with pid %d (restart %d)", cmd.Process.Pid, restarts)
+			}
+			go func() {
+				exitCh <- cmd.Wait()
+			}()
+		}
+		running := true
+		for running {
+			select {
+			case <-signalCh:
+				if cmd.Process != nil {
+					stopSupervisedRelayer(cmd.Process, exitCh)
+				}
+				supervisor.writeEvent(SupervisorEventStop, "relayer stopped")
+				return nil
+			case err := <-exitCh:
+				if err == nil {
+					err = fmt.Errorf("exit status 0")
+				}
+				supervisor.writeEvent(SupervisorEventExit, "relayer exited after %s: %s", time.Since(startTime).Round(time.Second), err)
+				running = false
+			case <-balanceTicker.C:
+				supervisor.topUpBalances()
+			}
+		}
+		if time.Since(startTime) > supervisorStableRunTime {
+			backoff = supervisorMinBackoff
+		}
+		backoffTimer := time.NewTimer(backoff)
+		waiting := true
+		for waiting {
+			select {
+			case <-signalCh:
+				backoffTimer.Stop()
+				supervisor.writeEvent(SupervisorEventStop, "supervisor stopped while waiting to restart the relayer")
+				return nil
+			case <-backoffTimer.C:
+				waiting = false
+			case <-balanceTicker.C:
+				supervisor.topUpBalances()
+			}
+		}
+		backoff = min(2*backoff, supervisorMaxBackoff)
+	}
+}
+
+// interrupts the relayer [proc], killing it if it does not exit in time
+func stopSupervisedRelayer(proc *os.Process, exitCh chan error) {
+	_ = proc.Signal(os.Interrupt)
+	select {
+	case <-exitCh:
+	case <-time.After(supervisorStopTimeout):
+		_ = proc.Kill()
+	}
+}
+
+type relayerSupervisor struct {
+	config SupervisorConfig
+}
+
+// appends an event to the supervisor event log. failures are ignored, as there
+// is no one to report them to
+func (s relayerSupervisor) writeEvent(kind string, format string, args ...interface{}) {
+	_ = AppendSupervisorEvent(s.config.EventsPath, SupervisorEvent{
+		Time:    time.Now().UTC(),
+		Kind:    kind,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// checks the relayer balance on all destinations, topping up with the funding key
+// the ones under the configured floor
+func (s relayerSupervisor) topUpBalances() {
+	if s.config.FundingKeyPath == "" || s.config.BalanceFloor == nil {
+		return
+	}
+	fundingKey, err := key.LoadSoft(models.NewLocalNetwork().ID, s.config.FundingKeyPath)
+	if err != nil {
+		s.writeEvent(SupervisorEventTopUpFailure, "failure loading funding key %s: %s", s.config.FundingKeyPath, err)
+		return
+	}
+	relayerConfig, err := LoadRelayerConfig(s.config.ConfigPath)
+	if err != nil {
+		s.writeEvent(SupervisorEventBalanceFailed, "failure loading relayer config: %s", err)
+		return
+	}
+	for _, destination := range relayerConfig.DestinationBlockchains {
+		balance, err := getRelayerDestinationBalance(destination)
+		if err != nil {
+			s.writeEvent(SupervisorEventBalanceFailed, "failure obtaining relayer balance on %s: %s", destination.BlockchainID, err)
+			continue
+		}
+		if balance.Balance.Cmp(s.config.BalanceFloor) >= 0 {
+			continue
+		}
+		if err := FundRelayer(destination.RPCEndpoint.BaseURL, fundingKey.PrivKeyHex(), balance.Address); err != nil {
+			s.writeEvent(SupervisorEventTopUpFailure, "failure topping up %s on %s: %s", balance.Address, destination.BlockchainID, err)
+			continue
+		}
+		newBalance := balance.Balance
+		if client, err := evm.GetClient(destination.RPCEndpoint.BaseURL); err == nil {
+			if b, err := client.GetAddressBalance(balance.Address); err == nil {
+				newBalance = b
+			}
+			client.Close()
+		}
+		s.writeEvent(
+			SupervisorEventTopUp,
+			"topped up %s on %s from %s to %s wei",
+			balance.Address,
+			destination.BlockchainID,
+			balance.Balance,
+			newBalance,
+		)
+	}
+}
+
+// AppendSupervisorEvent appends [event] to the supervisor event log at [eventsPath]
+func AppendSupervisorEvent(eventsPath string, event SupervisorEvent) error {
+	bs, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(eventsPath), constants.DefaultPerms755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(eventsPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, constants.WriteReadReadPerms)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(append(bs, '\n'))
+	return err
+}
+
+// GetSupervisorEvents returns the last [last] events of the supervisor event log at
+// [eventsPath], or all of them if [last] is 0
+func GetSupervisorEvents(eventsPath string, last int) ([]SupervisorEvent, error) {
+	if !utils.FileExists(eventsPath) {
+		return nil, nil
+	}
+	f, err := os.Open(eventsPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	events := []SupervisorEvent{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		event := SupervisorEvent{}
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			return nil, fmt.Errorf("invalid supervisor event log %s: %w", eventsPath, err)
+		}
+		events = append(events, event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if last > 0 && len(events) > last {
+		events = events[len(events)-last:]
+	}
+	return events, nil
+}
+
+func loadSupervisorConfig(supervisorConfigPath string) (SupervisorConfig, error) {
+	supervisorConfig := SupervisorConfig{}
+	bs, err := os.ReadFile(supervisorConfigPath)
+	if err != nil {
+		return supervisorConfig, err
+	}
+	if err := json.Unmarshal(bs, &supervisorConfig); err != nil {
+		return supervisorConfig, err
+	}
+	if supervisorConfig.BalanceCheckInterval == 0 {
+		supervisorConfig.BalanceCheckInterval = defaultSupervisorBalanceCheckInterval
+	}
+	if supervisorConfig.LogMaxSizeMB == 0 {
+		supervisorConfig.LogMaxSizeMB = defaultSupervisorLogMaxSizeMB
+	}
+	if supervisorConfig.LogMaxBackups == 0 {
+		supervisorConfig.LogMaxBackups = defaultSupervisorLogMaxBackups
+	}
+	return supervisorConfig, nil
+}
+
+func saveSupervisorConfig(supervisorConfigPath string, supervisorConfig SupervisorConfig) error {
+	bs, err := json.MarshalIndent(supervisorConfig, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(supervisorConfigPath), constants.DefaultPerms755); err != nil {
+		return err
+	}
+	return os.WriteFile(supervisorConfigPath, bs, constants.WriteReadReadPerms)
+}
@@ -0,0 +1,40 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package relayer
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupervisorEvents(t *testing.T) {
+	require := require.New(t)
+	eventsPath := filepath.Join(t.TempDir(), "events", "events.jsonl")
+
+	events, err := GetSupervisorEvents(eventsPath, 0)
+	require.NoError(err)
+	require.Empty(events)
+
+	for i := 0; i < 5; i++ {
+		require.NoError(AppendSupervisorEvent(eventsPath, SupervisorEvent{
+			Time:    time.Unix(int64(i), 0).UTC(),
+			Kind:    SupervisorEventRestart,
+			Message: fmt.Sprintf("restart %d", i),
+		}))
+	}
+	events, err = GetSupervisorEvents(eventsPath, 0)
+	require.NoError(err)
+	require.Len(events, 5)
+
+	events, err = GetSupervisorEvents(eventsPath, 2)
+	require.NoError(err)
+	require.Len(events, 2)
+	require.Equal("restart 3", events[0].Message)
+	require.Equal("restart 4", events[1].Message)
+	require.Equal(SupervisorEventRestart, events[1].Kind)
+	require.Equal(time.Unix(4, 0).UTC(), events[1].Time)
+}
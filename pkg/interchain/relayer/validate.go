@@ -0,0 +1,149 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package relayer
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanche-cli/sdk/evm"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/icm-services/relayer/config"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	RelayerCheckRPC          = "rpc"
+	RelayerCheckBlockchainID = "blockchain id"
+	RelayerCheckMessenger    = "messenger"
+	RelayerCheckRegistry     = "registry"
+	RelayerCheckRewardKey    = "reward address"
+	RelayerCheckAccountKey   = "account key"
+)
+
+// RelayerConfigCheck is the result of validating one aspect of a source or destination
+// on a relayer config
+type RelayerConfigCheck struct {
+	Role         string
+	BlockchainID string
+	Check        string
+	Passed       bool
+	Details      string
+}
+
+// ValidateRelayerConfig checks every source and destination on [relayerConfig]: the RPC endpoint is
+// reachable, the configured blockchain ID matches the one reported by the chain as obtained
+// by [getBlockchainID], the ICM messenger and registry contracts are deployed, the source reward address
+// is set, and the destination account key is set and has at least [minBalance]
+func ValidateRelayerConfig(
+	relayerConfig *config.Config,
+	getBlockchainID func(rpcURL string) (ids.ID, error),
+	minBalance *big.Int,
+) []RelayerConfigCheck {
+	checks := []RelayerConfigCheck{}
+	for _, source := range relayerConfig.SourceBlockchains {
+		addCheck := func(check string, err error, details string) {
+			checks = append(checks, newRelayerConfigCheck(RelayerConfigSource, source.BlockchainID, check, err, details))
+		}
+		_, err := getBlockchainHeight(source.RPCEndpoint.BaseURL)
+		addCheck(RelayerCheckRPC, err, source.RPCEndpoint.BaseURL)
+		if err != nil {
+			continue
+		}
+		addCheck(RelayerCheckBlockchainID, checkBlockchainID(source.RPCEndpoint.BaseURL, source.BlockchainID, getBlockchainID), source.BlockchainID)
+		client, err := evm.GetClient(source.RPCEndpoint.BaseURL)
+		if err != nil {
+			addCheck(RelayerCheckMessenger, err, "")
+			continue
+		}
+		for _, address := range sortedKeys(source.MessageContracts) {
+			messageContract := source.MessageContracts[address]
+			switch messageContract.MessageFormat {
+			case config.TELEPORTER.String():
+				addCheck(RelayerCheckMessenger, checkContractDeployed(client, address), address)
+				rewardAddress, _ := messageContract.Settings["reward-address"].(string)
+				addCheck(RelayerCheckRewardKey, checkAddress(rewardAddress), rewardAddress)
+			case config.OFF_CHAIN_REGISTRY.String():
+				registryAddress, _ := messageContract.Settings["teleporter-registry-address"].(string)
+				if registryAddress != "" {
+					addCheck(RelayerCheckRegistry, checkContractDeployed(client, registryAddress), registryAddress)
+				}
+			}
+		}
+		client.Close()
+	}
+	for _, destination := range relayerConfig.DestinationBlockchains {
+		addCheck := func(check string, err error, details string) {
+			checks = append(checks, newRelayerConfigCheck(RelayerConfigDestination, destination.BlockchainID, check, err, details))
+		}
+		_, err := getBlockchainHeight(destination.RPCEndpoint.BaseURL)
+		addCheck(RelayerCheckRPC, err, destination.RPCEndpoint.BaseURL)
+		if err != nil {
+			continue
+		}
+		addCheck(RelayerCheckBlockchainID, checkBlockchainID(destination.RPCEndpoint.BaseURL, destination.BlockchainID, getBlockchainID), destination.BlockchainID)
+		balance, err := getRelayerDestinationBalance(destination)
+		if err == nil && balance.Balance.Cmp(minBalance) < 0 {
+			err = fmt.Errorf("balance %s is below required %s", balance.Balance, minBalance)
+		}
+		addCheck(RelayerCheckAccountKey, err, balance.Address)
+	}
+	return checks
+}
+
+func newRelayerConfigCheck(role string, blockchainID string, check string, err error, details string) RelayerConfigCheck {
+	if err != nil {
+		details = err.Error()
+	}
+	return RelayerConfigCheck{
+		Role:         role,
+		BlockchainID: blockchainID,
+		Check:        check,
+		Passed:       err == nil,
+		Details:      details,
+	}
+}
+
+// verifies that the chain at [rpcURL] reports [blockchainID] as its ID
+func checkBlockchainID(
+	rpcURL string,
+	blockchainID string,
+	getBlockchainID func(rpcURL string) (ids.ID, error),
+) error {
+	chainBlockchainID, err := getBlockchainID(rpcURL)
+	if err != nil {
+		return err
+	}
+	if chainBlockchainID.String() != blockchainID {
+		return fmt.Errorf("configured blockchain ID %s differs from chain reported %s", blockchainID, chainBlockchainID)
+	}
+	return nil
+}
+
+func checkContractDeployed(client evm.Client, address string) error {
+	if !common.IsHexAddress(address) {
+		return fmt.Errorf("invalid contract address %q", address)
+	}
+	deployed, err := client.ContractAlreadyDeployed(address)
+	if err != nil {
+		return err
+	}
+	if !deployed {
+		return fmt.Errorf("no contract deployed at %s", address)
+	}
+	return nil
+}
+
+func checkAddress(address string) error {
+	if address == "" {
+		return fmt.Errorf("address not set")
+	}
+	if !common.IsHexAddress(address) {
+		return fmt.Errorf("invalid address %q", address)
+	}
+	if common.HexToAddress(address) == (common.Address{}) {
+		return fmt.Errorf("address is the zero address")
+	}
+	return nil
+}
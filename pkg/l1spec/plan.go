@@ -0,0 +1,354 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package l1spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+type StepKind string
+
+const (
+	StepCreate               StepKind = "create"
+	StepDeploy               StepKind = "deploy"
+	StepInitValidatorManager StepKind = "initValidatorManager"
+	StepDeployICM            StepKind = "deployICM"
+	StepDeployRelayer        StepKind = "deployRelayer"
+	StepAddValidator         StepKind = "addValidator"
+
+	// deploy flag receiving the bootstrap validators file
+	bootstrapFileFlag = "bootstrap-filepath"
+)
+
+// State is the observed state of a spec L1
+type State struct {
+	// blockchain configuration. Nil if it was not created yet
+	Sidecar *models.Sidecar
+	// whether the validator manager was initialized with the bootstrap validators
+	ValidatorManagerInitialized bool
+	// whether a local relayer is running for the spec network
+	RelayerRunning bool
+	// current L1 validators
+	Validators []ids.NodeID
+}
+
+// Step is a CLI command invocation needed to take an L1 to its spec
+type Step struct {
+	Kind        StepKind
+	Description string
+	// command path from the CLI root, e.g. [blockchain deploy]
+	Command []string
+	Args    []string
+	Flags   map[string]string
+	// contents of the files to generate before running the step, keyed by the
+	// name of the flag receiving the file path
+	Files map[string][]byte
+}
+
+// CommandLine returns the step as a CLI command line, with generated files
+// shown as placeholders
+func (s Step) CommandLine() string {
+	parts := append([]string{"avalanche"}, s.Command...)
+	parts = append(parts, s.Args...)
+	flagNames := make([]string, 0, len(s.Flags)+len(s.Files))
+	for name := range s.Flags {
+		flagNames = append(flagNames, name)
+	}
+	for name := range s.Files {
+		flagNames = append(flagNames, name)
+	}
+	sort.Strings(flagNames)
+	for _, name := range flagNames {
+		value, ok := s.Flags[name]
+		switch {
+		case !ok:
+			parts = append(parts, fmt.Sprintf("--%s <generated %s>", name, name))
+		case value == "true":
+			parts = append(parts, "--"+name)
+		default:
+			parts = append(parts, fmt.Sprintf("--%s=%s", name, value))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// Plan returns the steps needed to take an L1 from [state] to [spec], in execution order.
+// Steps depending on the result of previous ones assume those ran successfully, so
+// plans should be recomputed from the observed state after each step
+func Plan(spec Spec, state State) ([]Step, error) {
+	network := spec.NetworkModel()
+	steps := []Step{}
+	var networkData models.NetworkData
+	if state.Sidecar == nil {
+		steps = append(steps, createStep(spec))
+	} else {
+		if err := checkSidecar(spec, *state.Sidecar); err != nil {
+			return nil, err
+		}
+		networkData = state.Sidecar.Networks[network.Name()]
+	}
+	deployed := networkData.BlockchainID != ids.Empty
+	if !deployed {
+		steps = append(steps, deployStep(spec))
+	} else if !state.ValidatorManagerInitialized {
+		// local deploys initialize the validator manager on their own
+		steps = append(steps, initValidatorManagerStep(spec))
+	}
+	if spec.ICM.Enabled && networkData.TeleporterMessengerAddress == "" {
+		steps = append(steps, deployICMStep(spec, state.Sidecar))
+	}
+	if spec.Relayer.Enabled && !state.RelayerRunning {
+		steps = append(steps, deployRelayerStep(spec))
+	}
+	validators := map[ids.NodeID]bool{}
+	for _, nodeID := range state.Validators {
+		validators[nodeID] = true
+	}
+	for _, validator := range spec.Validators {
+		// public deploys register the spec validators as bootstrap validators
+		if !deployed && spec.Network != NetworkLocal {
+			continue
+		}
+		nodeID, err := ids.NodeIDFromString(validator.NodeID)
+		if err != nil {
+			return nil, err
+		}
+		if validators[nodeID] {
+			continue
+		}
+		steps = append(steps, addValidatorStep(spec, validator))
+	}
+	return steps, nil
+}
+
+// checks that the existing blockchain configuration can be taken to [spec]
+func checkSidecar(spec Spec, sc models.Sidecar) error {
+	if sc.VM != spec.VM.Type {
+		return fmt.Errorf("blockchain %s already exists with VM %s, but the spec asks for %s", spec.Name, sc.VM, spec.VM.Type)
+	}
+	if !sc.Sovereign {
+		return fmt.Errorf("blockchain %s already exists as a non sovereign blockchain, but the spec asks for an L1", spec.Name)
+	}
+	if sc.ValidatorManagement != spec.ValidatorManagementType() {
+		return fmt.Errorf(
+			"blockchain %s already exists with validator management %s, but the spec asks for %s",
+			spec.Name,
+			sc.ValidatorManagement,
+			spec.ValidatorManagementType(),
+		)
+	}
+	if spec.ICM.Enabled && !sc.TeleporterReady {
+		return fmt.Errorf("blockchain %s already exists without ICM support, but the spec enables ICM", spec.Name)
+	}
+	return nil
+}
+
+func createStep(spec Spec) Step {
+	flags := map[string]string{}
+	switch spec.VM.Type {
+	case models.SubnetEvm:
+		flags["evm"] = "true"
+		switch {
+		case spec.Genesis.File != "":
+			flags["genesis"] = spec.Genesis.File
+		case spec.Genesis.Template != "":
+			flags["template"] = spec.Genesis.Template
+		default:
+			flags[spec.Genesis.Defaults+"-defaults"] = "true"
+		}
+		if spec.Genesis.ChainID != 0 {
+			flags["evm-chain-id"] = strconv.FormatUint(spec.Genesis.ChainID, 10)
+		}
+		flags["icm"] = strconv.FormatBool(spec.ICM.Enabled)
+	case models.CustomVM:
+		flags["custom"] = "true"
+		flags["genesis"] = spec.Genesis.File
+		if spec.VM.Binary != "" {
+			flags["custom-vm-path"] = spec.VM.Binary
+		} else {
+			flags["custom-vm-repo-url"] = spec.VM.RepoURL
+			flags["custom-vm-branch"] = spec.VM.Branch
+			flags["custom-vm-build-script"] = spec.VM.BuildScript
+		}
+	default:
+		flags["vm-type"] = string(spec.VM.Type)
+		if spec.Genesis.File != "" {
+			flags["genesis"] = spec.Genesis.File
+		}
+	}
+	if spec.Genesis.TokenSymbol != "" {
+		flags["evm-token"] = spec.Genesis.TokenSymbol
+	}
+	if spec.VM.Type != models.CustomVM {
+		if spec.VM.Version == "" || spec.VM.Version == constants.LatestReleaseVersionTag {
+			flags["latest"] = "true"
+		} else {
+			flags["vm-version"] = spec.VM.Version
+		}
+	}
+	flags[spec.ValidatorManager.Type] = "true"
+	if spec.ValidatorManager.Owner != "" {
+		flags["validator-manager-owner"] = spec.ValidatorManager.Owner
+	}
+	if spec.ValidatorManager.ProxyOwner != "" {
+		flags["proxy-contract-owner"] = spec.ValidatorManager.ProxyOwner
+	}
+	if spec.ValidatorManager.Type == ProofOfStake {
+		flags["reward-basis-points"] = strconv.FormatUint(spec.ValidatorManager.RewardBasisPoints, 10)
+	}
+	return Step{
+		Kind:        StepCreate,
+		Description: fmt.Sprintf("Create %s blockchain configuration %s", spec.VM.Type, spec.Name),
+		Command:     []string{"blockchain", "create"},
+		Args:        []string{spec.Name},
+		Flags:       flags,
+	}
+}
+
+func deployStep(spec Spec) Step {
+	flags := networkFlags(spec)
+	flags["skip-icm-deploy"] = "true"
+	flags["skip-relayer"] = "true"
+	step := Step{
+		Kind:    StepDeploy,
+		Command: []string{"blockchain", "deploy"},
+		Args:    []string{spec.Name},
+		Flags:   flags,
+	}
+	if spec.Network == NetworkLocal {
+		if spec.LocalNodes != 0 {
+			flags["num-local-nodes"] = strconv.FormatUint(uint64(spec.LocalNodes), 10)
+		}
+		step.Description = fmt.Sprintf("Deploy %s to the local network, bootstrapped by local machine nodes", spec.Name)
+		return step
+	}
+	// public L1 nodes are run by the user, so the validator manager is initialized
+	// once they track the L1
+	flags["convert-only"] = "true"
+	bootstrapValidators := make([]models.SubnetValidator, 0, len(spec.Validators))
+	for _, validator := range spec.Validators {
+		bootstrapValidator := models.SubnetValidator{
+			NodeID:               validator.NodeID,
+			Weight:               validator.Weight,
+			Balance:              uint64(validator.Balance * float64(units.Avax)),
+			BLSPublicKey:         validator.BLSPublicKey,
+			BLSProofOfPossession: validator.BLSProofOfPossession,
+			ChangeOwnerAddr:      validator.ChangeOwner,
+		}
+		if bootstrapValidator.Weight == 0 {
+			bootstrapValidator.Weight = constants.BootstrapValidatorWeight
+		}
+		if bootstrapValidator.Balance == 0 {
+			bootstrapValidator.Balance = constants.BootstrapValidatorBalanceNanoAVAX
+		}
+		bootstrapValidators = append(bootstrapValidators, bootstrapValidator)
+	}
+	// marshaling a slice of plain structs can not fail
+	bootstrapValidatorsBytes, _ := json.MarshalIndent(bootstrapValidators, "", "  ")
+	step.Files = map[string][]byte{bootstrapFileFlag: bootstrapValidatorsBytes}
+	step.Description = fmt.Sprintf("Deploy %s to %s and convert it into an L1 with %d bootstrap validators", spec.Name, spec.Network, len(bootstrapValidators))
+	return step
+}
+
+func initValidatorManagerStep(spec Spec) Step {
+	flags := map[string]string{}
+	flags[spec.Network] = "true"
+	if spec.Network == NetworkLocal {
+		flags["genesis-key"] = "true"
+	} else if spec.Key != "" {
+		flags["key"] = spec.Key
+	}
+	return Step{
+		Kind:        StepInitValidatorManager,
+		Description: fmt.Sprintf("Initialize the %s validator manager of %s with its bootstrap validators", spec.ValidatorManagementType(), spec.Name),
+		Command:     []string{"contract", "initValidatorManager"},
+		Args:        []string{spec.Name},
+		Flags:       flags,
+	}
+}
+
+func deployICMStep(spec Spec, sc *models.Sidecar) Step {
+	keyName := constants.ICMKeyName
+	if sc != nil && sc.TeleporterKey != "" {
+		keyName = sc.TeleporterKey
+	}
+	return Step{
+		Kind:        StepDeployICM,
+		Description: fmt.Sprintf("Deploy ICM Messenger and Registry into %s", spec.Name),
+		Command:     []string{"interchain", "messenger", "deploy"},
+		Flags: map[string]string{
+			spec.Network: "true",
+			"blockchain": spec.Name,
+			"key":        keyName,
+		},
+	}
+}
+
+func deployRelayerStep(spec Spec) Step {
+	flags := map[string]string{
+		spec.Network:  "true",
+		"blockchains": spec.Name,
+		"cchain":      "true",
+	}
+	if spec.Network == NetworkLocal {
+		amount := strconv.FormatFloat(constants.DefaultRelayerAmount, 'f', -1, 64)
+		flags["key"] = constants.ICMRelayerKeyName
+		flags["amount"] = amount
+		flags["blockchain-funding-key"] = constants.ICMKeyName
+		flags["cchain-funding-key"] = "ewoq"
+		flags["cchain-amount"] = amount
+	}
+	return Step{
+		Kind:        StepDeployRelayer,
+		Description: fmt.Sprintf("Deploy a local ICM relayer for %s and C-Chain", spec.Name),
+		Command:     []string{"interchain", "relayer", "deploy"},
+		Flags:       flags,
+	}
+}
+
+func addValidatorStep(spec Spec, validator ValidatorSpec) Step {
+	flags := networkFlags(spec)
+	flags["node-id"] = validator.NodeID
+	flags["bls-public-key"] = validator.BLSPublicKey
+	flags["bls-proof-of-possession"] = validator.BLSProofOfPossession
+	if validator.Weight != 0 {
+		flags["weight"] = strconv.FormatUint(validator.Weight, 10)
+	}
+	balance := validator.Balance
+	if balance == 0 {
+		balance = constants.BootstrapValidatorBalanceAVAX
+	}
+	flags["balance"] = strconv.FormatFloat(balance, 'f', -1, 64)
+	if validator.ChangeOwner != "" {
+		flags["remaining-balance-owner"] = validator.ChangeOwner
+		flags["disable-owner"] = validator.ChangeOwner
+	}
+	return Step{
+		Kind:        StepAddValidator,
+		Description: fmt.Sprintf("Add validator %s to %s", validator.NodeID, spec.Name),
+		Command:     []string{"blockchain", "addValidator"},
+		Args:        []string{spec.Name},
+		Flags:       flags,
+	}
+}
+
+// returns the network and fee paying key flags shared by the P-Chain commands
+func networkFlags(spec Spec) map[string]string {
+	flags := map[string]string{spec.Network: "true"}
+	switch {
+	case spec.Network == NetworkMainnet:
+		flags["ledger"] = "true"
+	case spec.Network == NetworkFuji && spec.Key != "":
+		flags["key"] = spec.Key
+	}
+	return flags
+}
@@ -0,0 +1,248 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package l1spec
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/sdk/validatormanager/validatormanagertypes"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	NetworkLocal   = "local"
+	NetworkFuji    = "fuji"
+	NetworkMainnet = "mainnet"
+
+	ProofOfAuthority = "proof-of-authority"
+	ProofOfStake     = "proof-of-stake"
+
+	defaultRewardBasisPoints = 100
+)
+
+// Spec is the desired state of an L1, as described on an L1 spec file
+type Spec struct {
+	// blockchain name
+	Name string `yaml:"name"`
+	// one of local, fuji or mainnet
+	Network string `yaml:"network"`
+	// CLI stored key used to pay for fuji transactions. Local networks use the ewoq key,
+	// and mainnet uses ledger
+	Key              string               `yaml:"key,omitempty"`
+	VM               VMSpec               `yaml:"vm"`
+	Genesis          GenesisSpec          `yaml:"genesis,omitempty"`
+	ValidatorManager ValidatorManagerSpec `yaml:"validatorManager,omitempty"`
+	// number of local machine nodes bootstrapping the L1. Local network only
+	LocalNodes uint32 `yaml:"localNodes,omitempty"`
+	// L1 validators. On public networks, the validators present at deploy time are
+	// the L1 bootstrap validators, and the ones added later are registered through the
+	// validator manager. On local networks, the bootstrap validators are local machine
+	// nodes, and all the given validators are registered after deploy
+	Validators []ValidatorSpec `yaml:"validators,omitempty"`
+	ICM        FeatureSpec     `yaml:"icm,omitempty"`
+	Relayer    FeatureSpec     `yaml:"relayer,omitempty"`
+}
+
+type VMSpec struct {
+	// Subnet-EVM, Custom, or the name of a VM descriptor manifest. Defaults to Subnet-EVM
+	Type models.VMType `yaml:"type,omitempty"`
+	// VM release to use. Defaults to the latest one
+	Version string `yaml:"version,omitempty"`
+	// custom VM binary path
+	Binary string `yaml:"binary,omitempty"`
+	// custom VM source code settings
+	RepoURL     string `yaml:"repoURL,omitempty"`
+	Branch      string `yaml:"branch,omitempty"`
+	BuildScript string `yaml:"buildScript,omitempty"`
+}
+
+type GenesisSpec struct {
+	// genesis file path. If empty, Subnet-EVM genesis is generated from Template, or from
+	// the Defaults settings, and other VMs genesis is prompted for
+	File string `yaml:"file,omitempty"`
+	// Subnet-EVM genesis template
+	Template string `yaml:"template,omitempty"`
+	// Subnet-EVM default settings, one of test or production. Defaults to test
+	Defaults    string `yaml:"defaults,omitempty"`
+	ChainID     uint64 `yaml:"chainID,omitempty"`
+	TokenSymbol string `yaml:"tokenSymbol,omitempty"`
+}
+
+type ValidatorManagerSpec struct {
+	// one of proof-of-authority or proof-of-stake. Defaults to proof-of-authority
+	Type string `yaml:"type,omitempty"`
+	// EVM address of the validator manager owner
+	Owner string `yaml:"owner,omitempty"`
+	// EVM address of the validator manager proxy admin owner
+	ProxyOwner string `yaml:"proxyOwner,omitempty"`
+	// PoS only. Defaults to 100
+	RewardBasisPoints uint64 `yaml:"rewardBasisPoints,omitempty"`
+}
+
+type ValidatorSpec struct {
+	NodeID               string `yaml:"nodeID"`
+	BLSPublicKey         string `yaml:"blsPublicKey"`
+	BLSProofOfPossession string `yaml:"blsProofOfPossession"`
+	// if zero, the command defaults are used
+	Weight uint64 `yaml:"weight,omitempty"`
+	// AVAX balance for the P-Chain continuous fee. If zero, the command defaults are used
+	Balance float64 `yaml:"balance,omitempty"`
+	// P-Chain address receiving the leftover balance once the validator is removed
+	ChangeOwner string `yaml:"changeOwner,omitempty"`
+}
+
+type FeatureSpec struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+// LoadSpec reads and validates the L1 spec file at [specPath]. Relative file
+// paths on the spec are resolved against the spec file dir
+func LoadSpec(specPath string) (Spec, error) {
+	specBytes, err := os.ReadFile(specPath)
+	if err != nil {
+		return Spec{}, err
+	}
+	spec, err := ParseSpec(specBytes)
+	if err != nil {
+		return Spec{}, fmt.Errorf("invalid L1 spec %s: %w", specPath, err)
+	}
+	specDir := filepath.Dir(specPath)
+	for _, path := range []*string{&spec.Genesis.File, &spec.VM.Binary} {
+		if *path != "" && !filepath.IsAbs(*path) {
+			*path = filepath.Join(specDir, *path)
+		}
+	}
+	return spec, nil
+}
+
+// ParseSpec parses [specBytes], filling defaults and validating the result
+func ParseSpec(specBytes []byte) (Spec, error) {
+	var spec Spec
+	if err := yaml.Unmarshal(specBytes, &spec); err != nil {
+		return Spec{}, err
+	}
+	if spec.VM.Type == "" {
+		spec.VM.Type = models.SubnetEvm
+	}
+	if spec.ValidatorManager.Type == "" {
+		spec.ValidatorManager.Type = ProofOfAuthority
+	}
+	if spec.ValidatorManager.Type == ProofOfStake && spec.ValidatorManager.RewardBasisPoints == 0 {
+		spec.ValidatorManager.RewardBasisPoints = defaultRewardBasisPoints
+	}
+	if spec.Genesis.Defaults == "" {
+		spec.Genesis.Defaults = "test"
+	}
+	if err := spec.Validate(); err != nil {
+		return Spec{}, err
+	}
+	return spec, nil
+}
+
+// Validate checks that the spec settings are consistent
+func (s Spec) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("missing blockchain name")
+	}
+	switch s.Network {
+	case NetworkLocal, NetworkFuji, NetworkMainnet:
+	default:
+		return fmt.Errorf("invalid network %q: expected one of %s, %s or %s", s.Network, NetworkLocal, NetworkFuji, NetworkMainnet)
+	}
+	if s.Network != NetworkLocal && s.LocalNodes != 0 {
+		return fmt.Errorf("localNodes is only applicable to the %s network", NetworkLocal)
+	}
+	if s.Network != NetworkLocal && len(s.Validators) == 0 {
+		return fmt.Errorf("at least one validator is required on %s", s.Network)
+	}
+	switch s.VM.Type {
+	case models.CustomVM:
+		if s.Genesis.File == "" {
+			return fmt.Errorf("genesis file is required for %s VMs", models.CustomVM)
+		}
+		if (s.VM.Binary == "") == (s.VM.RepoURL == "") {
+			return fmt.Errorf("exactly one of VM binary or VM repoURL is required for %s VMs", models.CustomVM)
+		}
+	default:
+		if s.VM.Binary != "" || s.VM.RepoURL != "" || s.VM.Branch != "" || s.VM.BuildScript != "" {
+			return fmt.Errorf("VM binary and source code settings are only applicable to %s VMs", models.CustomVM)
+		}
+	}
+	if s.VM.Type != models.SubnetEvm && (s.Genesis.Template != "" || s.Genesis.ChainID != 0) {
+		return fmt.Errorf("genesis template and chain ID are only applicable to %s VMs", models.SubnetEvm)
+	}
+	if s.Genesis.File != "" && s.Genesis.Template != "" {
+		return fmt.Errorf("genesis file and template are mutually exclusive")
+	}
+	switch s.Genesis.Defaults {
+	case "test", "production":
+	default:
+		return fmt.Errorf("invalid genesis defaults %q: expected one of test or production", s.Genesis.Defaults)
+	}
+	switch s.ValidatorManager.Type {
+	case ProofOfAuthority:
+		if s.ValidatorManager.RewardBasisPoints != 0 {
+			return fmt.Errorf("rewardBasisPoints is only applicable to %s validator managers", ProofOfStake)
+		}
+	case ProofOfStake:
+	default:
+		return fmt.Errorf("invalid validator manager type %q: expected one of %s or %s", s.ValidatorManager.Type, ProofOfAuthority, ProofOfStake)
+	}
+	for _, addr := range []string{s.ValidatorManager.Owner, s.ValidatorManager.ProxyOwner} {
+		if addr != "" && !common.IsHexAddress(addr) {
+			return fmt.Errorf("invalid EVM address %q", addr)
+		}
+	}
+	nodeIDs := map[ids.NodeID]bool{}
+	for _, validator := range s.Validators {
+		nodeID, err := ids.NodeIDFromString(validator.NodeID)
+		if err != nil {
+			return fmt.Errorf("invalid validator node ID %q: %w", validator.NodeID, err)
+		}
+		if nodeIDs[nodeID] {
+			return fmt.Errorf("duplicated validator %s", nodeID)
+		}
+		nodeIDs[nodeID] = true
+		if validator.BLSPublicKey == "" || validator.BLSProofOfPossession == "" {
+			return fmt.Errorf("missing BLS public key or proof of possession for validator %s", nodeID)
+		}
+		if validator.Balance < 0 {
+			return fmt.Errorf("invalid negative balance for validator %s", nodeID)
+		}
+	}
+	if s.ICM.Enabled && s.VM.Type != models.SubnetEvm && s.VM.Type != models.CustomVM {
+		return fmt.Errorf("ICM is only supported on EVM based VMs")
+	}
+	if s.Relayer.Enabled && !s.ICM.Enabled {
+		return fmt.Errorf("relayer requires ICM to be enabled")
+	}
+	if s.Relayer.Enabled && s.Network == NetworkMainnet {
+		return fmt.Errorf("relayer deploy is not supported on %s", NetworkMainnet)
+	}
+	return nil
+}
+
+// NetworkModel returns the network the spec L1 lives on
+func (s Spec) NetworkModel() models.Network {
+	switch s.Network {
+	case NetworkFuji:
+		return models.NewFujiNetwork()
+	case NetworkMainnet:
+		return models.NewMainnetNetwork()
+	default:
+		return models.NewLocalNetwork()
+	}
+}
+
+// ValidatorManagementType returns the spec validator manager type, as recorded on sidecars
+func (s Spec) ValidatorManagementType() validatormanagertypes.ValidatorManagementType {
+	if s.ValidatorManager.Type == ProofOfStake {
+		return validatormanagertypes.ProofOfStake
+	}
+	return validatormanagertypes.ProofOfAuthority
+}
@@ -0,0 +1,215 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package l1spec
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/sdk/validatormanager/validatormanagertypes"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testNodeID1 = "NodeID-7Xhw2mDxuDS44j42TCB6U5579esbSt3Lg"
+	testNodeID2 = "NodeID-MFrZFVCXPv5iCn6M9K6XduxGTYp891xXZ"
+)
+
+const testSpec = `
+name: testl1
+network: fuji
+key: testkey
+genesis:
+  chainID: 12345
+  tokenSymbol: TST
+validatorManager:
+  owner: "0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC"
+validators:
+  - nodeID: ` + testNodeID1 + `
+    blsPublicKey: "0x01"
+    blsProofOfPossession: "0x02"
+  - nodeID: ` + testNodeID2 + `
+    blsPublicKey: "0x03"
+    blsProofOfPossession: "0x04"
+    weight: 20
+    balance: 1.5
+icm:
+  enabled: true
+relayer:
+  enabled: true
+`
+
+func TestParseSpec(t *testing.T) {
+	spec, err := ParseSpec([]byte(testSpec))
+	require.NoError(t, err)
+	require.Equal(t, models.VMType(models.SubnetEvm), spec.VM.Type)
+	require.Equal(t, ProofOfAuthority, spec.ValidatorManager.Type)
+	require.Equal(t, "test", spec.Genesis.Defaults)
+	require.Equal(t, models.NewFujiNetwork(), spec.NetworkModel())
+	require.Len(t, spec.Validators, 2)
+
+	for _, tc := range []struct {
+		old         string
+		new         string
+		expectedErr string
+	}{
+		{old: "name: testl1", new: "name: ''", expectedErr: "missing blockchain name"},
+		{old: "network: fuji", new: "network: devnet", expectedErr: "invalid network"},
+		{old: "network: fuji", new: "network: fuji\nlocalNodes: 2", expectedErr: "localNodes is only applicable"},
+		{old: "genesis:", new: "vm:\n  type: Custom\ngenesis:", expectedErr: "genesis file is required"},
+		{old: "genesis:", new: "vm:\n  binary: ./vm\ngenesis:", expectedErr: "only applicable to Custom VMs"},
+		{old: "validatorManager:", new: "validatorManager:\n  type: proof-of-nothing", expectedErr: "invalid validator manager type"},
+		{old: "validatorManager:", new: "validatorManager:\n  rewardBasisPoints: 5", expectedErr: "rewardBasisPoints is only applicable"},
+		{old: `"0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC"`, new: "0x1234", expectedErr: "invalid EVM address"},
+		{old: testNodeID2, new: testNodeID1, expectedErr: "duplicated validator"},
+		{old: testNodeID2, new: "NodeID-invalid", expectedErr: "invalid validator node ID"},
+		{old: `blsPublicKey: "0x03"`, new: `blsPublicKey: ""`, expectedErr: "missing BLS public key"},
+		{old: "icm:\n  enabled: true", new: "icm:\n  enabled: false", expectedErr: "relayer requires ICM"},
+		{old: "network: fuji", new: "network: mainnet", expectedErr: "relayer deploy is not supported"},
+	} {
+		_, err := ParseSpec([]byte(strings.Replace(testSpec, tc.old, tc.new, 1)))
+		require.ErrorContains(t, err, tc.expectedErr)
+	}
+}
+
+func TestLoadSpecResolvesPaths(t *testing.T) {
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "l1.yaml")
+	require.NoError(t, os.WriteFile(specPath, []byte(strings.Replace(testSpec, "genesis:", "genesis:\n  file: genesis.json", 1)), constants.WriteReadReadPerms))
+	spec, err := LoadSpec(specPath)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "genesis.json"), spec.Genesis.File)
+}
+
+func stepKinds(steps []Step) []StepKind {
+	kinds := []StepKind{}
+	for _, step := range steps {
+		kinds = append(kinds, step.Kind)
+	}
+	return kinds
+}
+
+func TestPlan(t *testing.T) {
+	spec, err := ParseSpec([]byte(testSpec))
+	require.NoError(t, err)
+
+	// nothing exists yet: public deploys take the spec validators as bootstrap validators
+	steps, err := Plan(spec, State{})
+	require.NoError(t, err)
+	require.Equal(t, []StepKind{StepCreate, StepDeploy, StepDeployICM, StepDeployRelayer}, stepKinds(steps))
+	require.Equal(
+		t,
+		"avalanche blockchain create testl1 --evm --evm-chain-id=12345 --evm-token=TST --icm --latest "+
+			"--proof-of-authority --test-defaults --validator-manager-owner=0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC",
+		steps[0].CommandLine(),
+	)
+	require.Equal(
+		t,
+		"avalanche blockchain deploy testl1 --bootstrap-filepath <generated bootstrap-filepath> --convert-only --fuji "+
+			"--key=testkey --skip-icm-deploy --skip-relayer",
+		steps[1].CommandLine(),
+	)
+	var bootstrapValidators []models.SubnetValidator
+	require.NoError(t, json.Unmarshal(steps[1].Files[bootstrapFileFlag], &bootstrapValidators))
+	require.Equal(t, []models.SubnetValidator{
+		{
+			NodeID:               testNodeID1,
+			Weight:               constants.BootstrapValidatorWeight,
+			Balance:              constants.BootstrapValidatorBalanceNanoAVAX,
+			BLSPublicKey:         "0x01",
+			BLSProofOfPossession: "0x02",
+		},
+		{
+			NodeID:               testNodeID2,
+			Weight:               20,
+			Balance:              1_500_000_000,
+			BLSPublicKey:         "0x03",
+			BLSProofOfPossession: "0x04",
+		},
+	}, bootstrapValidators)
+
+	// created and converted, with nodes not tracking the L1 yet
+	sc := &models.Sidecar{
+		Name:                spec.Name,
+		VM:                  models.SubnetEvm,
+		Sovereign:           true,
+		TeleporterReady:     true,
+		ValidatorManagement: validatormanagertypes.ProofOfAuthority,
+		Networks: map[string]models.NetworkData{
+			models.NewFujiNetwork().Name(): {
+				SubnetID:     ids.GenerateTestID(),
+				BlockchainID: ids.GenerateTestID(),
+			},
+		},
+	}
+	nodeID1, err := ids.NodeIDFromString(testNodeID1)
+	require.NoError(t, err)
+	nodeID2, err := ids.NodeIDFromString(testNodeID2)
+	require.NoError(t, err)
+	state := State{Sidecar: sc, Validators: []ids.NodeID{nodeID1, nodeID2}}
+	steps, err = Plan(spec, state)
+	require.NoError(t, err)
+	require.Equal(t, []StepKind{StepInitValidatorManager, StepDeployICM, StepDeployRelayer}, stepKinds(steps))
+
+	// everything in place but a validator added to the spec afterwards
+	networkData := sc.Networks[models.NewFujiNetwork().Name()]
+	networkData.TeleporterMessengerAddress = "0x253b2784c75e510dD0fF1da844684a1aC0aa5fcf"
+	sc.Networks[models.NewFujiNetwork().Name()] = networkData
+	state = State{Sidecar: sc, ValidatorManagerInitialized: true, RelayerRunning: true, Validators: []ids.NodeID{nodeID1}}
+	steps, err = Plan(spec, state)
+	require.NoError(t, err)
+	require.Equal(t, []StepKind{StepAddValidator}, stepKinds(steps))
+	require.Equal(
+		t,
+		"avalanche blockchain addValidator testl1 --balance=1.5 --bls-proof-of-possession=0x04 --bls-public-key=0x03 "+
+			"--fuji --key=testkey --node-id="+testNodeID2+" --weight=20",
+		steps[0].CommandLine(),
+	)
+
+	// up to date
+	state.Validators = append(state.Validators, nodeID2)
+	steps, err = Plan(spec, state)
+	require.NoError(t, err)
+	require.Empty(t, steps)
+
+	// drift that apply can not fix
+	sc.ValidatorManagement = validatormanagertypes.ProofOfStake
+	_, err = Plan(spec, state)
+	require.ErrorContains(t, err, "already exists with validator management")
+	sc.ValidatorManagement = validatormanagertypes.ProofOfAuthority
+	sc.VM = models.CustomVM
+	_, err = Plan(spec, state)
+	require.ErrorContains(t, err, "already exists with VM Custom")
+}
+
+func TestPlanLocal(t *testing.T) {
+	spec, err := ParseSpec([]byte(strings.Replace(testSpec, "network: fuji", "network: local\nlocalNodes: 2", 1)))
+	require.NoError(t, err)
+
+	// local deploys are bootstrapped by local machine nodes, so the spec validators are added afterwards
+	steps, err := Plan(spec, State{})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]StepKind{StepCreate, StepDeploy, StepDeployICM, StepDeployRelayer, StepAddValidator, StepAddValidator},
+		stepKinds(steps),
+	)
+	require.Equal(
+		t,
+		"avalanche blockchain deploy testl1 --local --num-local-nodes=2 --skip-icm-deploy --skip-relayer",
+		steps[1].CommandLine(),
+	)
+	require.Empty(t, steps[1].Files)
+	require.Equal(
+		t,
+		"avalanche interchain relayer deploy --amount=10 --blockchain-funding-key=cli-teleporter-deployer --blockchains=testl1 "+
+			"--cchain --cchain-amount=10 --cchain-funding-key=ewoq --key=cli-awm-relayer --local",
+		steps[3].CommandLine(),
+	)
+}
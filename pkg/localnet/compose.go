@@ -0,0 +1,264 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package localnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/docker"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	sdkutils "github.com/ava-labs/avalanche-cli/sdk/utils"
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/tests/fixture/tmpnet"
+
+	dircopy "github.com/otiai10/copy"
+)
+
+const (
+	// private subnet used by the exported compose project. nodes get fixed IPs on it
+	composeSubnetPrefix = "172.28.0."
+	composeSubnet       = composeSubnetPrefix + "0/24"
+	composeFirstNodeIP  = 10
+
+	composeFileName       = "docker-compose.yml"
+	composePluginsDir     = "plugins"
+	composeRelayerDir     = "icm-relayer"
+	composeNodeDataDir    = "/.avalanchego"
+	composeRelayerDataDir = constants.ICMRelayerDockerDir
+)
+
+var avalancheGoBinaryVersionRegex = regexp.MustCompile(`avalanchego-(v\d+\.\d+\.\d+)`)
+
+// ComposeExportInfo summarizes the result of a local network compose export
+type ComposeExportInfo struct {
+	ComposePath        string
+	AvalancheGoVersion string
+	Nodes              []docker.LocalNetworkComposeNode
+	WithICMRelayer     bool
+}
+
+// TmpNetExportCompose writes at [exportDir] a standalone docker-compose project that runs the
+// stopped tmpnet at [networkDir]. Node databases, staking keys, genesis, chain and subnet configs,
+// VM plugins and the relayer config are all included, so the project reproduces the same
+// network ID and blockchain IDs.
+// If [avalancheGoVersion] is empty, it is obtained from the avalanchego binary used by the network.
+func TmpNetExportCompose(
+	networkDir string,
+	exportDir string,
+	avalancheGoVersion string,
+	relayerVersion string,
+) (ComposeExportInfo, error) {
+	info := ComposeExportInfo{}
+	network, err := GetTmpNetNetwork(networkDir)
+	if err != nil {
+		return info, err
+	}
+	if len(network.Nodes) == 0 {
+		return info, fmt.Errorf("network at %s has no nodes", networkDir)
+	}
+	if entries, err := os.ReadDir(exportDir); err == nil && len(entries) > 0 {
+		return info, fmt.Errorf("export dir %s is not empty", exportDir)
+	}
+	if err := os.MkdirAll(exportDir, constants.DefaultPerms755); err != nil {
+		return info, err
+	}
+	if avalancheGoVersion == "" {
+		avalancheGoVersion = constants.DefaultAvalancheGoVersion
+		if matches := avalancheGoBinaryVersionRegex.FindStringSubmatch(network.DefaultRuntimeConfig.AvalancheGoPath); len(matches) == 2 {
+			avalancheGoVersion = matches[1]
+		}
+	}
+	pluginDir, err := network.DefaultFlags.GetStringVal(config.PluginDirKey)
+	if err != nil {
+		return info, err
+	}
+	exportPluginDir := filepath.Join(exportDir, composePluginsDir)
+	if pluginDir != "" && sdkutils.DirExists(pluginDir) {
+		if err := dircopy.Copy(pluginDir, exportPluginDir); err != nil {
+			return info, fmt.Errorf("failure copying VM plugins from %s: %w", pluginDir, err)
+		}
+	} else if err := os.MkdirAll(exportPluginDir, constants.DefaultPerms755); err != nil {
+		return info, err
+	}
+	composeNodes := []docker.LocalNetworkComposeNode{}
+	// maps node http endpoints on the host, to node http endpoints on the compose network
+	endpoints := map[string]string{}
+	bootstrapIPs := []string{}
+	bootstrapIDs := []string{}
+	for i, node := range network.Nodes {
+		composeNode := docker.LocalNetworkComposeNode{
+			Name: fmt.Sprintf("node%d", i+1),
+			IP:   fmt.Sprintf("%s%d", composeSubnetPrefix, composeFirstNodeIP+i),
+		}
+		composeNode.HTTPPort, err = getTmpNetNodePort(node, config.HTTPPortKey, uint16(constants.AvalancheGoAPIPort+2*i))
+		if err != nil {
+			return info, err
+		}
+		composeNode.StakingPort, err = getTmpNetNodePort(node, config.StakingPortKey, uint16(constants.AvalancheGoP2PPort+2*i))
+		if err != nil {
+			return info, err
+		}
+		if i > 0 {
+			composeNode.DependsOn = composeNodes[i-1].Name
+		}
+		if err := exportComposeNode(
+			network,
+			node,
+			composeNode,
+			filepath.Join(exportDir, composeNode.Name),
+			bootstrapIPs,
+			bootstrapIDs,
+		); err != nil {
+			return info, fmt.Errorf("failure exporting node %s: %w", node.NodeID, err)
+		}
+		for _, host := range []string{"127.0.0.1", "localhost", "[::]"} {
+			endpoints[fmt.Sprintf("http://%s:%d", host, composeNode.HTTPPort)] = fmt.Sprintf("http://%s:%d", composeNode.IP, composeNode.HTTPPort)
+			endpoints[fmt.Sprintf("ws://%s:%d", host, composeNode.HTTPPort)] = fmt.Sprintf("ws://%s:%d", composeNode.IP, composeNode.HTTPPort)
+		}
+		bootstrapIPs = append(bootstrapIPs, fmt.Sprintf("%s:%d", composeNode.IP, composeNode.StakingPort))
+		bootstrapIDs = append(bootstrapIDs, node.NodeID.String())
+		composeNodes = append(composeNodes, composeNode)
+	}
+	relayerConfigPath := filepath.Join(networkDir, constants.ICMRelayerConfigFilename)
+	withICMRelayer := utils.FileExists(relayerConfigPath)
+	if withICMRelayer {
+		if err := exportComposeRelayerConfig(
+			relayerConfigPath,
+			filepath.Join(exportDir, composeRelayerDir, constants.ICMRelayerConfigFilename),
+			endpoints,
+		); err != nil {
+			return info, err
+		}
+	}
+	composeBytes, err := docker.RenderLocalNetworkComposeFile(docker.DockerComposeInputs{
+		AvalanchegoVersion: avalancheGoVersion,
+		ICMRelayerVersion:  relayerVersion,
+		LocalNetworkNodes:  composeNodes,
+		LocalNetworkSubnet: composeSubnet,
+		WithICMRelayer:     withICMRelayer,
+	})
+	if err != nil {
+		return info, err
+	}
+	composePath := filepath.Join(exportDir, composeFileName)
+	if err := os.WriteFile(composePath, composeBytes, constants.WriteReadReadPerms); err != nil {
+		return info, err
+	}
+	return ComposeExportInfo{
+		ComposePath:        composePath,
+		AvalancheGoVersion: avalancheGoVersion,
+		Nodes:              composeNodes,
+		WithICMRelayer:     withICMRelayer,
+	}, nil
+}
+
+// copies the data dir of [node] into [nodeExportDir], and writes a node config file
+// with all paths and networking settings adapted to the compose project
+func exportComposeNode(
+	network *tmpnet.Network,
+	node *tmpnet.Node,
+	composeNode docker.LocalNetworkComposeNode,
+	nodeExportDir string,
+	bootstrapIPs []string,
+	bootstrapIDs []string,
+) error {
+	nodeDir := filepath.Join(network.Dir, node.NodeID.String())
+	if err := dircopy.Copy(nodeDir, nodeExportDir, dircopy.Options{
+		Skip: func(_ os.FileInfo, src string, _ string) (bool, error) {
+			// logs and runtime files are not needed by the exported node
+			rel, err := filepath.Rel(nodeDir, src)
+			if err != nil {
+				return false, err
+			}
+			return rel == "logs" || rel == "process.json", nil
+		},
+	}); err != nil {
+		return err
+	}
+	configsDir := filepath.Join(nodeExportDir, "configs")
+	if err := os.MkdirAll(filepath.Join(configsDir, "chains"), constants.DefaultPerms755); err != nil {
+		return err
+	}
+	flags := tmpnet.FlagsMap{}
+	for k, v := range node.Flags {
+		flags[k] = v
+	}
+	flags.SetDefaults(network.DefaultFlags)
+	flags[config.DataDirKey] = composeNodeDataDir
+	flags[config.ChainConfigDirKey] = filepath.Join(composeNodeDataDir, "configs", "chains")
+	flags[config.PluginDirKey] = filepath.Join(composeNodeDataDir, composePluginsDir)
+	flags[config.PublicIPKey] = composeNode.IP
+	flags[config.HTTPHostKey] = "0.0.0.0"
+	flags[config.HTTPAllowedHostsKey] = "*"
+	flags[config.HTTPPortKey] = composeNode.HTTPPort
+	flags[config.StakingPortKey] = composeNode.StakingPort
+	flags[config.BootstrapIPsKey] = strings.Join(bootstrapIPs, ",")
+	flags[config.BootstrapIDsKey] = strings.Join(bootstrapIDs, ",")
+	delete(flags, config.StakingHostKey)
+	genesisPath := filepath.Join(network.Dir, "genesis.json")
+	if utils.FileExists(genesisPath) {
+		if err := utils.FileCopy(genesisPath, filepath.Join(configsDir, "genesis.json")); err != nil {
+			return err
+		}
+		flags[config.GenesisFileKey] = filepath.Join(composeNodeDataDir, "configs", "genesis.json")
+	}
+	subnetDir := network.GetSubnetDir()
+	if sdkutils.DirExists(subnetDir) {
+		if err := dircopy.Copy(subnetDir, filepath.Join(configsDir, "subnets")); err != nil {
+			return err
+		}
+		flags[config.SubnetConfigDirKey] = filepath.Join(composeNodeDataDir, "configs", "subnets")
+	} else {
+		delete(flags, config.SubnetConfigDirKey)
+	}
+	return utils.WriteJSON(filepath.Join(configsDir, "node.json"), map[string]interface{}(flags))
+}
+
+// writes at [exportPath] the relayer config at [relayerConfigPath], with node endpoints
+// translated by [endpoints] and storage moved into the relayer container
+func exportComposeRelayerConfig(
+	relayerConfigPath string,
+	exportPath string,
+	endpoints map[string]string,
+) error {
+	bs, err := os.ReadFile(relayerConfigPath)
+	if err != nil {
+		return err
+	}
+	relayerConfig := string(bs)
+	for hostEndpoint, composeEndpoint := range endpoints {
+		relayerConfig = strings.ReplaceAll(relayerConfig, hostEndpoint, composeEndpoint)
+	}
+	relayerConfigMap := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(relayerConfig), &relayerConfigMap); err != nil {
+		return fmt.Errorf("failed unmarshalling relayer config %s: %w", relayerConfigPath, err)
+	}
+	relayerConfigMap["storage-location"] = filepath.Join(composeRelayerDataDir, constants.ICMRelayerStorageDir)
+	if err := os.MkdirAll(filepath.Dir(exportPath), constants.DefaultPerms755); err != nil {
+		return err
+	}
+	return utils.WriteJSON(exportPath, relayerConfigMap)
+}
+
+// returns the port persisted for [node] on flag [key], or [defaultPort] if not set
+func getTmpNetNodePort(node *tmpnet.Node, key string, defaultPort uint16) (uint16, error) {
+	value, ok := node.Flags[key]
+	if !ok {
+		return defaultPort, nil
+	}
+	port, err := strconv.ParseUint(fmt.Sprint(value), 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %v for node %s: %w", key, value, node.NodeID, err)
+	}
+	if port == 0 {
+		return defaultPort, nil
+	}
+	return uint16(port), nil
+}
@@ -0,0 +1,189 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package localnet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/utils/logging"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+type testComposeService struct {
+	Image     string      `yaml:"image"`
+	Command   string      `yaml:"command"`
+	Volumes   []string    `yaml:"volumes"`
+	Ports     []string    `yaml:"ports"`
+	Networks  interface{} `yaml:"networks"`
+	DependsOn []string    `yaml:"depends_on"`
+}
+
+type testComposeFile struct {
+	Services map[string]testComposeService `yaml:"services"`
+	Networks map[string]struct {
+		IPAM struct {
+			Config []map[string]string `yaml:"config"`
+		} `yaml:"ipam"`
+	} `yaml:"networks"`
+}
+
+// creates a stopped two node tmpnet at a temp dir, with a VM plugin, and returns the network dir
+func newTestComposeNetwork(t *testing.T) string {
+	networkID, unparsedGenesis, upgradeBytes, defaultFlags, nodes, err := GetDefaultNetworkConf(2)
+	require.NoError(t, err)
+	pluginDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, "testvm"), []byte("vm"), constants.DefaultPerms755))
+	networkDir := filepath.Join(t.TempDir(), "network")
+	_, err = TmpNetCreate(
+		context.Background(),
+		logging.NoLog{},
+		networkDir,
+		"/bin/avalanchego-v1.13.0/avalanchego",
+		pluginDir,
+		networkID,
+		nil,
+		nil,
+		unparsedGenesis,
+		upgradeBytes,
+		defaultFlags,
+		nodes,
+		false,
+	)
+	require.NoError(t, err)
+	return networkDir
+}
+
+func TestTmpNetExportCompose(t *testing.T) {
+	require := require.New(t)
+	networkDir := newTestComposeNetwork(t)
+	network, err := GetTmpNetNetwork(networkDir)
+	require.NoError(err)
+	httpPorts := []uint16{}
+	for i, node := range network.Nodes {
+		// logs are not exported
+		logsDir := filepath.Join(networkDir, node.NodeID.String(), "logs")
+		require.NoError(os.MkdirAll(logsDir, constants.DefaultPerms755))
+		createFile(t, filepath.Join(logsDir, "main.log"))
+		port, err := getTmpNetNodePort(node, config.HTTPPortKey, uint16(constants.AvalancheGoAPIPort+2*i))
+		require.NoError(err)
+		httpPorts = append(httpPorts, port)
+	}
+	relayerConfig := map[string]interface{}{
+		"p-chain-api":      map[string]string{"base-url": fmt.Sprintf("http://127.0.0.1:%d", httpPorts[0])},
+		"info-api":         map[string]string{"base-url": fmt.Sprintf("http://localhost:%d", httpPorts[1])},
+		"storage-location": "/home/user/.avalanche-cli/runs/icm-relayer-storage",
+	}
+	require.NoError(utils.WriteJSON(filepath.Join(networkDir, constants.ICMRelayerConfigFilename), relayerConfig))
+
+	exportDir := filepath.Join(t.TempDir(), "compose")
+	info, err := TmpNetExportCompose(networkDir, exportDir, "", "v1.6.0")
+	require.NoError(err)
+	// version is obtained from the network avalanchego binary
+	require.Equal("v1.13.0", info.AvalancheGoVersion)
+	require.True(info.WithICMRelayer)
+	require.Equal(filepath.Join(exportDir, composeFileName), info.ComposePath)
+	require.Len(info.Nodes, 2)
+
+	composeBytes, err := os.ReadFile(info.ComposePath)
+	require.NoError(err)
+	var compose testComposeFile
+	require.NoError(yaml.Unmarshal(composeBytes, &compose))
+	require.Len(compose.Services, 3)
+	require.Equal(composeSubnet, compose.Networks["localnet"].IPAM.Config[0]["subnet"])
+	for i, node := range network.Nodes {
+		name := fmt.Sprintf("node%d", i+1)
+		ip := fmt.Sprintf("%s%d", composeSubnetPrefix, composeFirstNodeIP+i)
+		stakingPort, err := getTmpNetNodePort(node, config.StakingPortKey, uint16(constants.AvalancheGoP2PPort+2*i))
+		require.NoError(err)
+		require.Equal(name, info.Nodes[i].Name)
+		service, ok := compose.Services[name]
+		require.True(ok, name)
+		require.Equal("avaplatform/avalanchego:v1.13.0", service.Image)
+		require.Contains(service.Command, "--config-file=/.avalanchego/configs/node.json")
+		require.Equal([]string{
+			fmt.Sprintf("%d:%d", httpPorts[i], httpPorts[i]),
+			fmt.Sprintf("%d:%d", stakingPort, stakingPort),
+		}, service.Ports)
+		require.Equal([]string{
+			"./" + name + ":/.avalanchego:rw",
+			"./plugins:/.avalanchego/plugins:ro",
+		}, service.Volumes)
+		require.Equal(map[string]interface{}{
+			"localnet": map[string]interface{}{"ipv4_address": ip},
+		}, service.Networks)
+		if i == 0 {
+			require.Empty(service.DependsOn)
+		} else {
+			require.Equal([]string{fmt.Sprintf("node%d", i)}, service.DependsOn)
+		}
+
+		// node config points to the container paths and compose network
+		nodeConfigBytes, err := os.ReadFile(filepath.Join(exportDir, name, "configs", "node.json"))
+		require.NoError(err)
+		nodeConfig := map[string]interface{}{}
+		require.NoError(json.Unmarshal(nodeConfigBytes, &nodeConfig))
+		require.Equal(composeNodeDataDir, nodeConfig[config.DataDirKey])
+		require.Equal(filepath.Join(composeNodeDataDir, composePluginsDir), nodeConfig[config.PluginDirKey])
+		require.Equal(ip, nodeConfig[config.PublicIPKey])
+		require.Equal(filepath.Join(composeNodeDataDir, "configs", "genesis.json"), nodeConfig[config.GenesisFileKey])
+		require.FileExists(filepath.Join(exportDir, name, "configs", "genesis.json"))
+		require.NoDirExists(filepath.Join(exportDir, name, "logs"))
+		if i == 0 {
+			require.Empty(nodeConfig[config.BootstrapIPsKey])
+		} else {
+			require.Equal(fmt.Sprintf("%s%d:%d", composeSubnetPrefix, composeFirstNodeIP, info.Nodes[0].StakingPort), nodeConfig[config.BootstrapIPsKey])
+			require.Equal(network.Nodes[0].NodeID.String(), nodeConfig[config.BootstrapIDsKey])
+		}
+	}
+	require.FileExists(filepath.Join(exportDir, composePluginsDir, "testvm"))
+
+	relayer, ok := compose.Services["icm-relayer"]
+	require.True(ok)
+	require.Equal("avaplatform/icm-relayer:v1.6.0", relayer.Image)
+	require.Equal([]string{"./icm-relayer:/.icm-relayer:rw"}, relayer.Volumes)
+	require.Empty(relayer.Ports)
+	require.Equal([]string{"node1", "node2"}, relayer.DependsOn)
+
+	// relayer reaches the nodes through the compose network
+	exportedRelayerConfigBytes, err := os.ReadFile(filepath.Join(exportDir, composeRelayerDir, constants.ICMRelayerConfigFilename))
+	require.NoError(err)
+	exportedRelayerConfig := map[string]interface{}{}
+	require.NoError(json.Unmarshal(exportedRelayerConfigBytes, &exportedRelayerConfig))
+	require.Equal(map[string]interface{}{
+		"p-chain-api":      map[string]interface{}{"base-url": fmt.Sprintf("http://%s%d:%d", composeSubnetPrefix, composeFirstNodeIP, httpPorts[0])},
+		"info-api":         map[string]interface{}{"base-url": fmt.Sprintf("http://%s%d:%d", composeSubnetPrefix, composeFirstNodeIP+1, httpPorts[1])},
+		"storage-location": filepath.Join(composeRelayerDataDir, constants.ICMRelayerStorageDir),
+	}, exportedRelayerConfig)
+
+	// export dir must be empty
+	_, err = TmpNetExportCompose(networkDir, exportDir, "", "v1.6.0")
+	require.ErrorContains(err, "is not empty")
+}
+
+func TestTmpNetExportComposeWithoutRelayer(t *testing.T) {
+	require := require.New(t)
+	networkDir := newTestComposeNetwork(t)
+	exportDir := filepath.Join(t.TempDir(), "compose")
+	info, err := TmpNetExportCompose(networkDir, exportDir, "v1.12.2", "v1.6.0")
+	require.NoError(err)
+	require.Equal("v1.12.2", info.AvalancheGoVersion)
+	require.False(info.WithICMRelayer)
+	composeBytes, err := os.ReadFile(info.ComposePath)
+	require.NoError(err)
+	var compose testComposeFile
+	require.NoError(yaml.Unmarshal(composeBytes, &compose))
+	require.Len(compose.Services, 2)
+	require.NotContains(compose.Services, "icm-relayer")
+	require.Equal("avaplatform/avalanchego:v1.12.2", compose.Services["node1"].Image)
+	require.NoDirExists(filepath.Join(exportDir, composeRelayerDir))
+}
@@ -0,0 +1,281 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package localnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/key"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/coreth/core"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	PChainAlias = "P-Chain"
+	XChainAlias = "X-Chain"
+	CChainAlias = "C-Chain"
+
+	// genesis eth address for primary network allocations. only needed for pre-mainnet assets
+	allocationsETHAddr = "0x0000000000000000000000000000000000000000"
+)
+
+// GenesisAllocationSpec is a user provided funding request for the local network genesis.
+// If [Key] is given, the P-, X- and C-Chain addresses of the CLI managed key are funded,
+// otherwise the explicit chain addresses are used. Amounts are given in nAVAX.
+type GenesisAllocationSpec struct {
+	Key           string `json:"key,omitempty"`
+	PChainAddress string `json:"pChainAddress,omitempty"`
+	XChainAddress string `json:"xChainAddress,omitempty"`
+	CChainAddress string `json:"cChainAddress,omitempty"`
+	PChainAmount  uint64 `json:"pChainAmount,omitempty"`
+	XChainAmount  uint64 `json:"xChainAmount,omitempty"`
+	CChainAmount  uint64 `json:"cChainAmount,omitempty"`
+}
+
+// GenesisAllocationsFile is the format of the file given to network start --genesis-allocations
+type GenesisAllocationsFile struct {
+	Allocations []GenesisAllocationSpec `json:"allocations"`
+}
+
+// GenesisFundedAddress is a resolved allocation for a single address on a single chain
+type GenesisFundedAddress struct {
+	Chain   string `json:"chain"`
+	Address string `json:"address"`
+	KeyName string `json:"keyName,omitempty"`
+	Amount  uint64 `json:"amount"`
+}
+
+// GenesisAllocations keeps track of the funds allocated on local network genesis
+type GenesisAllocations struct {
+	EwoqFunded      bool                   `json:"ewoqFunded"`
+	FundedAddresses []GenesisFundedAddress `json:"fundedAddresses,omitempty"`
+}
+
+// DefaultGenesisAllocations returns the allocations used when the user does not
+// customize the local network genesis: only EWOQ is funded
+func DefaultGenesisAllocations() *GenesisAllocations {
+	return &GenesisAllocations{
+		EwoqFunded: true,
+	}
+}
+
+// IsFunded indicates if [addr] on [chain] received funds on local network genesis
+func (g *GenesisAllocations) IsFunded(chain string, addr string) bool {
+	if g == nil {
+		return false
+	}
+	for _, fundedAddress := range g.FundedAddresses {
+		if fundedAddress.Chain != chain {
+			continue
+		}
+		if chain == CChainAlias {
+			if strings.EqualFold(fundedAddress.Address, addr) {
+				return true
+			}
+			continue
+		}
+		// P-Chain and X-Chain addresses are compared regardless of chain prefix and HRP
+		fundedShortID, err := address.ParseToID(fundedAddress.Address)
+		if err != nil {
+			continue
+		}
+		shortID, err := address.ParseToID(addr)
+		if err != nil {
+			continue
+		}
+		if fundedShortID == shortID {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadGenesisAllocationsFile reads the allocations file at [path] and resolves all key
+// names and addresses on it, for the given [network]
+func LoadGenesisAllocationsFile(
+	app *application.Avalanche,
+	network models.Network,
+	path string,
+	fundEwoq bool,
+) (*GenesisAllocations, error) {
+	allocations := &GenesisAllocations{
+		EwoqFunded: fundEwoq,
+	}
+	if path == "" {
+		return allocations, nil
+	}
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading genesis allocations file %s: %w", path, err)
+	}
+	var allocationsFile GenesisAllocationsFile
+	if err := json.Unmarshal(bs, &allocationsFile); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling genesis allocations file %s: %w", path, err)
+	}
+	for i, spec := range allocationsFile.Allocations {
+		fundedAddresses, err := resolveGenesisAllocationSpec(app, network, spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid genesis allocation at index %d: %w", i, err)
+		}
+		allocations.FundedAddresses = append(allocations.FundedAddresses, fundedAddresses...)
+	}
+	return allocations, nil
+}
+
+func resolveGenesisAllocationSpec(
+	app *application.Avalanche,
+	network models.Network,
+	spec GenesisAllocationSpec,
+) ([]GenesisFundedAddress, error) {
+	if spec.PChainAmount == 0 && spec.XChainAmount == 0 && spec.CChainAmount == 0 {
+		return nil, fmt.Errorf("no amount specified")
+	}
+	pChainAddress := spec.PChainAddress
+	xChainAddress := spec.XChainAddress
+	cChainAddress := spec.CChainAddress
+	if spec.Key != "" {
+		if pChainAddress != "" || xChainAddress != "" || cChainAddress != "" {
+			return nil, fmt.Errorf("key %s and explicit addresses can't be specified at the same time", spec.Key)
+		}
+		k, err := app.GetKey(spec.Key, network, false)
+		if err != nil {
+			return nil, fmt.Errorf("failure loading key %s: %w", spec.Key, err)
+		}
+		pChainAddress = k.P()[0]
+		xChainAddress = k.X()[0]
+		cChainAddress = k.C()
+	}
+	fundedAddresses := []GenesisFundedAddress{}
+	if spec.PChainAmount > 0 {
+		if _, err := address.ParseToID(pChainAddress); err != nil {
+			return nil, fmt.Errorf("invalid P-Chain address %q: %w", pChainAddress, err)
+		}
+		fundedAddresses = append(fundedAddresses, GenesisFundedAddress{
+			Chain:   PChainAlias,
+			Address: pChainAddress,
+			KeyName: spec.Key,
+			Amount:  spec.PChainAmount,
+		})
+	}
+	if spec.XChainAmount > 0 {
+		if _, err := address.ParseToID(xChainAddress); err != nil {
+			return nil, fmt.Errorf("invalid X-Chain address %q: %w", xChainAddress, err)
+		}
+		fundedAddresses = append(fundedAddresses, GenesisFundedAddress{
+			Chain:   XChainAlias,
+			Address: xChainAddress,
+			KeyName: spec.Key,
+			Amount:  spec.XChainAmount,
+		})
+	}
+	if spec.CChainAmount > 0 {
+		if !common.IsHexAddress(cChainAddress) {
+			return nil, fmt.Errorf("invalid C-Chain address %q", cChainAddress)
+		}
+		fundedAddresses = append(fundedAddresses, GenesisFundedAddress{
+			Chain:   CChainAlias,
+			Address: cChainAddress,
+			KeyName: spec.Key,
+			Amount:  spec.CChainAmount,
+		})
+	}
+	return fundedAddresses, nil
+}
+
+// ApplyGenesisAllocations modifies [unparsedGenesis] so as to fund all addresses in
+// [allocations]. If EWOQ is not set to be funded, its default allocations are removed.
+func ApplyGenesisAllocations(
+	unparsedGenesis *genesis.UnparsedConfig,
+	allocations *GenesisAllocations,
+) error {
+	if allocations == nil {
+		return nil
+	}
+	ewoqKey, err := key.LoadEwoq(unparsedGenesis.NetworkID)
+	if err != nil {
+		return err
+	}
+	ewoqShortID := ewoqKey.Addresses()[0]
+	cChainGenesis := core.Genesis{}
+	if err := json.Unmarshal([]byte(unparsedGenesis.CChainGenesis), &cChainGenesis); err != nil {
+		return fmt.Errorf("failed unmarshalling C-Chain genesis: %w", err)
+	}
+	if !allocations.EwoqFunded {
+		primaryAllocations := []genesis.UnparsedAllocation{}
+		for _, allocation := range unparsedGenesis.Allocations {
+			shortID, err := address.ParseToID(allocation.AVAXAddr)
+			if err != nil {
+				return err
+			}
+			if shortID != ewoqShortID {
+				primaryAllocations = append(primaryAllocations, allocation)
+			}
+		}
+		unparsedGenesis.Allocations = primaryAllocations
+		delete(cChainGenesis.Alloc, common.HexToAddress(ewoqKey.C()))
+	}
+	if cChainGenesis.Alloc == nil {
+		cChainGenesis.Alloc = core.GenesisAlloc{}
+	}
+	// P-Chain and X-Chain funds for the same short address are merged into one allocation
+	primaryAllocationIndex := map[ids.ShortID]int{}
+	for _, fundedAddress := range allocations.FundedAddresses {
+		switch fundedAddress.Chain {
+		case PChainAlias, XChainAlias:
+			shortID, err := address.ParseToID(fundedAddress.Address)
+			if err != nil {
+				return err
+			}
+			index, ok := primaryAllocationIndex[shortID]
+			if !ok {
+				avaxAddr, err := address.Format("X", key.GetHRP(unparsedGenesis.NetworkID), shortID[:])
+				if err != nil {
+					return err
+				}
+				unparsedGenesis.Allocations = append(unparsedGenesis.Allocations, genesis.UnparsedAllocation{
+					ETHAddr:  allocationsETHAddr,
+					AVAXAddr: avaxAddr,
+				})
+				index = len(unparsedGenesis.Allocations) - 1
+				primaryAllocationIndex[shortID] = index
+			}
+			if fundedAddress.Chain == XChainAlias {
+				unparsedGenesis.Allocations[index].InitialAmount += fundedAddress.Amount
+			} else {
+				unparsedGenesis.Allocations[index].UnlockSchedule = append(
+					unparsedGenesis.Allocations[index].UnlockSchedule,
+					genesis.LockedAmount{Amount: fundedAddress.Amount},
+				)
+			}
+		case CChainAlias:
+			addr := common.HexToAddress(fundedAddress.Address)
+			// nAVAX to wei
+			amount := new(big.Int).Mul(new(big.Int).SetUint64(fundedAddress.Amount), big.NewInt(int64(units.Avax)))
+			if account, ok := cChainGenesis.Alloc[addr]; ok {
+				amount.Add(amount, account.Balance)
+			}
+			cChainGenesis.Alloc[addr] = core.GenesisAccount{
+				Balance: amount,
+			}
+		default:
+			return fmt.Errorf("unknown chain %q for genesis allocation", fundedAddress.Chain)
+		}
+	}
+	cChainGenesisBytes, err := json.Marshal(cChainGenesis)
+	if err != nil {
+		return fmt.Errorf("failed marshalling C-Chain genesis: %w", err)
+	}
+	unparsedGenesis.CChainGenesis = string(cChainGenesisBytes)
+	return nil
+}
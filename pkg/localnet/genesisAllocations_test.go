@@ -0,0 +1,62 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package localnet
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/pkg/key"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/coreth/core"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyGenesisAllocations(t *testing.T) {
+	require := require.New(t)
+	_, unparsedGenesis, _, _, _, err := GetDefaultNetworkConf(1)
+	require.NoError(err)
+	ewoqKey, err := key.LoadEwoq(unparsedGenesis.NetworkID)
+	require.NoError(err)
+	k, err := key.NewSoft(unparsedGenesis.NetworkID)
+	require.NoError(err)
+	allocations := &GenesisAllocations{
+		EwoqFunded: false,
+		FundedAddresses: []GenesisFundedAddress{
+			{Chain: PChainAlias, Address: k.P()[0], Amount: 10 * units.Avax},
+			{Chain: XChainAlias, Address: k.X()[0], Amount: 20 * units.Avax},
+			{Chain: CChainAlias, Address: k.C(), Amount: 30 * units.Avax},
+		},
+	}
+	require.NoError(ApplyGenesisAllocations(unparsedGenesis, allocations))
+
+	found := false
+	for _, allocation := range unparsedGenesis.Allocations {
+		shortID, err := address.ParseToID(allocation.AVAXAddr)
+		require.NoError(err)
+		require.NotEqual(ewoqKey.Addresses()[0], shortID)
+		if shortID == k.Addresses()[0] {
+			found = true
+			require.Equal(20*units.Avax, allocation.InitialAmount)
+			require.Len(allocation.UnlockSchedule, 1)
+			require.Equal(10*units.Avax, allocation.UnlockSchedule[0].Amount)
+		}
+	}
+	require.True(found)
+
+	cChainGenesis := core.Genesis{}
+	require.NoError(json.Unmarshal([]byte(unparsedGenesis.CChainGenesis), &cChainGenesis))
+	require.NotContains(cChainGenesis.Alloc, common.HexToAddress(ewoqKey.C()))
+	account, ok := cChainGenesis.Alloc[common.HexToAddress(k.C())]
+	require.True(ok)
+	expectedBalance := new(big.Int).Mul(big.NewInt(int64(30*units.Avax)), big.NewInt(int64(units.Avax)))
+	require.Zero(expectedBalance.Cmp(account.Balance))
+
+	require.True(allocations.IsFunded(PChainAlias, k.P()[0]))
+	require.True(allocations.IsFunded(CChainAlias, k.C()))
+	require.False(allocations.IsFunded(XChainAlias, ewoqKey.X()[0]))
+}
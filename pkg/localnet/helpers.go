@@ -19,10 +19,12 @@ import (
 // After P-Chain is bootstrapped, set alias [blockchainName]->blockchainID
 // for the network, and persists RPC into sidecar
 // Use both for local networks and local clusters
+// [vmBinaryPath] is the binary of the blockchain VM, to be installed on the nodes
 func TrackSubnet(
 	app *application.Avalanche,
 	printFunc func(msg string, args ...interface{}),
 	blockchainName string,
+	vmBinaryPath string,
 	networkDir string,
 	wallet *primary.Wallet,
 ) error {
@@ -43,10 +45,6 @@ func TrackSubnet(
 		blockchainConfig []byte
 		subnetConfig     []byte
 	)
-	vmBinaryPath, err := SetupVMBinary(app, blockchainName)
-	if err != nil {
-		return fmt.Errorf("failed to setup VM binary: %w", err)
-	}
 	if app.ChainConfigExists(blockchainName) {
 		blockchainConfig, err = os.ReadFile(app.GetChainConfigPath(blockchainName))
 		if err != nil {
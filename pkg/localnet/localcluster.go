@@ -526,12 +526,14 @@ func GetLocalClusterTrackedBlockchains(
 	return trackedBlockchains, nil
 }
 
-// Tracks the subnet of [blockchainName] in the cluster given by [clusterName]
+// Tracks the subnet of [blockchainName] in the cluster given by [clusterName],
+// running the VM binary [vmBinaryPath]
 func LocalClusterTrackSubnet(
 	app *application.Avalanche,
 	printFunc func(msg string, args ...interface{}),
 	clusterName string,
 	blockchainName string,
+	vmBinaryPath string,
 ) error {
 	if !LocalClusterExists(app, clusterName) {
 		return fmt.Errorf("local cluster %q is not found", clusterName)
@@ -541,6 +543,7 @@ func LocalClusterTrackSubnet(
 		app,
 		printFunc,
 		blockchainName,
+		vmBinaryPath,
 		networkDir,
 		nil,
 	)
@@ -22,16 +22,18 @@ type ExtraLocalNetworkData struct {
 	RelayerPath                      string
 	CChainTeleporterMessengerAddress string
 	CChainTeleporterRegistryAddress  string
+	GenesisAllocations               *GenesisAllocations
 }
 
 // Restart all nodes on local network to track [blockchainName].
-// Before that, set up VM binary, blockchain and subnet config information
+// Before that, set up VM binary [vmBinaryPath], blockchain and subnet config information
 // After the blockchain is bootstrapped, add alias for [blockchainName]->[blockchainID]
 // Finally persist all new blockchain RPC URLs into blockchain sidecar.
 func LocalNetworkTrackSubnet(
 	app *application.Avalanche,
 	printFunc func(msg string, args ...interface{}),
 	blockchainName string,
+	vmBinaryPath string,
 ) error {
 	networkDir, err := GetLocalNetworkDir(app)
 	if err != nil {
@@ -54,6 +56,7 @@ func LocalNetworkTrackSubnet(
 		app,
 		printFunc,
 		blockchainName,
+		vmBinaryPath,
 		networkDir,
 		wallet,
 	)
@@ -181,3 +184,22 @@ func WriteExtraLocalNetworkData(
 	}
 	return os.WriteFile(extraLocalNetworkDataPath, bs, constants.WriteReadReadPerms)
 }
+
+// Persists the funds allocated on the local network genesis, alongside the network at [rootDataDir]
+func WriteLocalNetworkGenesisAllocations(
+	app *application.Avalanche,
+	rootDataDir string,
+	genesisAllocations *GenesisAllocations,
+) error {
+	_, extraLocalNetworkData, err := GetExtraLocalNetworkData(app, rootDataDir)
+	if err != nil {
+		return err
+	}
+	extraLocalNetworkData.GenesisAllocations = genesisAllocations
+	bs, err := json.Marshal(&extraLocalNetworkData)
+	if err != nil {
+		return err
+	}
+	extraLocalNetworkDataPath := filepath.Join(rootDataDir, constants.ExtraLocalNetworkDataFilename)
+	return os.WriteFile(extraLocalNetworkDataPath, bs, constants.WriteReadReadPerms)
+}
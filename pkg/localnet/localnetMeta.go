@@ -14,9 +14,11 @@ import (
 )
 
 // Local network metadata keeps reference to the tmpnet directory
-// of the currently executing local network
+// of the currently executing local network, together with the
+// funds allocated on its genesis
 type LocalNetworkMeta struct {
-	NetworkDir string `json:"networkDir"`
+	NetworkDir         string              `json:"networkDir"`
+	GenesisAllocations *GenesisAllocations `json:"genesisAllocations,omitempty"`
 }
 
 // localNetworkMetaPath returns the path of the metadata file
@@ -48,13 +50,23 @@ func GetLocalNetworkMeta(
 }
 
 // SaveLocalNetworkMeta saves the tmpnet directory of the currently executing local network
-// to the metadata file
+// to the metadata file, together with the genesis allocations persisted on it
 func SaveLocalNetworkMeta(
 	app *application.Avalanche,
 	networkDir string,
 ) error {
+	_, extraLocalNetworkData, err := GetExtraLocalNetworkData(app, networkDir)
+	if err != nil {
+		return err
+	}
+	genesisAllocations := extraLocalNetworkData.GenesisAllocations
+	if genesisAllocations == nil {
+		// networks created before genesis allocations were configurable
+		genesisAllocations = DefaultGenesisAllocations()
+	}
 	meta := LocalNetworkMeta{
-		NetworkDir: networkDir,
+		NetworkDir:         networkDir,
+		GenesisAllocations: genesisAllocations,
 	}
 	bs, err := json.Marshal(&meta)
 	if err != nil {
@@ -74,3 +86,18 @@ func RemoveLocalNetworkMeta(
 	path := localNetworkMetaPath(app)
 	return os.RemoveAll(path)
 }
+
+// GetLocalNetworkGenesisAllocations returns the funds allocated on genesis for the
+// currently executing local network, or nil if there is no such network
+func GetLocalNetworkGenesisAllocations(
+	app *application.Avalanche,
+) (*GenesisAllocations, error) {
+	if !LocalNetworkMetaExists(app) {
+		return nil, nil
+	}
+	meta, err := GetLocalNetworkMeta(app)
+	if err != nil {
+		return nil, err
+	}
+	return meta.GenesisAllocations, nil
+}
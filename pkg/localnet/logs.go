@@ -0,0 +1,371 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package localnet
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	sdkutils "github.com/ava-labs/avalanche-cli/sdk/utils"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+const (
+	RelayerLogSourceName    = "relayer"
+	AggregatorLogSourceName = "aggregator"
+
+	// avalanchego plain log format timestamp, eg [01-02|15:04:05.000]
+	plainLogTimeFormat = "[01-02|15:04:05.000]"
+	// zap ISO8601 json log format timestamp
+	jsonLogTimeFormat = "2006-01-02T15:04:05.000Z0700"
+
+	logsFollowPollInterval = 500 * time.Millisecond
+)
+
+// LogSource is a log file that takes part on the aggregated local network logs
+type LogSource struct {
+	// short name used to identify the source on the aggregated output
+	Name string
+	// node associated to the log, if any
+	NodeID string
+	// chain alias or blockchain ID associated to the log, if any
+	Chain string
+	Path  string
+}
+
+// LogEntry is a single log line of a given source
+type LogEntry struct {
+	Source LogSource
+	Time   time.Time
+	// log level as found on the line, empty if not recognized
+	Level string
+	Line  string
+}
+
+// LogsFilter selects the log entries to be shown
+type LogsFilter struct {
+	nodeIDs []string
+	// chain aliases or blockchain IDs
+	chains      []string
+	hasMinLevel bool
+	minLevel    logging.Level
+	regex       *regexp.Regexp
+}
+
+// NewLogsFilter creates a filter for the given [nodeIDs], [chains], minimum
+// log [level] and [regex]. Empty values do not filter.
+func NewLogsFilter(
+	nodeIDs []string,
+	chains []string,
+	level string,
+	regex string,
+) (LogsFilter, error) {
+	filter := LogsFilter{
+		nodeIDs: nodeIDs,
+		chains:  chains,
+	}
+	if level != "" {
+		minLevel, err := logging.ToLevel(level)
+		if err != nil {
+			return filter, err
+		}
+		filter.hasMinLevel = true
+		filter.minLevel = minLevel
+	}
+	if regex != "" {
+		var err error
+		filter.regex, err = regexp.Compile(regex)
+		if err != nil {
+			return filter, err
+		}
+	}
+	return filter, nil
+}
+
+// Match indicates if [entry] passes the filter
+func (f LogsFilter) Match(entry LogEntry) bool {
+	if len(f.nodeIDs) > 0 && !sdkutils.Belongs(f.nodeIDs, entry.Source.NodeID) {
+		return false
+	}
+	if len(f.chains) > 0 {
+		chainMatch := false
+		for _, chain := range f.chains {
+			// node logs are split by chain, other sources mention the blockchain ID on the line
+			if entry.Source.Chain == chain || (entry.Source.NodeID == "" && strings.Contains(entry.Line, chain)) {
+				chainMatch = true
+				break
+			}
+		}
+		if !chainMatch {
+			return false
+		}
+	}
+	if f.hasMinLevel && entry.Level != "" {
+		if level, err := logging.ToLevel(entry.Level); err == nil && level < f.minLevel {
+			return false
+		}
+	}
+	if f.regex != nil && !f.regex.MatchString(entry.Line) {
+		return false
+	}
+	return true
+}
+
+// GetLocalNetworkLogSources returns all log sources associated to the local network:
+// avalanchego logs of the local network nodes and of the local clusters connected to it,
+// the local relayer log, and the signature aggregator logs
+func GetLocalNetworkLogSources(app *application.Avalanche) ([]LogSource, error) {
+	sources := []LogSource{}
+	networkDir, err := GetLocalNetworkDir(app)
+	if err != nil {
+		return nil, err
+	}
+	networkSources, err := getTmpNetLogSources(networkDir, "")
+	if err != nil {
+		return nil, err
+	}
+	sources = append(sources, networkSources...)
+	clusterNames, err := GetRunningLocalClustersConnectedToLocalNetwork(app)
+	if err != nil {
+		return nil, err
+	}
+	for _, clusterName := range clusterNames {
+		clusterSources, err := getTmpNetLogSources(GetLocalClusterDir(app, clusterName), clusterName)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, clusterSources...)
+		aggregatorLogPath := filepath.Join(app.GetAggregatorLogDir(clusterName), constants.SignatureAggregatorLogName+".log")
+		if utils.FileExists(aggregatorLogPath) {
+			sources = append(sources, LogSource{
+				Name: clusterName + "/" + AggregatorLogSourceName,
+				Path: aggregatorLogPath,
+			})
+		}
+	}
+	relayerLogPath := app.GetLocalRelayerLogPath(models.Local)
+	if utils.FileExists(relayerLogPath) {
+		sources = append(sources, LogSource{
+			Name: RelayerLogSourceName,
+			Path: relayerLogPath,
+		})
+	}
+	aggregatorLogPath := filepath.Join(app.GetAggregatorLogDir(""), constants.SignatureAggregatorLogName+".log")
+	if utils.FileExists(aggregatorLogPath) {
+		sources = append(sources, LogSource{
+			Name: AggregatorLogSourceName,
+			Path: aggregatorLogPath,
+		})
+	}
+	return sources, nil
+}
+
+// returns a log source for every avalanchego log file found on the tmpnet at [networkDir]
+func getTmpNetLogSources(networkDir string, prefix string) ([]LogSource, error) {
+	network, err := GetTmpNetNetwork(networkDir)
+	if err != nil {
+		return nil, err
+	}
+	sources := []LogSource{}
+	for _, node := range network.Nodes {
+		nodeID := node.NodeID.String()
+		logPaths, err := filepath.Glob(filepath.Join(networkDir, nodeID, "logs", "*.log"))
+		if err != nil {
+			return nil, err
+		}
+		for _, logPath := range logPaths {
+			chain := strings.TrimSuffix(filepath.Base(logPath), ".log")
+			name := nodeID + "/" + chain
+			if prefix != "" {
+				name = prefix + "/" + name
+			}
+			sources = append(sources, LogSource{
+				Name:   name,
+				NodeID: nodeID,
+				Chain:  chain,
+				Path:   logPath,
+			})
+		}
+	}
+	return sources, nil
+}
+
+// ParseLogLine obtains timestamp and level for [line], accepting both avalanchego
+// plain format and zap json format. Plain format timestamps lack year, so the
+// year is taken from [now].
+func ParseLogLine(line string, now time.Time) (time.Time, string, bool) {
+	if strings.HasPrefix(line, "{") {
+		logMap := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(line), &logMap); err != nil {
+			return time.Time{}, "", false
+		}
+		timestampStr, ok := logMap["timestamp"].(string)
+		if !ok {
+			return time.Time{}, "", false
+		}
+		t, err := time.Parse(jsonLogTimeFormat, timestampStr)
+		if err != nil {
+			return time.Time{}, "", false
+		}
+		level, _ := logMap["level"].(string)
+		return t, strings.ToUpper(level), true
+	}
+	if len(line) < len(plainLogTimeFormat) {
+		return time.Time{}, "", false
+	}
+	t, err := time.ParseInLocation(plainLogTimeFormat, line[:len(plainLogTimeFormat)], now.Location())
+	if err != nil {
+		return time.Time{}, "", false
+	}
+	t = t.AddDate(now.Year(), 0, 0)
+	if t.After(now.Add(24 * time.Hour)) {
+		// log from previous year
+		t = t.AddDate(-1, 0, 0)
+	}
+	level := ""
+	if fields := strings.Fields(line[len(plainLogTimeFormat):]); len(fields) > 0 {
+		level = fields[0]
+	}
+	return t, level, true
+}
+
+// parses all lines read from [r] into entries for [source]. Lines without a recognizable
+// timestamp (eg stack traces) inherit the timestamp of the previous line
+func readLogEntries(source LogSource, r io.Reader, lastTime time.Time, now time.Time) ([]LogEntry, time.Time, error) {
+	entries := []LogEntry{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \r\n")
+		if line == "" {
+			continue
+		}
+		t, level, ok := ParseLogLine(line, now)
+		if ok {
+			lastTime = t
+		} else {
+			t = lastTime
+		}
+		entries = append(entries, LogEntry{
+			Source: source,
+			Time:   t,
+			Level:  level,
+			Line:   line,
+		})
+	}
+	return entries, lastTime, scanner.Err()
+}
+
+// MergeLogEntries sorts entries of different sources by time, keeping the
+// original order for entries with the same time
+func MergeLogEntries(entries []LogEntry) []LogEntry {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.Before(entries[j].Time)
+	})
+	return entries
+}
+
+// GetLogEntries reads all [sources] and returns their time ordered entries that
+// pass [filter]
+func GetLogEntries(sources []LogSource, filter LogsFilter) ([]LogEntry, error) {
+	now := time.Now()
+	entries := []LogEntry{}
+	for _, source := range sources {
+		f, err := os.Open(source.Path)
+		if err != nil {
+			return nil, err
+		}
+		sourceEntries, _, err := readLogEntries(source, f, time.Time{}, now)
+		_ = f.Close()
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, utils.Filter(sourceEntries, filter.Match)...)
+	}
+	return MergeLogEntries(entries), nil
+}
+
+// FollowLogEntries polls [sources] for new content, starting at their current end, and
+// calls [printFunc] with the new time ordered entries that pass [filter], until [ctx] is done
+func FollowLogEntries(
+	ctx context.Context,
+	sources []LogSource,
+	filter LogsFilter,
+	printFunc func(LogEntry),
+) error {
+	offsets := make([]int64, len(sources))
+	lastTimes := make([]time.Time, len(sources))
+	for i, source := range sources {
+		info, err := os.Stat(source.Path)
+		if err != nil {
+			return err
+		}
+		offsets[i] = info.Size()
+	}
+	ticker := time.NewTicker(logsFollowPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		now := time.Now()
+		entries := []LogEntry{}
+		for i, source := range sources {
+			info, err := os.Stat(source.Path)
+			if err != nil {
+				// file may be in the middle of a rotation
+				continue
+			}
+			if info.Size() < offsets[i] {
+				// file was rotated
+				offsets[i] = 0
+			}
+			if info.Size() == offsets[i] {
+				continue
+			}
+			f, err := os.Open(source.Path)
+			if err != nil {
+				continue
+			}
+			if _, err := f.Seek(offsets[i], io.SeekStart); err != nil {
+				_ = f.Close()
+				return err
+			}
+			bs, err := io.ReadAll(f)
+			_ = f.Close()
+			if err != nil {
+				return err
+			}
+			// only process complete lines
+			lastNewLine := strings.LastIndex(string(bs), "\n")
+			if lastNewLine == -1 {
+				continue
+			}
+			bs = bs[:lastNewLine+1]
+			offsets[i] += int64(len(bs))
+			var sourceEntries []LogEntry
+			sourceEntries, lastTimes[i], err = readLogEntries(source, strings.NewReader(string(bs)), lastTimes[i], now)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, utils.Filter(sourceEntries, filter.Match)...)
+		}
+		for _, entry := range MergeLogEntries(entries) {
+			printFunc(entry)
+		}
+	}
+}
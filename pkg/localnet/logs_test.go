@@ -0,0 +1,79 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package localnet
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLogLine(t *testing.T) {
+	require := require.New(t)
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	ts, level, ok := ParseLogLine(`[03-10|11:30:00.123] INFO <P Chain> platformvm/vm.go:100 initializing`, now)
+	require.True(ok)
+	require.Equal("INFO", level)
+	require.Equal(time.Date(2025, 3, 10, 11, 30, 0, 123000000, time.UTC), ts)
+
+	// plain logs from the end of previous year
+	ts, _, ok = ParseLogLine(`[12-31|23:59:59.000] WARN something`, now)
+	require.True(ok)
+	require.Equal(2024, ts.Year())
+
+	ts, level, ok = ParseLogLine(`{"level":"error","timestamp":"2025-03-10T11:30:01.000Z","msg":"failed"}`, now)
+	require.True(ok)
+	require.Equal("ERROR", level)
+	require.Equal(time.Date(2025, 3, 10, 11, 30, 1, 0, time.UTC), ts.UTC())
+
+	_, _, ok = ParseLogLine("goroutine 1 [running]:", now)
+	require.False(ok)
+}
+
+func TestReadAndFilterLogEntries(t *testing.T) {
+	require := require.New(t)
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	nodeSource := LogSource{Name: "node/P", NodeID: "NodeID-1", Chain: "P"}
+	relayerSource := LogSource{Name: RelayerLogSourceName}
+
+	nodeEntries, _, err := readLogEntries(nodeSource, strings.NewReader(strings.Join([]string{
+		`[03-10|11:30:00.000] INFO first`,
+		`[03-10|11:30:02.000] ERROR third`,
+		`stack trace line`,
+	}, "\n")), time.Time{}, now)
+	require.NoError(err)
+	require.Len(nodeEntries, 3)
+	// lines without timestamp inherit previous one
+	require.Equal(nodeEntries[1].Time, nodeEntries[2].Time)
+
+	relayerEntries, _, err := readLogEntries(relayerSource, strings.NewReader(
+		`{"level":"info","timestamp":"2025-03-10T11:30:01.000Z","msg":"second","blockchainID":"abc"}`,
+	), time.Time{}, now)
+	require.NoError(err)
+	require.Len(relayerEntries, 1)
+
+	merged := MergeLogEntries(append(nodeEntries, relayerEntries...))
+	require.Equal(`[03-10|11:30:00.000] INFO first`, merged[0].Line)
+	require.Equal(RelayerLogSourceName, merged[1].Source.Name)
+
+	filter, err := NewLogsFilter(nil, nil, "warn", "")
+	require.NoError(err)
+	require.False(filter.Match(merged[0]))
+	require.True(filter.Match(merged[2]))
+
+	filter, err = NewLogsFilter(nil, []string{"abc"}, "", "")
+	require.NoError(err)
+	require.False(filter.Match(merged[0]))
+	require.True(filter.Match(merged[1]))
+
+	filter, err = NewLogsFilter([]string{"NodeID-1"}, nil, "", "fir.t")
+	require.NoError(err)
+	require.True(filter.Match(merged[0]))
+	require.False(filter.Match(merged[1]))
+
+	_, err = NewLogsFilter(nil, nil, "unknown", "")
+	require.Error(err)
+}
@@ -0,0 +1,189 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package localnet
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/docker"
+	"github.com/ava-labs/avalanche-cli/pkg/monitoring"
+	"github.com/ava-labs/avalanche-cli/pkg/remoteconfig"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+)
+
+const localMonitoringHost = "127.0.0.1"
+
+// remote monitoring configs address services by their compose name. local monitoring
+// services run on the host network, so they are addressed by the local host instead
+var localMonitoringAddressReplacer = strings.NewReplacer(
+	fmt.Sprintf("prometheus:%d", constants.AvalancheGoMonitoringPort),
+	fmt.Sprintf("%s:%d", localMonitoringHost, constants.AvalancheGoMonitoringPort),
+	fmt.Sprintf("loki:%d", constants.AvalancheGoLokiPort),
+	fmt.Sprintf("%s:%d", localMonitoringHost, constants.AvalancheGoLokiPort),
+)
+
+// GetLocalMonitoringComposePath returns the docker compose file used for local network monitoring
+func GetLocalMonitoringComposePath(app *application.Avalanche) string {
+	return filepath.Join(app.GetLocalMonitoringDir(), composeFileName)
+}
+
+// LocalNetworkMonitoringStart writes prometheus, loki, promtail and grafana configs for the
+// running local network, and starts them on the local docker.
+// Prometheus scrapes all local network nodes, and the nodes of the running local clusters
+// connected to it, promtail ships their logs to loki, and grafana is provisioned with the
+// same dashboards used for cloud clusters
+func LocalNetworkMonitoringStart(app *application.Avalanche) error {
+	networkDir, err := GetLocalNetworkDir(app)
+	if err != nil {
+		return err
+	}
+	// networks to monitor, labeled by host
+	type monitoredNetwork struct {
+		host string
+		dir  string
+	}
+	monitoredNetworks := []monitoredNetwork{{host: "local", dir: networkDir}}
+	clusterNames, err := GetRunningLocalClustersConnectedToLocalNetwork(app)
+	if err != nil {
+		return err
+	}
+	for _, clusterName := range clusterNames {
+		monitoredNetworks = append(monitoredNetworks, monitoredNetwork{host: clusterName, dir: GetLocalClusterDir(app, clusterName)})
+	}
+	nodeURIs := []string{}
+	for _, monitored := range monitoredNetworks {
+		uris, err := GetTmpNetNodeURIsWithFix(monitored.dir)
+		if err != nil {
+			return err
+		}
+		nodeURIs = append(nodeURIs, uris...)
+	}
+	monitoringDir := app.GetLocalMonitoringDir()
+	for _, dir := range []string{
+		filepath.Join(monitoringDir, "prometheus", "data"),
+		filepath.Join(monitoringDir, "loki", "data"),
+		filepath.Join(monitoringDir, "grafana", "data"),
+		filepath.Join(monitoringDir, "grafana", constants.DashboardsDir),
+		filepath.Join(monitoringDir, "grafana", "provisioning", "datasources"),
+		filepath.Join(monitoringDir, "grafana", "provisioning", "dashboards"),
+	} {
+		if err := os.MkdirAll(dir, constants.DefaultPerms755); err != nil {
+			return err
+		}
+	}
+	avalancheGoTargets := utils.Map(nodeURIs, func(uri string) string {
+		return strings.TrimPrefix(strings.TrimPrefix(uri, "http://"), "https://")
+	})
+	prometheusConfigPath := filepath.Join(monitoringDir, "prometheus", "prometheus.yml")
+	if err := monitoring.WritePrometheusConfig(prometheusConfigPath, avalancheGoTargets, nil, nil); err != nil {
+		return err
+	}
+	if err := localizeMonitoringConfig(prometheusConfigPath); err != nil {
+		return err
+	}
+	if err := monitoring.WriteLokiConfig(
+		filepath.Join(monitoringDir, "loki", "loki.yml"),
+		strconv.Itoa(constants.AvalancheGoLokiPort),
+	); err != nil {
+		return err
+	}
+	logsSources := []docker.LocalMonitoringLogsSource{}
+	for _, monitored := range monitoredNetworks {
+		network, err := GetTmpNetNetwork(monitored.dir)
+		if err != nil {
+			return err
+		}
+		for _, node := range network.Nodes {
+			nodeID := node.NodeID.String()
+			promtailDir := filepath.Join(monitoringDir, "promtail", nodeID)
+			if err := os.MkdirAll(promtailDir, constants.DefaultPerms755); err != nil {
+				return err
+			}
+			if err := monitoring.WritePromtailConfig(
+				filepath.Join(promtailDir, "promtail.yml"),
+				localMonitoringHost,
+				strconv.Itoa(constants.AvalancheGoLokiPort),
+				monitored.host,
+				nodeID,
+				"",
+			); err != nil {
+				return err
+			}
+			logsSources = append(logsSources, docker.LocalMonitoringLogsSource{
+				NodeID:  nodeID,
+				LogsDir: filepath.Join(monitored.dir, nodeID, "logs"),
+			})
+		}
+	}
+	if err := writeLocalGrafanaConfig(filepath.Join(monitoringDir, "grafana")); err != nil {
+		return err
+	}
+	composeBytes, err := docker.RenderLocalMonitoringComposeFile(docker.DockerComposeInputs{
+		LocalMonitoringDir:  monitoringDir,
+		LocalMonitoringUser: fmt.Sprintf("%d:%d", os.Getuid(), os.Getgid()),
+		LocalMonitoringLogs: logsSources,
+	})
+	if err != nil {
+		return err
+	}
+	composePath := GetLocalMonitoringComposePath(app)
+	if err := os.WriteFile(composePath, composeBytes, constants.WriteReadReadPerms); err != nil {
+		return err
+	}
+	return docker.StartLocalDockerCompose(composePath)
+}
+
+// LocalNetworkMonitoringStop stops local network monitoring services. Collected data is kept
+func LocalNetworkMonitoringStop(app *application.Avalanche) error {
+	composePath := GetLocalMonitoringComposePath(app)
+	if !utils.FileExists(composePath) {
+		return fmt.Errorf("local network monitoring has not been started")
+	}
+	return docker.StopLocalDockerCompose(composePath)
+}
+
+// writes grafana config, datasources and dashboards into [grafanaDir]
+func writeLocalGrafanaConfig(grafanaDir string) error {
+	grafanaConfig, err := remoteconfig.RenderGrafanaConfig()
+	if err != nil {
+		return err
+	}
+	lokiDataSource, err := remoteconfig.RenderGrafanaLokiDataSourceConfig()
+	if err != nil {
+		return err
+	}
+	prometheusDataSource, err := remoteconfig.RenderGrafanaPrometheusDataSourceConfigg()
+	if err != nil {
+		return err
+	}
+	dashboardsConfig, err := remoteconfig.RenderGrafanaDashboardConfig()
+	if err != nil {
+		return err
+	}
+	for path, content := range map[string][]byte{
+		filepath.Join(grafanaDir, "grafana.ini"):                                   grafanaConfig,
+		filepath.Join(grafanaDir, "provisioning", "datasources", "loki.yml"):       lokiDataSource,
+		filepath.Join(grafanaDir, "provisioning", "datasources", "prometheus.yml"): prometheusDataSource,
+		filepath.Join(grafanaDir, "provisioning", "dashboards", "dashboards.yml"):  dashboardsConfig,
+	} {
+		if err := os.WriteFile(path, []byte(localMonitoringAddressReplacer.Replace(string(content))), constants.WriteReadReadPerms); err != nil {
+			return err
+		}
+	}
+	return monitoring.WriteMonitoringJSONFiles(grafanaDir)
+}
+
+// replaces monitoring service addresses at config file [path] with local ones
+func localizeMonitoringConfig(path string) error {
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(localMonitoringAddressReplacer.Replace(string(bs))), constants.WriteReadReadPerms)
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/sdk/validatormanager/validatormanagertypes"
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+// DeployPlanTx is a signed P-Chain tx of a deploy plan
+type DeployPlanTx struct {
+	Kind string `json:"Kind"`
+
+	TxID ids.ID `json:"TxID"`
+
+	// fee in nAVAX, as estimated at plan time
+	Fee uint64 `json:"Fee"`
+
+	// signed tx, hex encoded
+	Tx string `json:"Tx"`
+}
+
+// DeployPlan is the outcome of a blockchain deploy or convert dry run: the signed
+// P-Chain txs to be issued in order, and the settings to be recorded on the
+// sidecar once they are accepted
+type DeployPlan struct {
+	BlockchainName string `json:"BlockchainName"`
+
+	// network name, as given by Network.Name()
+	Network string `json:"Network"`
+
+	// sha256 of the genesis used on the CreateChainTx, if included
+	GenesisSHA256 string `json:"GenesisSHA256,omitempty"`
+
+	SubnetID ids.ID `json:"SubnetID"`
+
+	BlockchainID ids.ID `json:"BlockchainID"`
+
+	// sovereign L1 settings, if the plan includes a ConvertSubnetToL1Tx
+	ValidatorManagerAddress string `json:"ValidatorManagerAddress,omitempty"`
+
+	ValidatorManagement validatormanagertypes.ValidatorManagementType `json:"ValidatorManagement,omitempty"`
+
+	ValidatorManagerOwner string `json:"ValidatorManagerOwner,omitempty"`
+
+	ProxyContractOwner string `json:"ProxyContractOwner,omitempty"`
+
+	BootstrapValidators []SubnetValidator `json:"BootstrapValidators,omitempty"`
+
+	Txs []DeployPlanTx `json:"Txs"`
+
+	// P-Chain addresses paying for the plan
+	PayingAddresses []string `json:"PayingAddresses"`
+
+	// total fees in nAVAX
+	TotalFee uint64 `json:"TotalFee"`
+
+	// total bootstrap validators balance in nAVAX
+	TotalValidatorBalance uint64 `json:"TotalValidatorBalance"`
+
+	SignedOffAt time.Time `json:"SignedOffAt"`
+
+	// sha256 of the plan contents, set on sign off. It is an integrity check only:
+	// anyone editing the plan can recompute it
+	Digest string `json:"Digest"`
+}
+
+// ComputeDigest returns the sha256 of the plan contents, excluding the digest itself
+func (p DeployPlan) ComputeDigest() (string, error) {
+	p.Digest = ""
+	planBytes, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256(planBytes)
+	return hex.EncodeToString(digest[:]), nil
+}
+
+// IsSignedOff tells if the plan was signed off and not modified afterwards. As the digest
+// is not a signature, modifications that also update it are not detected
+func (p DeployPlan) IsSignedOff() (bool, error) {
+	if p.SignedOffAt.IsZero() || p.Digest == "" {
+		return false, nil
+	}
+	digest, err := p.ComputeDigest()
+	if err != nil {
+		return false, err
+	}
+	return digest == p.Digest, nil
+}
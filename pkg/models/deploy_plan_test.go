@@ -0,0 +1,50 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployPlanSignOff(t *testing.T) {
+	plan := DeployPlan{
+		BlockchainName: "testl1",
+		Network:        NewFujiNetwork().Name(),
+		SubnetID:       ids.GenerateTestID(),
+		BlockchainID:   ids.GenerateTestID(),
+		Txs: []DeployPlanTx{
+			{Kind: "CreateChainTx", TxID: ids.GenerateTestID(), Fee: 1_000_000, Tx: "0x00"},
+		},
+		TotalFee: 1_000_000,
+	}
+	signedOff, err := plan.IsSignedOff()
+	require.NoError(t, err)
+	require.False(t, signedOff)
+
+	plan.SignedOffAt = time.Now().UTC()
+	plan.Digest, err = plan.ComputeDigest()
+	require.NoError(t, err)
+	signedOff, err = plan.IsSignedOff()
+	require.NoError(t, err)
+	require.True(t, signedOff)
+
+	// the digest survives a save and load roundtrip
+	planBytes, err := json.Marshal(plan)
+	require.NoError(t, err)
+	var loadedPlan DeployPlan
+	require.NoError(t, json.Unmarshal(planBytes, &loadedPlan))
+	signedOff, err = loadedPlan.IsSignedOff()
+	require.NoError(t, err)
+	require.True(t, signedOff)
+
+	// any change after sign off invalidates it
+	loadedPlan.Txs[0].Fee = 1
+	signedOff, err = loadedPlan.IsSignedOff()
+	require.NoError(t, err)
+	require.False(t, signedOff)
+}
@@ -19,6 +19,7 @@ type NetworkData struct {
 	BootstrapValidators        []SubnetValidator
 	ClusterName                string
 	ValidatorManagerAddress    string
+	RewardCalculatorAddress    string
 }
 
 type Sidecar struct {
@@ -38,6 +39,9 @@ type Sidecar struct {
 	CustomVMRepoURL     string
 	CustomVMBranch      string
 	CustomVMBuildScript string
+	// commit the custom VM build is pinned to, and SHA-256 of the resulting binary
+	CustomVMCommit       string
+	CustomVMBinarySHA256 string
 	// ICM related
 	TeleporterReady   bool
 	TeleporterKey     string
@@ -49,6 +53,8 @@ type Sidecar struct {
 	ValidatorManagement   validatormanagertypes.ValidatorManagementType
 	ValidatorManagerOwner string
 	ProxyContractOwner    string
+	// ERC20 token staked on PoS validator managers. Native token if empty
+	StakingTokenAddress string
 	// Subnet defaults to Sovereign post ACP-77
 	Sovereign bool
 	UseACP99  bool
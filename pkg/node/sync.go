@@ -15,6 +15,7 @@ import (
 	"github.com/ava-labs/avalanche-cli/pkg/subnet"
 	"github.com/ava-labs/avalanche-cli/pkg/utils"
 	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanche-cli/pkg/vm"
 	"github.com/ava-labs/avalanchego/utils/set"
 )
 
@@ -62,13 +63,34 @@ func prepareSubnetPlugin(app *application.Avalanche, hosts []*models.Host, block
 	if err != nil {
 		return err
 	}
+	// pinned custom VMs are not rebuilt on the nodes, where the build environment differs,
+	// but copied from the locally verified binary
+	customVMBinaryPath := ""
+	if sc.VM == models.CustomVM && sc.CustomVMBinarySHA256 != "" {
+		customVMBinaryPath = app.GetCustomVMPath(blockchainName)
+		if !utils.FileExists(customVMBinaryPath) {
+			if err := vm.BuildCustomVM(app, &sc); err != nil {
+				return err
+			}
+		}
+		if err := vm.VerifyCustomVMBinary(sc, customVMBinaryPath); err != nil {
+			return err
+		}
+	}
 	wg := sync.WaitGroup{}
 	wgResults := models.NodeResults{}
 	for _, host := range hosts {
 		wg.Add(1)
 		go func(nodeResults *models.NodeResults, host *models.Host) {
 			defer wg.Done()
-			if err := ssh.RunSSHCreatePlugin(host, sc); err != nil {
+			if customVMBinaryPath != "" {
+				goArch, goOS := ssh.NewHostInstaller(host).GetArch()
+				if err := vm.CheckCustomVMBinaryPlatform(customVMBinaryPath, goOS, goArch); err != nil {
+					nodeResults.AddResult(host.NodeID, nil, err)
+					return
+				}
+			}
+			if err := ssh.RunSSHCreatePlugin(host, sc, customVMBinaryPath); err != nil {
 				nodeResults.AddResult(host.NodeID, nil, err)
 			}
 		}(&wgResults, host)
@@ -13,6 +13,7 @@ import (
 	"github.com/ava-labs/avalanche-cli/pkg/binutils"
 	"github.com/ava-labs/avalanche-cli/pkg/models"
 	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	"github.com/ava-labs/avalanche-cli/pkg/vm"
 )
 
 func SanitizePath(path string) (string, error) {
@@ -50,16 +51,9 @@ func CreatePlugin(app *application.Avalanche, subnetName string, pluginDir strin
 			return "", fmt.Errorf("failed to create VM ID from %s: %w", subnetName, err)
 		}
 
-		switch sc.VM {
-		case models.SubnetEvm:
-			_, vmSourcePath, err = binutils.SetupSubnetEVM(app, sc.VMVersion)
-			if err != nil {
-				return "", fmt.Errorf("failed to install subnet-evm: %w", err)
-			}
-		case models.CustomVM:
-			vmSourcePath = binutils.SetupCustomBin(app, subnetName)
-		default:
-			return "", fmt.Errorf("unknown vm: %s", sc.VM)
+		vmSourcePath, err = vm.SetupVMBinary(app, sc.VM, subnetName, sc.VMVersion)
+		if err != nil {
+			return "", err
 		}
 		vmDestPath = filepath.Join(pluginDir, chainVMID.String())
 	}
@@ -71,27 +65,16 @@ func CreatePlugin(app *application.Avalanche, subnetName string, pluginDir strin
 func CreatePluginFromVersion(
 	app *application.Avalanche,
 	subnetName string,
-	vm models.VMType,
+	vmType models.VMType,
 	version string,
 	vmid string,
 	pluginDir string,
 ) (string, error) {
-	var vmSourcePath string
-	var vmDestPath string
-	var err error
-
-	switch vm {
-	case models.SubnetEvm:
-		_, vmSourcePath, err = binutils.SetupSubnetEVM(app, version)
-		if err != nil {
-			return "", fmt.Errorf("failed to install subnet-evm: %w", err)
-		}
-	case models.CustomVM:
-		vmSourcePath = binutils.SetupCustomBin(app, subnetName)
-	default:
-		return "", fmt.Errorf("unknown vm: %s", vm)
+	vmSourcePath, err := vm.SetupVMBinary(app, vmType, subnetName, version)
+	if err != nil {
+		return "", err
 	}
-	vmDestPath = filepath.Join(pluginDir, vmid)
+	vmDestPath := filepath.Join(pluginDir, vmid)
 
 	return vmDestPath, binutils.CopyFile(vmSourcePath, vmDestPath)
 }
@@ -609,8 +609,10 @@ func RunSSHRenderAvalancheNodeConfig(
 	return host.UploadBytes(nodeConf, remoteconfig.GetRemoteAvalancheNodeConfig(), constants.SSHFileOpsTimeout)
 }
 
-// RunSSHCreatePlugin runs script to create plugin
-func RunSSHCreatePlugin(host *models.Host, sc models.Sidecar) error {
+// RunSSHCreatePlugin runs script to create plugin. For custom VMs, [customVMBinaryPath] is
+// a local binary, already verified against the pinned hash, that is uploaded to the node.
+// If it is empty, the custom VM is built on the node
+func RunSSHCreatePlugin(host *models.Host, sc models.Sidecar, customVMBinaryPath string) error {
 	vmID, err := sc.GetVMID()
 	if err != nil {
 		return err
@@ -625,9 +627,34 @@ func RunSSHCreatePlugin(host *models.Host, sc models.Sidecar) error {
 		_ = h.Remove(tmpDir, true)
 	}(host)
 	switch {
+	case sc.VM == models.CustomVM && customVMBinaryPath != "":
+		ux.Logger.Info("Uploading Custom VM binary %s for %s to %s", customVMBinaryPath, host.NodeID, subnetVMBinaryPath)
+		tmpBinaryPath := filepath.Join(tmpDir, filepath.Base(subnetVMBinaryPath))
+		if err := host.Upload(customVMBinaryPath, tmpBinaryPath, constants.SSHFileOpsTimeout); err != nil {
+			return err
+		}
+		if err := host.MkdirAll(filepath.Dir(subnetVMBinaryPath), constants.SSHFileOpsTimeout); err != nil {
+			return err
+		}
+		if _, err := host.Command(
+			fmt.Sprintf("chmod +x %s && mv -f %s %s", tmpBinaryPath, tmpBinaryPath, subnetVMBinaryPath),
+			nil,
+			constants.SSHScriptTimeout,
+		); err != nil {
+			return err
+		}
+		if err := checkRemoteCustomVMBinaryHash(host, sc, subnetVMBinaryPath); err != nil {
+			return err
+		}
+
 	case sc.VM == models.CustomVM:
+		// build the pinned commit if there is one
+		ref := sc.CustomVMBranch
+		if sc.CustomVMCommit != "" {
+			ref = sc.CustomVMCommit
+		}
 		ux.Logger.Info("Building Custom VM for %s to %s", host.NodeID, subnetVMBinaryPath)
-		ux.Logger.Info("Custom VM Params: repo %s branch %s via %s", sc.CustomVMRepoURL, sc.CustomVMBranch, sc.CustomVMBuildScript)
+		ux.Logger.Info("Custom VM Params: repo %s branch %s via %s", sc.CustomVMRepoURL, ref, sc.CustomVMBuildScript)
 		if err := RunOverSSH(
 			"Build CustomVM",
 			host,
@@ -636,7 +663,7 @@ func RunSSHCreatePlugin(host *models.Host, sc models.Sidecar) error {
 			scriptInputs{
 				CustomVMRepoDir:     tmpDir,
 				CustomVMRepoURL:     sc.CustomVMRepoURL,
-				CustomVMBranch:      sc.CustomVMBranch,
+				CustomVMBranch:      ref,
 				CustomVMBuildScript: sc.CustomVMBuildScript,
 				VMBinaryPath:        subnetVMBinaryPath,
 				GoVersion:           constants.BuildEnvGolangVersion,
@@ -644,6 +671,9 @@ func RunSSHCreatePlugin(host *models.Host, sc models.Sidecar) error {
 		); err != nil {
 			return err
 		}
+		if err := checkRemoteCustomVMBinaryHash(host, sc, subnetVMBinaryPath); err != nil {
+			return err
+		}
 
 	case sc.VM == models.SubnetEvm:
 		ux.Logger.Info("Installing Subnet EVM for %s", host.NodeID)
@@ -674,6 +704,34 @@ func RunSSHCreatePlugin(host *models.Host, sc models.Sidecar) error {
 	return nil
 }
 
+// checkRemoteCustomVMBinaryHash checks that the custom VM binary at [vmBinaryPath] on [host]
+// has the SHA-256 pinned on [sc], removing it if it does not
+func checkRemoteCustomVMBinaryHash(host *models.Host, sc models.Sidecar, vmBinaryPath string) error {
+	if sc.CustomVMBinarySHA256 == "" {
+		return nil
+	}
+	out, err := host.Command(fmt.Sprintf("sha256sum %s", vmBinaryPath), nil, constants.SSHScriptTimeout)
+	if err != nil {
+		return err
+	}
+	fields := strings.Fields(string(out))
+	if len(fields) == 0 {
+		return fmt.Errorf("unexpected empty output computing SHA-256 of %s on %s", vmBinaryPath, host.NodeID)
+	}
+	if fields[0] != sc.CustomVMBinarySHA256 {
+		_, _ = host.Command(fmt.Sprintf("rm -f %s", vmBinaryPath), nil, constants.SSHScriptTimeout)
+		return fmt.Errorf(
+			"custom VM binary on %s has SHA-256 %s but blockchain %s is pinned to %s (commit %s)",
+			host.NodeID,
+			fields[0],
+			sc.Name,
+			sc.CustomVMBinarySHA256,
+			sc.CustomVMCommit,
+		)
+	}
+	return nil
+}
+
 // RunSSHMergeSubnetNodeConfig merges subnet node config to the node config on the remote host
 func mergeSubnetNodeConfig(host *models.Host, subnetNodeConfigPath string) error {
 	if subnetNodeConfigPath == "" {
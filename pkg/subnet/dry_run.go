@@ -0,0 +1,179 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package subnet
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/key"
+	"github.com/ava-labs/avalanche-cli/pkg/keychain"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/txutils"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanchego/ids"
+	avagoconstants "github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	pbuilder "github.com/ava-labs/avalanchego/wallet/chain/p/builder"
+	psigner "github.com/ava-labs/avalanchego/wallet/chain/p/signer"
+	pwallet "github.com/ava-labs/avalanchego/wallet/chain/p/wallet"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+// NewDryRunPublicDeployer returns a deployer that builds and signs txs as usual, but
+// instead of issuing them, accepts them on a local wallet only. This way, later txs
+// can depend on earlier ones, as a CreateChainTx does on its CreateSubnetTx
+func NewDryRunPublicDeployer(app *application.Avalanche, kc *keychain.Keychain, network models.Network) *PublicDeployer {
+	d := NewPublicDeployer(app, kc, network)
+	d.dryRun = true
+	return d
+}
+
+func (d *PublicDeployer) DryRun() bool {
+	return d.dryRun
+}
+
+// DryRunTxs returns the txs committed on the dry run, in order
+func (d *PublicDeployer) DryRunTxs() []models.DeployPlanTx {
+	return d.dryRunTxs
+}
+
+// dryRunClient accepts txs on the wallet backend without issuing them
+type dryRunClient struct {
+	backend pwallet.Backend
+}
+
+func (c *dryRunClient) IssueTx(tx *txs.Tx, options ...common.Option) error {
+	ops := common.NewOptions(options)
+	return c.backend.AcceptTx(ops.Context(), tx)
+}
+
+// same as primary.MakePWallet, but using a dry run client
+func (d *PublicDeployer) loadDryRunWallet(ctx context.Context, subnetIDs []ids.ID) (*primary.Wallet, error) {
+	addrs := d.kc.Addresses()
+	client, pContext, utxos, err := primary.FetchPState(ctx, d.network.Endpoint, addrs)
+	if err != nil {
+		return nil, err
+	}
+	owners, err := platformvm.GetOwners(client, ctx, subnetIDs, nil)
+	if err != nil {
+		return nil, err
+	}
+	pUTXOs := common.NewChainUTXOs(avagoconstants.PlatformChainID, utxos)
+	d.dryRunBackend = pwallet.NewBackend(pContext, pUTXOs, owners)
+	pWallet := pwallet.New(
+		&dryRunClient{backend: d.dryRunBackend},
+		pbuilder.New(addrs, pContext, d.dryRunBackend),
+		psigner.New(d.kc.Keychain, d.dryRunBackend),
+	)
+	return primary.NewWallet(pWallet, nil, nil), nil
+}
+
+func (d *PublicDeployer) dryRunCommit(tx *txs.Tx) (ids.ID, error) {
+	wallet, err := d.loadCacheWallet()
+	if err != nil {
+		return ids.Empty, err
+	}
+	fee, err := calculateFee(wallet, tx.Unsigned)
+	if err != nil {
+		return ids.Empty, err
+	}
+	txStr, err := txutils.Encode(tx)
+	if err != nil {
+		return ids.Empty, err
+	}
+	if err := wallet.P().IssueTx(tx); err != nil {
+		return ids.Empty, err
+	}
+	d.dryRunTxs = append(d.dryRunTxs, models.DeployPlanTx{
+		Kind: reflect.TypeOf(tx.Unsigned).Elem().Name(),
+		TxID: tx.ID(),
+		Fee:  fee,
+		Tx:   txStr,
+	})
+	return tx.ID(), nil
+}
+
+// GetSubnetOwners returns the control keys, in tx order, and the threshold of [subnetID].
+// On dry runs, subnets created by the dry run itself are also considered
+func (d *PublicDeployer) GetSubnetOwners(subnetID ids.ID) ([]string, uint32, error) {
+	if !d.dryRun || d.dryRunBackend == nil {
+		_, controlKeys, threshold, err := txutils.GetOwners(d.network, subnetID)
+		return controlKeys, threshold, err
+	}
+	owner, err := d.dryRunBackend.GetOwner(context.Background(), subnetID)
+	if err != nil {
+		return nil, 0, err
+	}
+	outputOwners, ok := owner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return nil, 0, fmt.Errorf("unexpected owner type %T for subnet %s", owner, subnetID)
+	}
+	hrp := key.GetHRP(d.network.ID)
+	controlKeys := []string{}
+	for _, addr := range outputOwners.Addrs {
+		addrStr, err := address.Format("P", hrp, addr[:])
+		if err != nil {
+			return nil, 0, err
+		}
+		controlKeys = append(controlKeys, addrStr)
+	}
+	return controlKeys, outputOwners.Threshold, nil
+}
+
+// IssueDeployPlan issues the txs of [plan] on [network], in order, waiting for each one
+// to be accepted. Txs already accepted, as on a previous partial run, are skipped
+func IssueDeployPlan(network models.Network, plan models.DeployPlan) error {
+	pClient := platformvm.NewClient(network.Endpoint)
+	for _, planTx := range plan.Txs {
+		if err := issueDeployPlanTx(pClient, planTx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// issues [planTx], if not already accepted, and waits for its acceptance
+func issueDeployPlanTx(pClient platformvm.Client, planTx models.DeployPlanTx) error {
+	tx, err := txutils.Decode(planTx.Tx)
+	if err != nil {
+		return err
+	}
+	if tx.ID() != planTx.TxID {
+		return fmt.Errorf("%s ID mismatch: expected %s but got %s", planTx.Kind, planTx.TxID, tx.ID())
+	}
+	ctx, cancel := utils.GetAPILargeContext()
+	defer cancel()
+	txStatus, err := pClient.GetTxStatus(ctx, tx.ID())
+	if err != nil {
+		return err
+	}
+	if txStatus.Status == status.Committed {
+		ux.Logger.PrintToUser("%s %s was already accepted", planTx.Kind, tx.ID())
+		return nil
+	}
+	ux.Logger.PrintToUser("Issuing %s %s...", planTx.Kind, tx.ID())
+	if _, err := pClient.IssueTx(ctx, tx.Bytes()); err != nil {
+		return fmt.Errorf("error issuing %s %s: %w", planTx.Kind, tx.ID(), err)
+	}
+	if err := platformvm.AwaitTxAccepted(pClient, ctx, tx.ID(), time.Second); err != nil {
+		return fmt.Errorf("error waiting for %s %s acceptance: %w", planTx.Kind, tx.ID(), err)
+	}
+	txStatus, err = pClient.GetTxStatus(ctx, tx.ID())
+	if err != nil {
+		return err
+	}
+	if txStatus.Status != status.Committed {
+		return fmt.Errorf("%s %s was not accepted: %s", planTx.Kind, tx.ID(), txStatus.Reason)
+	}
+	return nil
+}
@@ -64,13 +64,7 @@ func FindErrorLogs(rootDirs ...string) {
 				thisFileNotified := false
 				for _, o := range occurrences {
 					// first apply all filters
-					skip := false
-					for _, f := range filters {
-						if strings.Contains(o, f) {
-							skip = true
-						}
-					}
-					if skip {
+					if isFilteredLog(o) {
 						continue
 					}
 					// also check if this log has already been found in another log file
@@ -104,11 +98,45 @@ func FindErrorLogs(rootDirs ...string) {
 	}
 }
 
+// IsErrorLog indicates if [s] is an error log line that is worth showing to the user
+func IsErrorLog(s string) bool {
+	return errorRegEx.MatchString(s) && !isFilteredLog(s)
+}
+
+// ErrorLogsDeduper keeps track of the error logs already seen, disregarding timestamps,
+// so as to report an error only once even if it is found on different log files
+type ErrorLogsDeduper struct {
+	found []string
+}
+
+// IsNew indicates if error log [s] was not seen before, and registers it
+func (d *ErrorLogsDeduper) IsNew(s string) bool {
+	if alreadyFound(s, d.found) {
+		return false
+	}
+	d.found = append(d.found, removeTimestamp(s))
+	return true
+}
+
+// RemoveTimestamp returns log [s] without its leading timestamp, if any
+func RemoveTimestamp(s string) string {
+	return removeTimestamp(s)
+}
+
+func isFilteredLog(s string) bool {
+	for _, f := range filters {
+		if strings.Contains(s, f) {
+			return true
+		}
+	}
+	return false
+}
+
 func removeTimestamp(s string) string {
 	// first let's make sure this string follows the usual avalanchego timestamp structure
 	// the same log in a different file most likely will have a different timestamp
 	// log has format `[timestamp] log text`
-	if s[:1] == timestampStart {
+	if strings.HasPrefix(s, timestampStart) {
 		split := strings.SplitAfter(s, timestampEnd)
 		if len(split) >= 2 {
 			return split[1]
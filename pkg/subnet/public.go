@@ -33,6 +33,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	pwallet "github.com/ava-labs/avalanchego/wallet/chain/p/wallet"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
 )
@@ -46,6 +47,10 @@ type PublicDeployer struct {
 	network models.Network
 	app     *application.Avalanche
 	wallet  *primary.Wallet
+	// dry run deployers build and sign txs, without issuing them
+	dryRun        bool
+	dryRunBackend pwallet.Backend
+	dryRunTxs     []models.DeployPlanTx
 }
 
 func NewPublicDeployer(app *application.Avalanche, kc *keychain.Keychain, network models.Network) *PublicDeployer {
@@ -393,6 +398,10 @@ func (d *PublicDeployer) DeploySubnet(
 	if err != nil {
 		return ids.Empty, err
 	}
+	if d.dryRun {
+		ux.Logger.PrintToUser("Blockchain is to be created with ID: %s", subnetID.String())
+		return subnetID, nil
+	}
 	ux.Logger.PrintToUser("Blockchain has been created with ID: %s", subnetID.String())
 	time.Sleep(2 * time.Second)
 	return subnetID, nil
@@ -545,6 +554,9 @@ func (d *PublicDeployer) Commit(
 		issueTxErr error
 		errors     []error
 	)
+	if d.dryRun {
+		return d.dryRunCommit(tx)
+	}
 	wallet, err := d.loadCacheWallet()
 	if err != nil {
 		return ids.Empty, err
@@ -609,6 +621,17 @@ func (d *PublicDeployer) loadWallet(subnetIDs ...ids.ID) (*primary.Wallet, error
 	ctx := context.Background()
 	// filter out ids.Empty txs
 	filteredTxs := utils.Filter(subnetIDs, func(e ids.ID) bool { return e != ids.Empty })
+	if d.dryRun {
+		// dry run txs are only known by the wallet, so it can not be reloaded
+		if d.wallet == nil {
+			var err error
+			d.wallet, err = d.loadDryRunWallet(ctx, filteredTxs)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return d.wallet, nil
+	}
 	wallet, err := primary.MakeWallet(
 		ctx,
 		d.network.Endpoint,
@@ -625,6 +648,9 @@ func (d *PublicDeployer) loadWallet(subnetIDs ...ids.ID) (*primary.Wallet, error
 }
 
 func (d *PublicDeployer) CleanCacheWallet() {
+	if d.dryRun {
+		return
+	}
 	d.wallet = nil
 	// wait some amount of time to avoid consumed utxos to be retrieved as free ones
 	time.Sleep(5 * time.Second)
@@ -948,11 +974,8 @@ func (d *PublicDeployer) increaseValidatorPChainBalance(validationID ids.ID, bal
 
 func printFee(kind string, wallet *primary.Wallet, unsignedTx txs.UnsignedTx) error {
 	if showFees {
-		var pFeeCalculator avagofee.Calculator
-		pContext := wallet.P().Builder().Context()
 		calcKind := "dynamic"
-		pFeeCalculator = avagofee.NewDynamicCalculator(pContext.ComplexityWeights, pContext.GasPrice)
-		txFee, err := pFeeCalculator.CalculateFee(unsignedTx)
+		txFee, err := calculateFee(wallet, unsignedTx)
 		if err != nil {
 			if !errors.Is(err, avagofee.ErrUnsupportedTx) {
 				return err
@@ -965,6 +988,13 @@ func printFee(kind string, wallet *primary.Wallet, unsignedTx txs.UnsignedTx) er
 	return nil
 }
 
+// calculates the dynamic fee of [unsignedTx], as given by the current [wallet] context
+func calculateFee(wallet *primary.Wallet, unsignedTx txs.UnsignedTx) (uint64, error) {
+	pContext := wallet.P().Builder().Context()
+	pFeeCalculator := avagofee.NewDynamicCalculator(pContext.ComplexityWeights, pContext.GasPrice)
+	return pFeeCalculator.CalculateFee(unsignedTx)
+}
+
 func (d *PublicDeployer) getSubnetAuthAddressesInWallet(subnetAuth []ids.ShortID) []ids.ShortID {
 	walletAddrs := d.kc.Addresses().List()
 	subnetAuthInWallet := []ids.ShortID{}
@@ -1121,3 +1151,23 @@ func (d *PublicDeployer) IncreaseValidatorPChainBalance(
 	ux.Logger.PrintToUser("Validator balance has been increased with tx ID: %s", txID.String())
 	return txID, nil
 }
+
+// IncreaseValidatorPChainBalanceFee returns the fee of increasing the P-Chain balance of
+// [validationID] by [balance], at the current P-Chain gas price
+func (d *PublicDeployer) IncreaseValidatorPChainBalanceFee(
+	validationID ids.ID,
+	balance uint64,
+) (uint64, error) {
+	wallet, err := d.loadWallet()
+	if err != nil {
+		return 0, err
+	}
+	unsignedTx, err := wallet.P().Builder().NewIncreaseL1ValidatorBalanceTx(
+		validationID,
+		balance,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("error building tx: %w", err)
+	}
+	return calculateFee(wallet, unsignedTx)
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package topup
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+// Policy tells which L1 validators get their P-Chain balance topped up, and by how much
+type Policy struct {
+	// validators with less runway than this are topped up
+	MinRunway time.Duration
+	// runway validators are topped up to
+	TargetRunway time.Duration
+}
+
+// ValidatorBalance is the P-Chain balance of an L1 validator, in nAVAX
+type ValidatorBalance struct {
+	ValidationID ids.ID
+	NodeID       ids.NodeID
+	Balance      uint64
+}
+
+// TopUp is a balance increase for an L1 validator, in nAVAX
+type TopUp struct {
+	ValidationID ids.ID
+	NodeID       ids.NodeID
+	Runway       time.Duration
+	Amount       uint64
+}
+
+// Runway returns the time until [balance] runs out, paying a continuous fee of [feeRate]
+// nAVAX per second
+func Runway(balance uint64, feeRate uint64) time.Duration {
+	if feeRate == 0 {
+		return time.Duration(math.MaxInt64)
+	}
+	seconds := balance / feeRate
+	if seconds > uint64(math.MaxInt64/int64(time.Second)) {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// Plan returns the top ups needed by [validators] at the current [feeRate], lowest runway
+// first. Each top up costs its amount plus [txFee], and total cost is limited to [budget]:
+// validators that do not fit into it, are left out
+func (p Policy) Plan(validators []ValidatorBalance, feeRate uint64, txFee uint64, budget uint64) []TopUp {
+	topUps := []TopUp{}
+	targetBalance := feeRate * uint64(p.TargetRunway/time.Second)
+	for _, validator := range validators {
+		runway := Runway(validator.Balance, feeRate)
+		if runway >= p.MinRunway || validator.Balance >= targetBalance {
+			continue
+		}
+		topUps = append(topUps, TopUp{
+			ValidationID: validator.ValidationID,
+			NodeID:       validator.NodeID,
+			Runway:       runway,
+			Amount:       targetBalance - validator.Balance,
+		})
+	}
+	sort.SliceStable(topUps, func(i, j int) bool {
+		return topUps[i].Runway < topUps[j].Runway
+	})
+	planned := []TopUp{}
+	for _, topUp := range topUps {
+		if topUp.Amount > budget || txFee > budget-topUp.Amount {
+			continue
+		}
+		budget -= topUp.Amount + txFee
+		planned = append(planned, topUp)
+	}
+	return planned
+}
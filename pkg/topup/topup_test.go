@@ -0,0 +1,66 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package topup
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/stretchr/testify/require"
+)
+
+const day = 24 * time.Hour
+
+func TestRunway(t *testing.T) {
+	require.Equal(t, time.Duration(math.MaxInt64), Runway(100, 0))
+	require.Equal(t, 10*time.Second, Runway(100, 10))
+	require.Equal(t, 9*time.Second, Runway(99, 10))
+	require.Equal(t, time.Duration(math.MaxInt64), Runway(math.MaxUint64, 1))
+}
+
+func TestPlan(t *testing.T) {
+	const feeRate = 512
+	dailyFee := uint64(feeRate * day / time.Second)
+	policy := Policy{
+		MinRunway:    7 * day,
+		TargetRunway: 30 * day,
+	}
+	healthy := ValidatorBalance{ValidationID: ids.GenerateTestID(), Balance: 10 * dailyFee}
+	low := ValidatorBalance{ValidationID: ids.GenerateTestID(), Balance: 5 * dailyFee}
+	depleted := ValidatorBalance{ValidationID: ids.GenerateTestID()}
+	validators := []ValidatorBalance{healthy, low, depleted}
+
+	topUps := policy.Plan(validators, feeRate, 0, math.MaxUint64)
+	require.Len(t, topUps, 2)
+	require.Equal(t, depleted.ValidationID, topUps[0].ValidationID)
+	require.Equal(t, 30*dailyFee, topUps[0].Amount)
+	require.Equal(t, low.ValidationID, topUps[1].ValidationID)
+	require.Equal(t, 25*dailyFee, topUps[1].Amount)
+	require.Equal(t, 5*day, topUps[1].Runway)
+
+	// lowest runway is topped up first, and validators that do not fit into the budget are skipped
+	topUps = policy.Plan(validators, feeRate, 0, 29*dailyFee)
+	require.Len(t, topUps, 1)
+	require.Equal(t, low.ValidationID, topUps[0].ValidationID)
+	topUps = policy.Plan(validators, feeRate, 0, 30*dailyFee)
+	require.Len(t, topUps, 1)
+	require.Equal(t, depleted.ValidationID, topUps[0].ValidationID)
+
+	// tx fees are paid from the budget too
+	const txFee = 1000
+	topUps = policy.Plan(validators, feeRate, txFee, 30*dailyFee)
+	require.Len(t, topUps, 1)
+	require.Equal(t, low.ValidationID, topUps[0].ValidationID)
+	topUps = policy.Plan(validators, feeRate, txFee, 30*dailyFee+txFee)
+	require.Len(t, topUps, 1)
+	require.Equal(t, depleted.ValidationID, topUps[0].ValidationID)
+	topUps = policy.Plan(validators, feeRate, txFee, 55*dailyFee+2*txFee-1)
+	require.Len(t, topUps, 1)
+	topUps = policy.Plan(validators, feeRate, txFee, 55*dailyFee+2*txFee)
+	require.Len(t, topUps, 2)
+	require.Empty(t, policy.Plan(validators, feeRate, math.MaxUint64, math.MaxUint64))
+
+	require.Empty(t, policy.Plan(validators, 0, 0, math.MaxUint64))
+}
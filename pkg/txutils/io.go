@@ -12,16 +12,9 @@ import (
 
 // saves a given [tx] to [txPath]
 func SaveToDisk(tx *txs.Tx, txPath string, forceOverwrite bool) error {
-	// Serialize the signed tx
-	txBytes, err := txs.Codec.Marshal(txs.CodecVersion, tx)
-	if err != nil {
-		return fmt.Errorf("couldn't marshal signed tx: %w", err)
-	}
-
-	// Get the encoded (in hex + checksum) signed tx
-	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	txStr, err := Encode(tx)
 	if err != nil {
-		return fmt.Errorf("couldn't encode signed tx: %w", err)
+		return err
 	}
 	// save
 	if _, err := os.Stat(txPath); err == nil && !forceOverwrite {
@@ -45,7 +38,27 @@ func LoadFromDisk(txPath string) (*txs.Tx, error) {
 	if err != nil {
 		return nil, err
 	}
-	txBytes, err := formatting.Decode(formatting.Hex, string(txEncodedBytes))
+	return Decode(string(txEncodedBytes))
+}
+
+// encodes a given [tx] in hex + checksum format
+func Encode(tx *txs.Tx) (string, error) {
+	// Serialize the signed tx
+	txBytes, err := txs.Codec.Marshal(txs.CodecVersion, tx)
+	if err != nil {
+		return "", fmt.Errorf("couldn't marshal signed tx: %w", err)
+	}
+	// Get the encoded (in hex + checksum) signed tx
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return "", fmt.Errorf("couldn't encode signed tx: %w", err)
+	}
+	return txStr, nil
+}
+
+// decodes a tx given in hex + checksum format
+func Decode(txStr string) (*txs.Tx, error) {
+	txBytes, err := formatting.Decode(formatting.Hex, txStr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't decode signed tx: %w", err)
 	}
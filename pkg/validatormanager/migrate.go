@@ -0,0 +1,379 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatormanager
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	"github.com/ava-labs/avalanche-cli/sdk/evm"
+	validatorManagerSDK "github.com/ava-labs/avalanche-cli/sdk/validatormanager"
+	"github.com/ava-labs/avalanche-cli/sdk/validatormanager/validatormanagertypes"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// OpenZeppelin v5 Initializable storage, whose first field is the initialized version
+	initializableSlot = common.HexToHash("0xf0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00")
+	// EIP-1967 implementation slot of the transparent proxy
+	proxyImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	// PoSValidatorManagerStorage _posValidatorInfo mapping, as given by the v1.0.0 storage layout
+	posValidatorInfoSlot = common.HexToHash("0x4317713f7ecbdddd4bc99e95d903adedaa883b2e7c2551610bd13e2c7e473d06")
+)
+
+// v1.0.0 validator struct, as returned by getValidator
+const getValidatorV1ABI = `[{
+	"type": "function",
+	"name": "getValidator",
+	"stateMutability": "view",
+	"inputs": [{"name": "validationID", "type": "bytes32", "internalType": "bytes32"}],
+	"outputs": [{
+		"name": "",
+		"type": "tuple",
+		"internalType": "struct Validator",
+		"components": [
+			{"name": "status", "type": "uint8", "internalType": "enum ValidatorStatus"},
+			{"name": "nodeID", "type": "bytes", "internalType": "bytes"},
+			{"name": "startingWeight", "type": "uint64", "internalType": "uint64"},
+			{"name": "messageNonce", "type": "uint64", "internalType": "uint64"},
+			{"name": "weight", "type": "uint64", "internalType": "uint64"},
+			{"name": "startedAt", "type": "uint64", "internalType": "uint64"},
+			{"name": "endedAt", "type": "uint64", "internalType": "uint64"}
+		]
+	}]
+}]`
+
+// PoSMigration holds the settings to migrate the PoA validator manager behind the
+// transparent proxy to a native token PoS validator manager
+type PoSMigration struct {
+	RPCURL   string
+	SubnetID ids.ID
+	// pays for the contract deployments
+	PrivateKey string
+	// upgrades the proxy, and pays for the stake of the carried over validators
+	ProxyAdminOwnerPrivateKey string
+	// if PoSParams has no reward calculator address, a reward calculator with
+	// RewardBasisPoints is deployed
+	PoSParams         validatorManagerSDK.PoSParams
+	RewardBasisPoints uint64
+	// validators expected to be carried over to the PoS manager
+	ValidationIDs []ids.ID
+	// PoS owner of the carried over validators. Defaults to the proxy admin owner
+	ValidatorOwner common.Address
+}
+
+// migratedValidator is an active PoA validator carried over to the PoS manager
+type migratedValidator struct {
+	ValidationID   ids.ID
+	StartingWeight uint64
+}
+
+// posMigratorInitCode returns the init code of a contract to be set as a transient
+// implementation of the validator manager proxy by ProxyAdmin.upgradeAndCall. It only
+// accepts delegate calls made by the proxy on behalf of [proxyAdmin]. Call data is:
+// n, followed by n (slot, value) storage writes, followed by the call data to send to
+// the proxy itself, that reaches the implementation set by the storage writes.
+// Any failure is bubbled up, so the whole upgrade is reverted
+func posMigratorInitCode(proxyAdmin common.Address) []byte {
+	runtime := []byte{0x73} // PUSH20 proxyAdmin
+	runtime = append(runtime, proxyAdmin.Bytes()...)
+	runtime = append(runtime,
+		0x33,       // 21: CALLER
+		0x14,       // 22: EQ
+		0x60, 0x1d, // 23: PUSH1 29
+		0x57,       // 25: JUMPI
+		0x5f,       // 26: PUSH0
+		0x5f,       // 27: PUSH0
+		0xfd,       // 28: REVERT
+		0x5b,       // 29: JUMPDEST
+		0x5f,       // 30: PUSH0
+		0x35,       // 31: CALLDATALOAD             n
+		0x60, 0x06, // 32: PUSH1 6
+		0x1b,       // 34: SHL                      n*64
+		0x60, 0x20, // 35: PUSH1 32
+		0x01,       // 37: ADD                      end = 32 + n*64
+		0x60, 0x20, // 38: PUSH1 32                 p = 32
+		0x5b,       // 40: JUMPDEST                 loop: end p
+		0x81,       // 41: DUP2
+		0x81,       // 42: DUP2
+		0x10,       // 43: LT                       p < end
+		0x15,       // 44: ISZERO
+		0x60, 0x3e, // 45: PUSH1 62
+		0x57,       // 47: JUMPI
+		0x80,       // 48: DUP1
+		0x60, 0x20, // 49: PUSH1 32
+		0x01,       // 51: ADD
+		0x35,       // 52: CALLDATALOAD             value = calldata[p+32]
+		0x81,       // 53: DUP2
+		0x35,       // 54: CALLDATALOAD             slot = calldata[p]
+		0x55,       // 55: SSTORE
+		0x60, 0x40, // 56: PUSH1 64
+		0x01,       // 58: ADD                      p += 64
+		0x60, 0x28, // 59: PUSH1 40
+		0x56,       // 61: JUMP
+		0x5b,       // 62: JUMPDEST                 end p
+		0x50,       // 63: POP
+		0x80,       // 64: DUP1
+		0x36,       // 65: CALLDATASIZE
+		0x03,       // 66: SUB                      len = calldatasize - end
+		0x80,       // 67: DUP1
+		0x91,       // 68: SWAP2
+		0x5f,       // 69: PUSH0
+		0x37,       // 70: CALLDATACOPY             memory[0:len] = calldata[end:]
+		0x5f,       // 71: PUSH0
+		0x5f,       // 72: PUSH0
+		0x91,       // 73: SWAP2
+		0x5f,       // 74: PUSH0
+		0x5f,       // 75: PUSH0
+		0x30,       // 76: ADDRESS
+		0x5a,       // 77: GAS
+		0xf1,       // 78: CALL                     address(this).call(memory[0:len])
+		0x3d,       // 79: RETURNDATASIZE
+		0x5f,       // 80: PUSH0
+		0x5f,       // 81: PUSH0
+		0x3e,       // 82: RETURNDATACOPY
+		0x60, 0x59, // 83: PUSH1 89
+		0x57, // 85: JUMPI
+		0x3d, // 86: RETURNDATASIZE
+		0x5f, // 87: PUSH0
+		0xfd, // 88: REVERT
+		0x5b, // 89: JUMPDEST
+		0x00, // 90: STOP
+	)
+	// PUSH1 len DUP1 PUSH1 offset PUSH0 CODECOPY PUSH0 RETURN
+	const initCodeLen = 9
+	initCode := []byte{0x60, byte(len(runtime)), 0x80, 0x60, initCodeLen, 0x5f, 0x39, 0x5f, 0xf3}
+	return append(initCode, runtime...)
+}
+
+// posValidatorInfoOwnerSlot returns the slot holding the owner, delegation fee, and
+// minimum stake duration of [validationID] on the PoS validator manager storage
+func posValidatorInfoOwnerSlot(validationID ids.ID) common.Hash {
+	return crypto.Keccak256Hash(validationID[:], posValidatorInfoSlot.Bytes())
+}
+
+// posValidatorInfoOwnerValue packs the PoS validator info fields sharing the owner slot
+func posValidatorInfoOwnerValue(owner common.Address, delegationFeeBips uint16, minStakeDuration uint64) common.Hash {
+	value := new(big.Int).SetUint64(minStakeDuration)
+	value.Lsh(value, 16)
+	value.Or(value, new(big.Int).SetUint64(uint64(delegationFeeBips)))
+	value.Lsh(value, 160)
+	value.Or(value, new(big.Int).SetBytes(owner.Bytes()))
+	return common.BigToHash(value)
+}
+
+// posMigrationCallData returns the call data for the migrator, that resets the initialization
+// of the proxy storage, sets [posImplementation] behind the proxy, gives [owner] the PoS
+// ownership of [validators], and initializes the PoS manager with [initializeCallData]
+func posMigrationCallData(
+	posImplementation common.Address,
+	owner common.Address,
+	minimumDelegationFee uint16,
+	validators []migratedValidator,
+	initializeCallData []byte,
+) []byte {
+	writes := [][2]common.Hash{
+		{initializableSlot, {}},
+		{proxyImplementationSlot, common.BytesToHash(posImplementation.Bytes())},
+	}
+	for _, validator := range validators {
+		writes = append(writes, [2]common.Hash{
+			posValidatorInfoOwnerSlot(validator.ValidationID),
+			posValidatorInfoOwnerValue(owner, minimumDelegationFee, 0),
+		})
+	}
+	callData := common.BigToHash(big.NewInt(int64(len(writes)))).Bytes()
+	for _, write := range writes {
+		callData = append(callData, write[0].Bytes()...)
+		callData = append(callData, write[1].Bytes()...)
+	}
+	return append(callData, initializeCallData...)
+}
+
+// getValidatorStartingWeight returns the weight [validationID] was registered with on the
+// v1.0.0 validator manager at [managerAddress]
+func getValidatorStartingWeight(
+	rpcURL string,
+	managerAddress common.Address,
+	validationID ids.ID,
+) (uint64, error) {
+	metadata := &bind.MetaData{
+		ABI: getValidatorV1ABI,
+	}
+	abi, err := metadata.GetAbi()
+	if err != nil {
+		return 0, err
+	}
+	client, err := evm.GetClient(rpcURL)
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+	contract := bind.NewBoundContract(managerAddress, *abi, client.EthClient, client.EthClient, client.EthClient)
+	var out []interface{}
+	if err := contract.Call(&bind.CallOpts{}, &out, "getValidator", validationID); err != nil {
+		return 0, err
+	}
+	if len(out) != 1 {
+		return 0, fmt.Errorf("error at getValidator call: expected 1 return value, got %d", len(out))
+	}
+	startingWeight, ok := reflect.ValueOf(out[0]).FieldByName("StartingWeight").Interface().(uint64)
+	if !ok {
+		return 0, fmt.Errorf("error at getValidator call: unexpected return value %#v", out[0])
+	}
+	return startingWeight, nil
+}
+
+// MigratePoAToPoS deploys a native token PoS validator manager, and moves it behind the proxy
+// with a single ProxyAdmin.upgradeAndCall, that goes through a transient migrator implementation.
+// The validator set is kept on proxy storage, so the active validators in [m.ValidationIDs]
+// are carried over as is. On the same tx the migrator resets the initialization status left
+// by the PoA manager, makes [m.ValidatorOwner] the PoS owner of the carried over validators,
+// and initializes the PoS manager. The proxy admin owner deposits the value of the carried
+// over validators weights, that is unlocked to the owner when they are removed.
+// If anything fails, the proxy is kept on the PoA implementation.
+// Returns the validators that were carried over
+func MigratePoAToPoS(
+	m PoSMigration,
+	printFunc func(msg string, args ...interface{}),
+) ([]ids.ID, error) {
+	proxyAddress := common.HexToAddress(validatorManagerSDK.ProxyContractAddress)
+	managerType, err := validatorManagerSDK.GetValidatorManagerType(m.RPCURL, proxyAddress)
+	if err != nil {
+		return nil, err
+	}
+	if managerType != validatormanagertypes.ProofOfAuthority {
+		return nil, fmt.Errorf("validator manager at %s is not PoA", proxyAddress)
+	}
+	deployRewardCalculator := m.PoSParams.RewardCalculatorAddress == ""
+	if deployRewardCalculator {
+		// set once deployed
+		m.PoSParams.RewardCalculatorAddress = common.Address{}.Hex()
+	}
+	if err := m.PoSParams.Verify(); err != nil {
+		return nil, err
+	}
+	proxyAdminOwnerKey, err := crypto.HexToECDSA(m.ProxyAdminOwnerPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy admin owner private key: %w", err)
+	}
+	proxyAdminOwner := crypto.PubkeyToAddress(proxyAdminOwnerKey.PublicKey)
+	if m.ValidatorOwner == (common.Address{}) {
+		m.ValidatorOwner = proxyAdminOwner
+	}
+	poaImplementation, err := GetProxyValidatorManager(m.RPCURL)
+	if err != nil {
+		return nil, err
+	}
+	validators := []migratedValidator{}
+	deposit := big.NewInt(0)
+	for _, validationID := range m.ValidationIDs {
+		status, err := GetValidatorStatus(m.RPCURL, proxyAddress, validationID)
+		if err != nil {
+			return nil, err
+		}
+		switch status {
+		case ActiveValidatorStatus:
+		case PendingAddedValidatorStatus, PendingRemovedValidatorStatus:
+			// PoS ownership is only given to active validators
+			return nil, fmt.Errorf("validator %s has a pending operation (%s). Complete it before migrating", validationID, status)
+		default:
+			continue
+		}
+		startingWeight, err := getValidatorStartingWeight(m.RPCURL, proxyAddress, validationID)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, migratedValidator{
+			ValidationID:   validationID,
+			StartingWeight: startingWeight,
+		})
+		deposit.Add(deposit, new(big.Int).Mul(new(big.Int).SetUint64(startingWeight), m.PoSParams.WeightToValueFactor))
+	}
+
+	if deployRewardCalculator {
+		printFunc("Deploying Reward Calculator contract ...")
+		rewardCalculatorAddress, err := DeployRewardCalculatorContract(m.RPCURL, m.PrivateKey, m.RewardBasisPoints)
+		if err != nil {
+			return nil, fmt.Errorf("failure deploying reward calculator: %w", err)
+		}
+		m.PoSParams.RewardCalculatorAddress = rewardCalculatorAddress.Hex()
+	}
+	printFunc("Deploying Proof of Stake Validator Manager contract ...")
+	posImplementation, err := DeployPoSValidatorManagerContract(m.RPCURL, m.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failure deploying PoS validator manager: %w", err)
+	}
+	printFunc("Deploying migrator contract ...")
+	migrator, err := contract.DeployContract(
+		m.RPCURL,
+		m.PrivateKey,
+		[]byte(hex.EncodeToString(posMigratorInitCode(common.HexToAddress(validatorManagerSDK.ProxyAdminContractAddress)))),
+		"()",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failure deploying migrator: %w", err)
+	}
+	initializeCallData, err := validatorManagerSDK.PoSValidatorManagerInitializeCallData(m.SubnetID, m.PoSParams)
+	if err != nil {
+		return nil, err
+	}
+	printFunc(
+		"Moving proxy from PoA implementation %s to PoS implementation %s, depositing %s for the stake of %d validators owned by %s ...",
+		poaImplementation,
+		posImplementation,
+		deposit,
+		len(validators),
+		m.ValidatorOwner,
+	)
+	if _, _, err := contract.TxToMethod(
+		m.RPCURL,
+		false,
+		common.Address{},
+		m.ProxyAdminOwnerPrivateKey,
+		common.HexToAddress(validatorManagerSDK.ProxyAdminContractAddress),
+		deposit,
+		"migrate proxy to PoS",
+		validatorManagerSDK.ErrorSignatureToError,
+		"upgradeAndCall(address,address,bytes)",
+		proxyAddress,
+		migrator,
+		posMigrationCallData(posImplementation, m.ValidatorOwner, m.PoSParams.MinimumDelegationFee, validators, initializeCallData),
+	); err != nil {
+		return nil, fmt.Errorf("failure migrating to PoS, proxy kept on PoA implementation %s: %w", poaImplementation, err)
+	}
+
+	implementation, err := GetProxyValidatorManager(m.RPCURL)
+	if err != nil {
+		return nil, err
+	}
+	if implementation != posImplementation {
+		return nil, fmt.Errorf("proxy implementation is %s after migration, expected %s", implementation, posImplementation)
+	}
+	managerType, err = validatorManagerSDK.GetValidatorManagerType(m.RPCURL, proxyAddress)
+	if err != nil {
+		return nil, err
+	}
+	if managerType != validatormanagertypes.ProofOfStake {
+		return nil, fmt.Errorf("validator manager at %s is not PoS after migration", proxyAddress)
+	}
+	carried := make([]ids.ID, 0, len(validators))
+	for _, validator := range validators {
+		status, err := GetValidatorStatus(m.RPCURL, proxyAddress, validator.ValidationID)
+		if err != nil {
+			return nil, err
+		}
+		if status != ActiveValidatorStatus {
+			return nil, fmt.Errorf("validator %s was not carried over to PoS: status %s", validator.ValidationID, status)
+		}
+		carried = append(carried, validator.ValidationID)
+	}
+	return carried, nil
+}
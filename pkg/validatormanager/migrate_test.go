@@ -0,0 +1,198 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatormanager
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/internal/testutils"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	validatorManagerSDK "github.com/ava-labs/avalanche-cli/sdk/validatormanager"
+	"github.com/ava-labs/avalanche-cli/sdk/validatormanager/validatormanagertypes"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/core"
+	"github.com/ava-labs/subnet-evm/interfaces"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// ValidatorManagerStorage slots, as given by the v1.0.0 storage layout
+	churnSettingsSlot     = common.HexToHash("0xe92546d698950ddd38910d2e15ed1d923cd0a7b3dde9e2a6a3f380565559cb01")
+	validationPeriodsSlot = common.HexToHash("0xe92546d698950ddd38910d2e15ed1d923cd0a7b3dde9e2a6a3f380565559cb05")
+	registeredNodesSlot   = common.HexToHash("0xe92546d698950ddd38910d2e15ed1d923cd0a7b3dde9e2a6a3f380565559cb06")
+	// OpenZeppelin v5 Ownable storage
+	ownableSlot = common.HexToHash("0x9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300")
+)
+
+// addInitializedPoAValidatorManagerToAllocations sets the v1.0.0 PoA validator manager behind
+// the proxy, owned by [owner], with an initial validator set made of the active validator
+// [validationID] with [weight]. This is the state reached after the L1 conversion, that can't
+// be produced here as it requires P-Chain signatures
+func addInitializedPoAValidatorManagerToAllocations(
+	allocs core.GenesisAlloc,
+	owner common.Address,
+	validationID ids.ID,
+	nodeID ids.NodeID,
+	weight uint64,
+) {
+	AddTransparentProxyContractToAllocations(allocs, owner.Hex())
+	AddValidatorMessagesACP99ContractToAllocations(allocs)
+	AddPoAValidatorManagerContractToAllocations(allocs)
+	proxy := allocs[common.HexToAddress(validatorManagerSDK.ProxyContractAddress)]
+	storage := proxy.Storage
+	storage[initializableSlot] = common.BigToHash(big.NewInt(1))
+	storage[ownableSlot] = common.BytesToHash(owner.Bytes())
+	// maximum churn percentage 20, no churn period
+	storage[churnSettingsSlot] = common.BigToHash(new(big.Int).Lsh(big.NewInt(20), 64))
+	// churn tracker initialWeight (bits 0-63) and totalWeight (bits 64-127)
+	totalWeight := uint64(1_000_000)
+	weights := new(big.Int).Lsh(new(big.Int).SetUint64(totalWeight), 64)
+	storage[churnTrackerWeightsSlot] = common.BigToHash(weights.Or(weights, new(big.Int).SetUint64(totalWeight)))
+	storage[initializedValidatorSetSlot] = common.BigToHash(big.NewInt(1))
+	// validator struct: status, nodeID, startingWeight|messageNonce|weight|startedAt, endedAt
+	validatorSlot := crypto.Keccak256Hash(validationID[:], validationPeriodsSlot.Bytes()).Big()
+	storage[common.BigToHash(validatorSlot)] = common.BigToHash(big.NewInt(int64(ActiveValidatorStatus)))
+	// short bytes are stored left aligned, with twice its length on the lowest byte
+	nodeIDValue := common.Hash{}
+	copy(nodeIDValue[:], nodeID.Bytes())
+	nodeIDValue[31] = byte(2 * len(nodeID.Bytes()))
+	storage[common.BigToHash(new(big.Int).Add(validatorSlot, big.NewInt(1)))] = nodeIDValue
+	weights = new(big.Int).Lsh(new(big.Int).SetUint64(weight), 128)
+	storage[common.BigToHash(new(big.Int).Add(validatorSlot, big.NewInt(2)))] = common.BigToHash(weights.Or(weights, new(big.Int).SetUint64(weight)))
+	storage[crypto.Keccak256Hash(nodeID.Bytes(), registeredNodesSlot.Bytes())] = common.Hash(validationID)
+	allocs[common.HexToAddress(validatorManagerSDK.ProxyContractAddress)] = proxy
+}
+
+func TestMigratePoAToPoS(t *testing.T) {
+	require := testutils.SetupTest(t)
+	validationID := ids.GenerateTestID()
+	nodeID := ids.GenerateTestNodeID()
+	weight := uint64(100)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(err)
+	otherAddress := crypto.PubkeyToAddress(otherKey.PublicKey)
+	simEVM := testutils.NewSimulatedEVM(t, func(allocs core.GenesisAlloc, funded common.Address) {
+		addInitializedPoAValidatorManagerToAllocations(allocs, funded, validationID, nodeID, weight)
+		allocs[otherAddress] = core.GenesisAccount{
+			Balance: big.NewInt(1e18),
+		}
+	})
+	proxyAddress := common.HexToAddress(validatorManagerSDK.ProxyContractAddress)
+	poaImplementation := common.HexToAddress(validatorManagerSDK.ValidatorContractAddress)
+	status, err := GetValidatorStatus(simEVM.RPCURL, proxyAddress, validationID)
+	require.NoError(err)
+	require.Equal(ActiveValidatorStatus, status)
+
+	posParams := testPoSParams
+	posParams.RewardCalculatorAddress = ""
+	migration := PoSMigration{
+		RPCURL:                    simEVM.RPCURL,
+		SubnetID:                  ids.GenerateTestID(),
+		PrivateKey:                simEVM.PrivateKey,
+		ProxyAdminOwnerPrivateKey: simEVM.PrivateKey,
+		PoSParams:                 posParams,
+		RewardBasisPoints:         100,
+		ValidationIDs:             []ids.ID{validationID, ids.GenerateTestID()},
+	}
+	printFunc := func(string, ...interface{}) {}
+
+	// a failing PoS initialization keeps the proxy on PoA
+	failingMigration := migration
+	failingMigration.PoSParams.MaximumStakeMultiplier = 11
+	_, err = MigratePoAToPoS(failingMigration, printFunc)
+	require.Error(err)
+	implementation, err := GetProxyValidatorManager(simEVM.RPCURL)
+	require.NoError(err)
+	require.Equal(poaImplementation, implementation)
+	managerType, err := validatorManagerSDK.GetValidatorManagerType(simEVM.RPCURL, proxyAddress)
+	require.NoError(err)
+	require.Equal(validatormanagertypes.ValidatorManagementType(validatormanagertypes.ProofOfAuthority), managerType)
+
+	// only the proxy admin owner can migrate
+	otherMigration := migration
+	otherMigration.ProxyAdminOwnerPrivateKey = common.Bytes2Hex(crypto.FromECDSA(otherKey))
+	_, err = MigratePoAToPoS(otherMigration, printFunc)
+	require.Error(err)
+
+	carried, err := MigratePoAToPoS(migration, printFunc)
+	require.NoError(err)
+	require.Equal([]ids.ID{validationID}, carried)
+	implementation, err = GetProxyValidatorManager(simEVM.RPCURL)
+	require.NoError(err)
+	require.NotEqual(poaImplementation, implementation)
+	managerType, err = validatorManagerSDK.GetValidatorManagerType(simEVM.RPCURL, proxyAddress)
+	require.NoError(err)
+	require.Equal(validatormanagertypes.ValidatorManagementType(validatormanagertypes.ProofOfStake), managerType)
+
+	// the carried over validator is owned by the proxy admin owner, who deposited its stake
+	ctx := context.Background()
+	ownerValue, err := simEVM.Backend.Client().StorageAt(ctx, proxyAddress, posValidatorInfoOwnerSlot(validationID), nil)
+	require.NoError(err)
+	require.Equal(posValidatorInfoOwnerValue(simEVM.Address, posParams.MinimumDelegationFee, 0), common.BytesToHash(ownerValue))
+	balance, err := simEVM.Backend.Client().BalanceAt(ctx, proxyAddress, nil)
+	require.NoError(err)
+	require.Equal(new(big.Int).Mul(new(big.Int).SetUint64(weight), posParams.WeightToValueFactor), balance)
+
+	_, err = MigratePoAToPoS(migration, printFunc)
+	require.ErrorContains(err, "is not PoA")
+
+	// the validator can only be removed by its owner
+	_, _, err = InitializeValidatorRemoval(
+		simEVM.RPCURL,
+		proxyAddress,
+		false,
+		common.Address{},
+		common.Bytes2Hex(crypto.FromECDSA(otherKey)),
+		validationID,
+		true,
+		nil,
+		true,
+		false,
+	)
+	require.Error(err)
+	_, _, err = InitializeValidatorRemoval(
+		simEVM.RPCURL,
+		proxyAddress,
+		false,
+		common.Address{},
+		simEVM.PrivateKey,
+		validationID,
+		true,
+		nil,
+		true,
+		false,
+	)
+	require.NoError(err)
+	status, err = GetValidatorStatus(simEVM.RPCURL, proxyAddress, validationID)
+	require.NoError(err)
+	require.Equal(PendingRemovedValidatorStatus, status)
+}
+
+func TestPoSMigratorRejectsOtherCallers(t *testing.T) {
+	require := testutils.SetupTest(t)
+	simEVM := testutils.NewSimulatedEVM(t, nil)
+	migrator, err := contract.DeployContract(
+		simEVM.RPCURL,
+		simEVM.PrivateKey,
+		[]byte(hex.EncodeToString(posMigratorInitCode(common.HexToAddress(validatorManagerSDK.ProxyAdminContractAddress)))),
+		"()",
+	)
+	require.NoError(err)
+	client := simEVM.Backend.Client()
+	code, err := client.CodeAt(context.Background(), migrator, nil)
+	require.NoError(err)
+	require.Equal(posMigratorInitCode(common.HexToAddress(validatorManagerSDK.ProxyAdminContractAddress))[9:], code)
+
+	callData := posMigrationCallData(common.Address{}, simEVM.Address, 1, nil, nil)
+	_, err = client.CallContract(context.Background(), interfaces.CallMsg{
+		From: simEVM.Address,
+		To:   &migrator,
+		Data: callData,
+	}, nil)
+	require.Error(err)
+}
@@ -19,14 +19,35 @@ func SetupValidatorManagerAtProxy(
 	proxyManagerPrivateKey string,
 	validatorManager common.Address,
 ) (*types.Transaction, *types.Receipt, error) {
-	return contract.TxToMethod(
+	return UpgradeProxyValidatorManager(
 		rpcURL,
 		false,
 		common.Address{},
 		proxyManagerPrivateKey,
+		validatorManager,
+		"set proxy to PoS",
+	)
+}
+
+// UpgradeProxyValidatorManager points the validator manager proxy to the implementation at
+// [validatorManager]. If [generateRawTxOnly], the tx is not signed, but returned to be
+// signed by the proxy admin owner [proxyAdminOwner]
+func UpgradeProxyValidatorManager(
+	rpcURL string,
+	generateRawTxOnly bool,
+	proxyAdminOwner common.Address,
+	proxyAdminOwnerPrivateKey string,
+	validatorManager common.Address,
+	description string,
+) (*types.Transaction, *types.Receipt, error) {
+	return contract.TxToMethod(
+		rpcURL,
+		generateRawTxOnly,
+		proxyAdminOwner,
+		proxyAdminOwnerPrivateKey,
 		common.HexToAddress(validatorManagerSDK.ProxyAdminContractAddress),
 		big.NewInt(0),
-		"set proxy to PoS",
+		description,
 		validatorManagerSDK.ErrorSignatureToError,
 		"upgrade(address,address)",
 		common.HexToAddress(validatorManagerSDK.ProxyContractAddress),
@@ -0,0 +1,117 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatormanager
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/internal/testutils"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	validatorManagerSDK "github.com/ava-labs/avalanche-cli/sdk/validatormanager"
+	"github.com/ava-labs/subnet-evm/core"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestUpgradeProxyValidatorManager(t *testing.T) {
+	require := testutils.SetupTest(t)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(err)
+	otherAddress := crypto.PubkeyToAddress(otherKey.PublicKey)
+	simEVM := testutils.NewSimulatedEVM(t, func(allocs core.GenesisAlloc, funded common.Address) {
+		AddTransparentProxyContractToAllocations(allocs, funded.Hex())
+		AddValidatorMessagesACP99ContractToAllocations(allocs)
+		AddPoAValidatorManagerContractToAllocations(allocs)
+		allocs[otherAddress] = core.GenesisAccount{
+			Balance: big.NewInt(1e18),
+		}
+	})
+
+	implementation, err := GetProxyValidatorManager(simEVM.RPCURL)
+	require.NoError(err)
+	require.Equal(common.HexToAddress(validatorManagerSDK.ValidatorContractAddress), implementation)
+
+	newImplementation, err := DeployPoSValidatorManagerContract(simEVM.RPCURL, simEVM.PrivateKey)
+	require.NoError(err)
+
+	// not signed
+	tx, receipt, err := UpgradeProxyValidatorManager(
+		simEVM.RPCURL,
+		true,
+		simEVM.Address,
+		"",
+		newImplementation,
+		"upgrade proxy",
+	)
+	require.NoError(err)
+	require.Nil(receipt)
+	require.Equal(common.HexToAddress(validatorManagerSDK.ProxyAdminContractAddress), *tx.To())
+	implementation, err = GetProxyValidatorManager(simEVM.RPCURL)
+	require.NoError(err)
+	require.Equal(common.HexToAddress(validatorManagerSDK.ValidatorContractAddress), implementation)
+
+	// not the proxy admin owner
+	_, _, err = UpgradeProxyValidatorManager(
+		simEVM.RPCURL,
+		false,
+		otherAddress,
+		common.Bytes2Hex(crypto.FromECDSA(otherKey)),
+		newImplementation,
+		"upgrade proxy",
+	)
+	require.Error(err)
+
+	_, _, err = UpgradeProxyValidatorManager(
+		simEVM.RPCURL,
+		false,
+		simEVM.Address,
+		simEVM.PrivateKey,
+		newImplementation,
+		"upgrade proxy",
+	)
+	require.NoError(err)
+	implementation, err = GetProxyValidatorManager(simEVM.RPCURL)
+	require.NoError(err)
+	require.Equal(newImplementation, implementation)
+	set, err := ProxyHasValidatorManagerSet(simEVM.RPCURL)
+	require.NoError(err)
+	require.True(set)
+}
+
+func TestTransferProxyAdminOwnership(t *testing.T) {
+	require := testutils.SetupTest(t)
+	simEVM := testutils.NewSimulatedEVM(t, func(allocs core.GenesisAlloc, funded common.Address) {
+		AddTransparentProxyContractToAllocations(allocs, funded.Hex())
+	})
+	proxyAdmin := common.HexToAddress(validatorManagerSDK.ProxyAdminContractAddress)
+	newOwner := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	owner, err := contract.GetContractOwner(simEVM.RPCURL, proxyAdmin)
+	require.NoError(err)
+	require.Equal(simEVM.Address, owner)
+	_, _, err = contract.TransferOwnership(
+		simEVM.RPCURL,
+		false,
+		simEVM.Address,
+		simEVM.PrivateKey,
+		proxyAdmin,
+		newOwner,
+	)
+	require.NoError(err)
+	owner, err = contract.GetContractOwner(simEVM.RPCURL, proxyAdmin)
+	require.NoError(err)
+	require.Equal(newOwner, owner)
+
+	// the previous owner can't upgrade the proxy anymore
+	_, _, err = UpgradeProxyValidatorManager(
+		simEVM.RPCURL,
+		false,
+		simEVM.Address,
+		simEVM.PrivateKey,
+		common.HexToAddress(validatorManagerSDK.RewardCalculatorAddress),
+		"upgrade proxy",
+	)
+	require.Error(err)
+}
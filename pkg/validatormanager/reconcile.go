@@ -0,0 +1,245 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatormanager
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	"github.com/ava-labs/avalanche-cli/sdk/evm"
+	"github.com/ava-labs/avalanchego/ids"
+	warpMessage "github.com/ava-labs/avalanchego/vms/platformvm/warp/message"
+	warpPayload "github.com/ava-labs/avalanchego/vms/platformvm/warp/payload"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/interfaces"
+	subnetEvmWarp "github.com/ava-labs/subnet-evm/precompile/contracts/warp"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ValidatorStatus is the status of a validation on the validator manager
+type ValidatorStatus uint8
+
+const (
+	UnknownValidatorStatus ValidatorStatus = iota
+	PendingAddedValidatorStatus
+	ActiveValidatorStatus
+	PendingRemovedValidatorStatus
+	CompletedValidatorStatus
+	InvalidatedValidatorStatus
+)
+
+func (s ValidatorStatus) String() string {
+	switch s {
+	case PendingAddedValidatorStatus:
+		return "PendingAdded"
+	case ActiveValidatorStatus:
+		return "Active"
+	case PendingRemovedValidatorStatus:
+		return "PendingRemoved"
+	case CompletedValidatorStatus:
+		return "Completed"
+	case InvalidatedValidatorStatus:
+		return "Invalidated"
+	default:
+		return "Unknown"
+	}
+}
+
+// getValidator returns a struct, that can not be described with contract method specs.
+// Both v1.0.0 and ACP99 validator structs start with status and node ID
+const getValidatorABI = `[{
+	"type": "function",
+	"name": "getValidator",
+	"stateMutability": "view",
+	"inputs": [{"name": "validationID", "type": "bytes32", "internalType": "bytes32"}],
+	"outputs": [{
+		"name": "",
+		"type": "tuple",
+		"internalType": "struct Validator",
+		"components": [
+			{"name": "status", "type": "uint8", "internalType": "enum ValidatorStatus"},
+			{"name": "nodeID", "type": "bytes", "internalType": "bytes"}
+		]
+	}]
+}]`
+
+// GetValidatorStatus returns the status of [validationID] on the validator manager
+// at [managerAddress]
+func GetValidatorStatus(
+	rpcURL string,
+	managerAddress common.Address,
+	validationID ids.ID,
+) (ValidatorStatus, error) {
+	metadata := &bind.MetaData{
+		ABI: getValidatorABI,
+	}
+	abi, err := metadata.GetAbi()
+	if err != nil {
+		return UnknownValidatorStatus, err
+	}
+	client, err := evm.GetClient(rpcURL)
+	if err != nil {
+		return UnknownValidatorStatus, err
+	}
+	defer client.Close()
+	contract := bind.NewBoundContract(managerAddress, *abi, client.EthClient, client.EthClient, client.EthClient)
+	var out []interface{}
+	if err := contract.Call(&bind.CallOpts{}, &out, "getValidator", validationID); err != nil {
+		return UnknownValidatorStatus, err
+	}
+	if len(out) != 1 {
+		return UnknownValidatorStatus, fmt.Errorf("error at getValidator call: expected 1 return value, got %d", len(out))
+	}
+	status, ok := reflect.ValueOf(out[0]).FieldByName("Status").Interface().(uint8)
+	if !ok {
+		return UnknownValidatorStatus, fmt.Errorf("error at getValidator call: unexpected return value %#v", out[0])
+	}
+	return ValidatorStatus(status), nil
+}
+
+// L1ValidatorRegistration is a validator registration initiated on a validator manager
+type L1ValidatorRegistration struct {
+	ValidationID ids.ID
+	NodeID       ids.NodeID
+	Weight       uint64
+	Expiry       uint64
+}
+
+// GetL1ValidatorRegistrations returns all validator registrations sent to the P-Chain by
+// the validator manager at [managerAddress], in block order
+func GetL1ValidatorRegistrations(
+	rpcURL string,
+	managerAddress common.Address,
+) ([]L1ValidatorRegistration, error) {
+	client, err := evm.GetClient(rpcURL)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+	height, err := client.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	registrations := []L1ValidatorRegistration{}
+	for blockNumber := int64(0); blockNumber <= int64(height); blockNumber++ {
+		block, err := client.BlockByNumber(big.NewInt(blockNumber))
+		if err != nil {
+			return nil, err
+		}
+		blockHash := block.Hash()
+		logs, err := client.FilterLogs(interfaces.FilterQuery{
+			BlockHash: &blockHash,
+			Addresses: []common.Address{subnetEvmWarp.Module.Address},
+		})
+		if err != nil {
+			return nil, err
+		}
+		msgs := evm.GetWarpMessagesFromLogs(utils.PointersSlice(logs))
+		for _, msg := range msgs {
+			addressedCall, err := warpPayload.ParseAddressedCall(msg.Payload)
+			if err != nil || !bytes.Equal(addressedCall.SourceAddress, managerAddress.Bytes()) {
+				continue
+			}
+			reg, err := warpMessage.ParseRegisterL1Validator(addressedCall.Payload)
+			if err != nil {
+				continue
+			}
+			nodeID, err := ids.ToNodeID(reg.NodeID)
+			if err != nil {
+				return nil, err
+			}
+			registrations = append(registrations, L1ValidatorRegistration{
+				ValidationID: reg.ValidationID(),
+				NodeID:       nodeID,
+				Weight:       reg.Weight,
+				Expiry:       reg.Expiry,
+			})
+		}
+	}
+	return registrations, nil
+}
+
+// PendingOperationKind is a validator set change left halfway between the validator
+// manager and the P-Chain
+type PendingOperationKind int
+
+const (
+	NoPendingOperation PendingOperationKind = iota
+	// registered on the P-Chain, but not completed on the validator manager
+	RegistrationNotCompleted
+	// initiated on the validator manager, but not registered on the P-Chain
+	RegistrationNotIssued
+	// initiated on the validator manager, but expired before being registered on the P-Chain
+	RegistrationExpired
+	// initiated on the validator manager, but the validator is still on the P-Chain
+	RemovalNotIssued
+	// removed from the P-Chain, but not completed on the validator manager
+	RemovalNotCompleted
+	// active on the validator manager, but removed from the P-Chain
+	RemovedFromPChain
+)
+
+func (k PendingOperationKind) String() string {
+	switch k {
+	case RegistrationNotCompleted:
+		return "registration not completed on validator manager"
+	case RegistrationNotIssued:
+		return "registration not issued on P-Chain"
+	case RegistrationExpired:
+		return "registration expired before reaching P-Chain"
+	case RemovalNotIssued:
+		return "removal not issued on P-Chain"
+	case RemovalNotCompleted:
+		return "removal not completed on validator manager"
+	case RemovedFromPChain:
+		return "removed from P-Chain only"
+	default:
+		return "none"
+	}
+}
+
+// CanComplete tells if the operation can be completed by the CLI from its current state
+func (k PendingOperationKind) CanComplete() bool {
+	return k == RegistrationNotCompleted || k == RemovalNotIssued || k == RemovalNotCompleted
+}
+
+// CanAbort tells if the operation can be aborted by the CLI from its current state
+func (k PendingOperationKind) CanAbort() bool {
+	return k == RegistrationExpired
+}
+
+// GetPendingOperation compares the validator manager [status] of [registration] against
+// the P-Chain, where the validator is found if [onPChain]. Registrations expire on
+// unix time [now]
+func GetPendingOperation(
+	registration L1ValidatorRegistration,
+	status ValidatorStatus,
+	onPChain bool,
+	now uint64,
+) PendingOperationKind {
+	switch status {
+	case PendingAddedValidatorStatus:
+		switch {
+		case onPChain:
+			return RegistrationNotCompleted
+		case registration.Expiry <= now:
+			return RegistrationExpired
+		default:
+			return RegistrationNotIssued
+		}
+	case ActiveValidatorStatus:
+		if !onPChain {
+			return RemovedFromPChain
+		}
+	case PendingRemovedValidatorStatus:
+		if onPChain {
+			return RemovalNotIssued
+		}
+		return RemovalNotCompleted
+	}
+	return NoPendingOperation
+}
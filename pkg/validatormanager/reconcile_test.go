@@ -0,0 +1,201 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatormanager
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/internal/testutils"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	validatorManagerSDK "github.com/ava-labs/avalanche-cli/sdk/validatormanager"
+	"github.com/ava-labs/avalanchego/ids"
+	warpMessage "github.com/ava-labs/avalanchego/vms/platformvm/warp/message"
+	"github.com/ava-labs/subnet-evm/core"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPendingOperation(t *testing.T) {
+	now := uint64(1_000_000)
+	pendingRegistration := L1ValidatorRegistration{
+		ValidationID: ids.GenerateTestID(),
+		NodeID:       ids.GenerateTestNodeID(),
+		Weight:       20,
+		Expiry:       now + 1,
+	}
+	expiredRegistration := pendingRegistration
+	expiredRegistration.Expiry = now
+	tests := []struct {
+		name         string
+		registration L1ValidatorRegistration
+		status       ValidatorStatus
+		onPChain     bool
+		expected     PendingOperationKind
+		canComplete  bool
+		canAbort     bool
+	}{
+		{
+			name:         "pending added on P-Chain",
+			registration: pendingRegistration,
+			status:       PendingAddedValidatorStatus,
+			onPChain:     true,
+			expected:     RegistrationNotCompleted,
+			canComplete:  true,
+		},
+		{
+			name:         "pending added not on P-Chain",
+			registration: pendingRegistration,
+			status:       PendingAddedValidatorStatus,
+			expected:     RegistrationNotIssued,
+		},
+		{
+			name:         "pending added on P-Chain after expiry",
+			registration: expiredRegistration,
+			status:       PendingAddedValidatorStatus,
+			onPChain:     true,
+			expected:     RegistrationNotCompleted,
+			canComplete:  true,
+		},
+		{
+			name:         "pending added expired",
+			registration: expiredRegistration,
+			status:       PendingAddedValidatorStatus,
+			expected:     RegistrationExpired,
+			canAbort:     true,
+		},
+		{
+			name:         "pending removed on P-Chain",
+			registration: pendingRegistration,
+			status:       PendingRemovedValidatorStatus,
+			onPChain:     true,
+			expected:     RemovalNotIssued,
+			canComplete:  true,
+		},
+		{
+			name:         "pending removed not on P-Chain",
+			registration: pendingRegistration,
+			status:       PendingRemovedValidatorStatus,
+			expected:     RemovalNotCompleted,
+			canComplete:  true,
+		},
+		{
+			name:         "active not on P-Chain",
+			registration: pendingRegistration,
+			status:       ActiveValidatorStatus,
+			expected:     RemovedFromPChain,
+		},
+		{
+			name:         "active on P-Chain",
+			registration: pendingRegistration,
+			status:       ActiveValidatorStatus,
+			onPChain:     true,
+			expected:     NoPendingOperation,
+		},
+		{
+			name:         "completed",
+			registration: pendingRegistration,
+			status:       CompletedValidatorStatus,
+			expected:     NoPendingOperation,
+		},
+		{
+			name:         "invalidated",
+			registration: expiredRegistration,
+			status:       InvalidatedValidatorStatus,
+			expected:     NoPendingOperation,
+		},
+		{
+			name:         "unknown",
+			registration: pendingRegistration,
+			status:       UnknownValidatorStatus,
+			expected:     NoPendingOperation,
+		},
+	}
+	covered := map[PendingOperationKind]bool{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			kind := GetPendingOperation(tt.registration, tt.status, tt.onPChain, now)
+			require.Equal(tt.expected, kind, kind.String())
+			require.Equal(tt.canComplete, kind.CanComplete())
+			require.Equal(tt.canAbort, kind.CanAbort())
+			covered[kind] = true
+		})
+	}
+	for kind := NoPendingOperation; kind <= RemovedFromPChain; kind++ {
+		require.True(t, covered[kind], "pending operation %q not covered", kind)
+	}
+}
+
+func TestGetL1ValidatorRegistrations(t *testing.T) {
+	require := testutils.SetupTest(t)
+	simEVM := testutils.NewSimulatedEVM(t, func(allocs core.GenesisAlloc, _ common.Address) {
+		AddValidatorMessagesACP99ContractToAllocations(allocs)
+		addInitializedERC20StakingManagerToAllocations(t, allocs, 1_000_000_000_000)
+	})
+	registrations, err := GetL1ValidatorRegistrations(simEVM.RPCURL, testStakingManagerAddress)
+	require.NoError(err)
+	require.Empty(registrations)
+
+	tokenAddress, err := contract.DeployERC20(simEVM.RPCURL, simEVM.PrivateKey, "TST", simEVM.Address, testTokenSupply)
+	require.NoError(err)
+	posParams := testPoSParams
+	posParams.StakingTokenAddress = tokenAddress.Hex()
+	_, _, err = validatorManagerSDK.PoSValidatorManagerInitialize(
+		simEVM.RPCURL,
+		testStakingManagerAddress,
+		simEVM.PrivateKey,
+		ids.GenerateTestID(),
+		posParams,
+	)
+	require.NoError(err)
+	header, err := simEVM.Backend.Client().HeaderByNumber(context.Background(), nil)
+	require.NoError(err)
+	owner := warpMessage.PChainOwner{
+		Threshold: 1,
+		Addresses: []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	stakeAmount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(100))
+	expectedWeight := new(big.Int).Div(stakeAmount, posParams.WeightToValueFactor).Uint64()
+	nodeIDs := []ids.NodeID{ids.GenerateTestNodeID(), ids.GenerateTestNodeID()}
+	for i, nodeID := range nodeIDs {
+		_, _, err = InitializeValidatorRegistrationPoSERC20(
+			simEVM.RPCURL,
+			testStakingManagerAddress,
+			tokenAddress,
+			simEVM.PrivateKey,
+			nodeID,
+			make([]byte, 48),
+			header.Time+3600+uint64(i),
+			owner,
+			owner,
+			10,
+			time.Hour,
+			stakeAmount,
+		)
+		require.NoError(err)
+	}
+
+	registrations, err = GetL1ValidatorRegistrations(simEVM.RPCURL, testStakingManagerAddress)
+	require.NoError(err)
+	require.Len(registrations, len(nodeIDs))
+	for i, registration := range registrations {
+		require.Equal(nodeIDs[i], registration.NodeID)
+		require.Equal(expectedWeight, registration.Weight)
+		require.Equal(header.Time+3600+uint64(i), registration.Expiry)
+		require.NotEqual(ids.Empty, registration.ValidationID)
+		status, err := GetValidatorStatus(simEVM.RPCURL, testStakingManagerAddress, registration.ValidationID)
+		require.NoError(err)
+		require.Equal(PendingAddedValidatorStatus, status)
+		require.Equal(RegistrationNotIssued, GetPendingOperation(registration, status, false, header.Time))
+	}
+	require.NotEqual(registrations[0].ValidationID, registrations[1].ValidationID)
+
+	// warp messages of other senders are ignored
+	registrations, err = GetL1ValidatorRegistrations(simEVM.RPCURL, common.HexToAddress(validatorManagerSDK.ProxyContractAddress))
+	require.NoError(err)
+	require.Empty(registrations)
+}
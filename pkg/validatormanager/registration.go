@@ -96,6 +96,94 @@ func InitializeValidatorRegistrationPoSNative(
 	)
 }
 
+// InitializeValidatorRegistrationPoSERC20 approves the ERC20 token staking manager
+// [managerAddress] to transfer [stakeAmount] of [stakingTokenAddress] from the staker
+// [stakerPrivateKey], and initializes the validator registration with that stake
+func InitializeValidatorRegistrationPoSERC20(
+	rpcURL string,
+	managerAddress common.Address,
+	stakingTokenAddress common.Address,
+	stakerPrivateKey string,
+	nodeID ids.NodeID,
+	blsPublicKey []byte,
+	expiry uint64,
+	balanceOwners warpMessage.PChainOwner,
+	disableOwners warpMessage.PChainOwner,
+	delegationFeeBips uint16,
+	minStakeDuration time.Duration,
+	stakeAmount *big.Int,
+) (*types.Transaction, *types.Receipt, error) {
+	type PChainOwner struct {
+		Threshold uint32
+		Addresses []common.Address
+	}
+
+	type ValidatorRegistrationInput struct {
+		NodeID                []byte
+		BlsPublicKey          []byte
+		RegistrationExpiry    uint64
+		RemainingBalanceOwner PChainOwner
+		DisableOwner          PChainOwner
+	}
+
+	stakerAddress, err := evm.PrivateKeyToAddress(stakerPrivateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	balance, err := contract.GetERC20Balance(rpcURL, stakingTokenAddress, stakerAddress)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failure getting staking token balance of %s: %w", stakerAddress, err)
+	}
+	if balance.Cmp(stakeAmount) < 0 {
+		return nil, nil, fmt.Errorf("%s has a staking token balance of %s, less than the stake amount %s", stakerAddress, balance, stakeAmount)
+	}
+	if tx, _, err := contract.ApproveERC20(
+		rpcURL,
+		stakerPrivateKey,
+		stakingTokenAddress,
+		managerAddress,
+		stakeAmount,
+	); err != nil {
+		return tx, nil, err
+	}
+
+	balanceOwnersAux := PChainOwner{
+		Threshold: balanceOwners.Threshold,
+		Addresses: utils.Map(balanceOwners.Addresses, func(addr ids.ShortID) common.Address {
+			return common.BytesToAddress(addr[:])
+		}),
+	}
+	disableOwnersAux := PChainOwner{
+		Threshold: disableOwners.Threshold,
+		Addresses: utils.Map(disableOwners.Addresses, func(addr ids.ShortID) common.Address {
+			return common.BytesToAddress(addr[:])
+		}),
+	}
+	validatorRegistrationInput := ValidatorRegistrationInput{
+		NodeID:                nodeID[:],
+		BlsPublicKey:          blsPublicKey,
+		RegistrationExpiry:    expiry,
+		RemainingBalanceOwner: balanceOwnersAux,
+		DisableOwner:          disableOwnersAux,
+	}
+
+	return contract.TxToMethod(
+		rpcURL,
+		false,
+		common.Address{},
+		stakerPrivateKey,
+		managerAddress,
+		big.NewInt(0),
+		"initialize validator registration with ERC20 stake",
+		validatormanager.ErrorSignatureToError,
+		"initializeValidatorRegistration((bytes,bytes,uint64,(uint32,[address]),(uint32,[address])),uint16,uint64,uint256)",
+		validatorRegistrationInput,
+		delegationFeeBips,
+		uint64(minStakeDuration.Seconds()),
+		stakeAmount,
+	)
+}
+
 // step 1 of flow for adding a new validator
 func InitializeValidatorRegistrationPoA(
 	rpcURL string,
@@ -393,6 +481,7 @@ func InitValidatorRegistration(
 	aggregatorExtraPeerEndpoints []info.Peer,
 	aggregatorLogger logging.Logger,
 	isPos bool,
+	stakingTokenAddressStr string,
 	delegationFee uint16,
 	stakeDuration time.Duration,
 	validatorManagerAddressStr string,
@@ -435,19 +524,36 @@ func InitValidatorRegistration(
 			ux.Logger.PrintToUser("Using RPC URL: %s", rpcURL)
 			ux.Logger.PrintToUser("NodeID: %s staking %s tokens", nodeID.String(), stakeAmount)
 			ux.Logger.PrintLineSeparator()
-			tx, receipt, err = InitializeValidatorRegistrationPoSNative(
-				rpcURL,
-				managerAddress,
-				ownerPrivateKey,
-				nodeID,
-				blsPublicKey,
-				expiry,
-				balanceOwners,
-				disableOwners,
-				delegationFee,
-				stakeDuration,
-				stakeAmount,
-			)
+			if stakingTokenAddressStr != "" {
+				tx, receipt, err = InitializeValidatorRegistrationPoSERC20(
+					rpcURL,
+					managerAddress,
+					common.HexToAddress(stakingTokenAddressStr),
+					ownerPrivateKey,
+					nodeID,
+					blsPublicKey,
+					expiry,
+					balanceOwners,
+					disableOwners,
+					delegationFee,
+					stakeDuration,
+					stakeAmount,
+				)
+			} else {
+				tx, receipt, err = InitializeValidatorRegistrationPoSNative(
+					rpcURL,
+					managerAddress,
+					ownerPrivateKey,
+					nodeID,
+					blsPublicKey,
+					expiry,
+					balanceOwners,
+					disableOwners,
+					delegationFee,
+					stakeDuration,
+					stakeAmount,
+				)
+			}
 			if err != nil {
 				if !errors.Is(err, validatormanager.ErrNodeAlreadyRegistered) {
 					return nil, ids.Empty, nil, evm.TransactionError(tx, err, "failure initializing validator registration")
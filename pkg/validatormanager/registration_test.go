@@ -0,0 +1,174 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatormanager
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/internal/testutils"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	validatorManagerSDK "github.com/ava-labs/avalanche-cli/sdk/validatormanager"
+	"github.com/ava-labs/avalanchego/ids"
+	warpMessage "github.com/ava-labs/avalanchego/vms/platformvm/warp/message"
+	"github.com/ava-labs/subnet-evm/core"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// ValidatorManagerStorage slots, as given by the v1.0.0 storage layout
+	churnTrackerWeightsSlot     = common.HexToHash("0xe92546d698950ddd38910d2e15ed1d923cd0a7b3dde9e2a6a3f380565559cb03")
+	initializedValidatorSetSlot = common.HexToHash("0xe92546d698950ddd38910d2e15ed1d923cd0a7b3dde9e2a6a3f380565559cb07")
+
+	testStakingManagerAddress = common.HexToAddress("0x0C0DEBA5E0000000000000000000000000000001")
+	testTokenSupply           = big.NewInt(1_000_000)
+	testPoSParams             = validatorManagerSDK.PoSParams{
+		MinimumStakeAmount:      big.NewInt(1),
+		MaximumStakeAmount:      new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1_000_000)),
+		MinimumStakeDuration:    100,
+		MinimumDelegationFee:    1,
+		MaximumStakeMultiplier:  1,
+		WeightToValueFactor:     big.NewInt(1e12),
+		RewardCalculatorAddress: validatorManagerSDK.RewardCalculatorAddress,
+		UptimeBlockchainID:      ids.GenerateTestID(),
+	}
+)
+
+// addInitializedERC20StakingManagerToAllocations sets an ERC20 token staking manager on genesis,
+// whose initial validator set is already registered with [totalWeight]. This is the state
+// reached after the L1 conversion, that can't be produced here as it requires P-Chain signatures
+func addInitializedERC20StakingManagerToAllocations(
+	t *testing.T,
+	allocs core.GenesisAlloc,
+	totalWeight uint64,
+) {
+	creationBytecode := common.FromHex(fillValidatorMessagesAddressPlaceholder(strings.TrimSpace(string(erc20PoSValidatorManagerBytecode))))
+	deployedBytecode, ok := runtimeBytecode(creationBytecode)
+	if !ok {
+		t.Fatal("failure extracting ERC20 token staking manager runtime bytecode")
+	}
+	allocs[testStakingManagerAddress] = core.GenesisAccount{
+		Balance: big.NewInt(0),
+		Code:    deployedBytecode,
+		Storage: map[common.Hash]common.Hash{
+			// churn tracker initialWeight (bits 0-63) and totalWeight (bits 64-127)
+			churnTrackerWeightsSlot:     common.BigToHash(new(big.Int).Lsh(new(big.Int).SetUint64(totalWeight), 64)),
+			initializedValidatorSetSlot: common.BigToHash(big.NewInt(1)),
+		},
+		Nonce: 1,
+	}
+}
+
+func TestInitializeValidatorRegistrationPoSERC20(t *testing.T) {
+	require := testutils.SetupTest(t)
+	evm := testutils.NewSimulatedEVM(t, func(allocs core.GenesisAlloc, _ common.Address) {
+		AddValidatorMessagesACP99ContractToAllocations(allocs)
+		addInitializedERC20StakingManagerToAllocations(t, allocs, 1_000_000_000_000)
+	})
+
+	tokenAddress, err := contract.DeployERC20(evm.RPCURL, evm.PrivateKey, "TST", evm.Address, testTokenSupply)
+	require.NoError(err)
+	posParams := testPoSParams
+	posParams.StakingTokenAddress = tokenAddress.Hex()
+	_, _, err = validatorManagerSDK.PoSValidatorManagerInitialize(
+		evm.RPCURL,
+		testStakingManagerAddress,
+		evm.PrivateKey,
+		ids.GenerateTestID(),
+		posParams,
+	)
+	require.NoError(err)
+
+	header, err := evm.Backend.Client().HeaderByNumber(context.Background(), nil)
+	require.NoError(err)
+	owner := warpMessage.PChainOwner{
+		Threshold: 1,
+		Addresses: []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	initialBalance, err := contract.GetERC20Balance(evm.RPCURL, tokenAddress, evm.Address)
+	require.NoError(err)
+	stakeAmount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(100))
+
+	_, receipt, err := InitializeValidatorRegistrationPoSERC20(
+		evm.RPCURL,
+		testStakingManagerAddress,
+		tokenAddress,
+		evm.PrivateKey,
+		ids.GenerateTestNodeID(),
+		make([]byte, 48),
+		header.Time+3600,
+		owner,
+		owner,
+		10,
+		time.Hour,
+		stakeAmount,
+	)
+	require.NoError(err)
+	require.NotNil(receipt)
+
+	// the approved stake was moved from the staker into the manager
+	managerBalance, err := contract.GetERC20Balance(evm.RPCURL, tokenAddress, testStakingManagerAddress)
+	require.NoError(err)
+	require.Equal(stakeAmount, managerBalance)
+	stakerBalance, err := contract.GetERC20Balance(evm.RPCURL, tokenAddress, evm.Address)
+	require.NoError(err)
+	require.Equal(new(big.Int).Sub(initialBalance, stakeAmount), stakerBalance)
+	out, err := contract.CallToMethod(
+		evm.RPCURL,
+		tokenAddress,
+		"allowance(address,address)->(uint256)",
+		evm.Address,
+		testStakingManagerAddress,
+	)
+	require.NoError(err)
+	allowance, err := contract.GetSmartContractCallResult[*big.Int]("allowance", out)
+	require.NoError(err)
+	require.Zero(allowance.Sign())
+}
+
+func TestInitializeValidatorRegistrationPoSERC20InsufficientBalance(t *testing.T) {
+	require := testutils.SetupTest(t)
+	evm := testutils.NewSimulatedEVM(t, func(allocs core.GenesisAlloc, _ common.Address) {
+		AddValidatorMessagesACP99ContractToAllocations(allocs)
+		addInitializedERC20StakingManagerToAllocations(t, allocs, 1_000_000_000_000)
+	})
+
+	tokenAddress, err := contract.DeployERC20(evm.RPCURL, evm.PrivateKey, "TST", evm.Address, testTokenSupply)
+	require.NoError(err)
+	stakeAmount := new(big.Int).Mul(big.NewInt(1e18), new(big.Int).Add(testTokenSupply, big.NewInt(1)))
+	owner := warpMessage.PChainOwner{
+		Threshold: 1,
+		Addresses: []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	_, _, err = InitializeValidatorRegistrationPoSERC20(
+		evm.RPCURL,
+		testStakingManagerAddress,
+		tokenAddress,
+		evm.PrivateKey,
+		ids.GenerateTestNodeID(),
+		make([]byte, 48),
+		0,
+		owner,
+		owner,
+		10,
+		time.Hour,
+		stakeAmount,
+	)
+	require.ErrorContains(err, "less than the stake amount")
+	// nothing was approved
+	out, err := contract.CallToMethod(
+		evm.RPCURL,
+		tokenAddress,
+		"allowance(address,address)->(uint256)",
+		evm.Address,
+		testStakingManagerAddress,
+	)
+	require.NoError(err)
+	allowance, err := contract.GetSmartContractCallResult[*big.Int]("allowance", out)
+	require.NoError(err)
+	require.Zero(allowance.Sign())
+}
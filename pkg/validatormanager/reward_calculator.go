@@ -0,0 +1,233 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatormanager
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	validatorManagerSDK "github.com/ava-labs/avalanche-cli/sdk/validatormanager"
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/core"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/core/vm/runtime"
+	"github.com/ava-labs/subnet-evm/params"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// IRewardCalculator v1.0.0 method, as called by the PoS Validator Manager
+	calculateRewardSignature = "calculateReward(uint256,uint64,uint64,uint64,uint64)"
+	calculateRewardSpec      = calculateRewardSignature + "->(uint256)"
+	// PUSH4 opcode, used by the solidity dispatcher to compare method selectors
+	push4Opcode = 0x63
+)
+
+// RewardCalculatorArtifact is a compiled reward calculator, as given
+// by a forge or hardhat artifact JSON file
+type RewardCalculatorArtifact struct {
+	Path             string
+	ABI              abi.ABI
+	Bytecode         []byte
+	DeployedBytecode []byte
+	// immutable variables set by the constructor, only given by forge artifacts
+	HasImmutables bool
+}
+
+// artifactBytecode accepts both the forge format {"object": "0x...", "immutableReferences": {...}}
+// and the hardhat format "0x..."
+type artifactBytecode struct {
+	object              string
+	immutableReferences map[string]json.RawMessage
+}
+
+func (b *artifactBytecode) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		b.object = s
+		return nil
+	}
+	var forgeBytecode struct {
+		Object              string                     `json:"object"`
+		ImmutableReferences map[string]json.RawMessage `json:"immutableReferences"`
+	}
+	if err := json.Unmarshal(data, &forgeBytecode); err != nil {
+		return err
+	}
+	b.object = forgeBytecode.Object
+	b.immutableReferences = forgeBytecode.ImmutableReferences
+	return nil
+}
+
+func (b artifactBytecode) decode() ([]byte, error) {
+	s := strings.TrimPrefix(strings.TrimSpace(b.object), "0x")
+	if s == "" {
+		return nil, fmt.Errorf("empty bytecode")
+	}
+	bs, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex bytecode (unlinked libraries are not supported): %w", err)
+	}
+	return bs, nil
+}
+
+// LoadRewardCalculatorArtifact loads the reward calculator artifact at [path],
+// and checks that it implements IRewardCalculator
+func LoadRewardCalculatorArtifact(path string) (RewardCalculatorArtifact, error) {
+	artifactBytes, err := os.ReadFile(path)
+	if err != nil {
+		return RewardCalculatorArtifact{}, err
+	}
+	var artifact struct {
+		ABI              json.RawMessage  `json:"abi"`
+		Bytecode         artifactBytecode `json:"bytecode"`
+		DeployedBytecode artifactBytecode `json:"deployedBytecode"`
+	}
+	if err := json.Unmarshal(artifactBytes, &artifact); err != nil {
+		return RewardCalculatorArtifact{}, fmt.Errorf("invalid reward calculator artifact %s: %w", path, err)
+	}
+	if len(artifact.ABI) == 0 {
+		return RewardCalculatorArtifact{}, fmt.Errorf("reward calculator artifact %s has no ABI", path)
+	}
+	contractABI, err := abi.JSON(bytes.NewReader(artifact.ABI))
+	if err != nil {
+		return RewardCalculatorArtifact{}, fmt.Errorf("invalid ABI on reward calculator artifact %s: %w", path, err)
+	}
+	bytecode, err := artifact.Bytecode.decode()
+	if err != nil {
+		return RewardCalculatorArtifact{}, fmt.Errorf("invalid bytecode on reward calculator artifact %s: %w", path, err)
+	}
+	deployedBytecode, err := artifact.DeployedBytecode.decode()
+	if err != nil {
+		return RewardCalculatorArtifact{}, fmt.Errorf("invalid deployed bytecode on reward calculator artifact %s: %w", path, err)
+	}
+	rewardCalculator := RewardCalculatorArtifact{
+		Path:             path,
+		ABI:              contractABI,
+		Bytecode:         bytecode,
+		DeployedBytecode: deployedBytecode,
+		HasImmutables:    len(artifact.DeployedBytecode.immutableReferences) != 0,
+	}
+	if err := rewardCalculator.checkInterface(); err != nil {
+		return RewardCalculatorArtifact{}, fmt.Errorf("reward calculator artifact %s: %w", path, err)
+	}
+	return rewardCalculator, nil
+}
+
+// checkInterface verifies that the artifact implements the IRewardCalculator
+// interface expected by the v1.0.0 PoS Validator Manager, and that it can be
+// set up without constructor arguments
+func (r RewardCalculatorArtifact) checkInterface() error {
+	method, ok := r.ABI.Methods["calculateReward"]
+	if !ok {
+		return fmt.Errorf("does not implement IRewardCalculator: missing %s", calculateRewardSignature)
+	}
+	if method.Sig != calculateRewardSignature {
+		return fmt.Errorf("does not implement IRewardCalculator: expected %s, found %s", calculateRewardSignature, method.Sig)
+	}
+	if len(method.Outputs) != 1 || method.Outputs[0].Type.String() != "uint256" {
+		return fmt.Errorf("does not implement IRewardCalculator: %s must return a single uint256", calculateRewardSignature)
+	}
+	if !method.IsConstant() {
+		return fmt.Errorf("does not implement IRewardCalculator: %s must be a view function", calculateRewardSignature)
+	}
+	if len(r.ABI.Constructor.Inputs) != 0 {
+		return fmt.Errorf("constructor arguments are not supported")
+	}
+	if !bytes.Contains(r.DeployedBytecode, append([]byte{push4Opcode}, method.ID...)) {
+		return fmt.Errorf("deployed bytecode does not dispatch %s", calculateRewardSignature)
+	}
+	return nil
+}
+
+// CheckConstructorFree verifies that the constructor of [r] just returns its deployed
+// bytecode, without setting immutable variables nor initializing storage. This is required
+// to set the reward calculator on genesis, where the constructor is not executed
+func (r RewardCalculatorArtifact) CheckConstructorFree() error {
+	if r.HasImmutables {
+		return fmt.Errorf("reward calculator artifact %s sets immutable variables on its constructor, that is not executed on genesis", r.Path)
+	}
+	// all network upgrades activated, as the calculator may use any recent opcode
+	cfg := &runtime.Config{
+		ChainConfig: params.TestChainConfig,
+	}
+	deployedBytecode, address, _, err := runtime.Create(r.Bytecode, cfg)
+	if err != nil {
+		return fmt.Errorf("failure executing reward calculator artifact %s constructor: %w", r.Path, err)
+	}
+	if !bytes.Equal(deployedBytecode, r.DeployedBytecode) {
+		return fmt.Errorf("reward calculator artifact %s constructor modifies its deployed bytecode, that is not supported on genesis", r.Path)
+	}
+	cfg.State.IntermediateRoot(true)
+	if cfg.State.GetStorageRoot(address) != types.EmptyRootHash {
+		return fmt.Errorf("reward calculator artifact %s constructor initializes storage, that is not supported on genesis", r.Path)
+	}
+	return nil
+}
+
+// AddCustomRewardCalculatorToAllocations sets [rewardCalculator] on genesis, at the
+// address used by default by the PoS Validator Manager. The constructor is not
+// executed, so the calculator must be constructor free
+func AddCustomRewardCalculatorToAllocations(
+	allocs core.GenesisAlloc,
+	rewardCalculator RewardCalculatorArtifact,
+) error {
+	if err := rewardCalculator.CheckConstructorFree(); err != nil {
+		return err
+	}
+	allocs[common.HexToAddress(validatorManagerSDK.RewardCalculatorAddress)] = core.GenesisAccount{
+		Balance: big.NewInt(0),
+		Code:    rewardCalculator.DeployedBytecode,
+		Nonce:   1,
+	}
+	return nil
+}
+
+// DeployCustomRewardCalculatorContract deploys [rewardCalculator], for blockchains
+// that did not get it on genesis
+func DeployCustomRewardCalculatorContract(
+	rpcURL string,
+	privateKey string,
+	rewardCalculator RewardCalculatorArtifact,
+) (common.Address, error) {
+	return contract.DeployContract(
+		rpcURL,
+		privateKey,
+		[]byte(hex.EncodeToString(rewardCalculator.Bytecode)),
+		"()",
+	)
+}
+
+// EstimateReward asks the reward calculator at [rewardCalculatorAddress] for the reward
+// corresponding to a staking period, as the PoS Validator Manager does on validator removal
+func EstimateReward(
+	rpcURL string,
+	rewardCalculatorAddress common.Address,
+	stakeAmount *big.Int,
+	validatorStartTime uint64,
+	stakingStartTime uint64,
+	stakingEndTime uint64,
+	uptimeSeconds uint64,
+) (*big.Int, error) {
+	out, err := contract.CallToMethod(
+		rpcURL,
+		rewardCalculatorAddress,
+		calculateRewardSpec,
+		stakeAmount,
+		validatorStartTime,
+		stakingStartTime,
+		stakingEndTime,
+		uptimeSeconds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return contract.GetSmartContractCallResult[*big.Int]("calculateReward", out)
+}
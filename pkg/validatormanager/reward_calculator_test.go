@@ -0,0 +1,299 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatormanager
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/internal/testutils"
+	validatorManagerSDK "github.com/ava-labs/avalanche-cli/sdk/validatormanager"
+	"github.com/ava-labs/subnet-evm/core"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const testReward = 42
+
+// returns the runtime of a reward calculator that dispatches [signature], returning [reward]
+func testRewardCalculatorRuntime(signature string, reward byte) []byte {
+	runtime := []byte{
+		0x60, 0x00, 0x35, 0x60, 0xe0, 0x1c, // selector
+		push4Opcode,
+	}
+	runtime = append(runtime, crypto.Keccak256([]byte(signature))[:4]...)
+	return append(runtime,
+		0x14, 0x60, 0x14, 0x57, // EQ: jump to reward
+		0x60, 0x00, 0x60, 0x00, 0xfd, // REVERT(0, 0)
+		0x5b, 0x60, reward, 0x60, 0x00, 0x52, // MSTORE(0, reward)
+		0x60, 0x20, 0x60, 0x00, 0xf3, // RETURN(0, 0x20)
+	)
+}
+
+// returns creation code that runs [constructor], and then returns [runtime]
+func testCreationCode(constructor []byte, runtime []byte) []byte {
+	codeOffset := len(constructor) + 13
+	creationCode := append([]byte{}, constructor...)
+	creationCode = append(creationCode,
+		0x61, byte(len(runtime)>>8), byte(len(runtime)), 0x80, // PUSH2 size, DUP1
+		0x61, byte(codeOffset>>8), byte(codeOffset), 0x60, 0x00, 0x39, // CODECOPY(0, offset, size)
+		0x60, 0x00, 0xf3, // RETURN(0, size)
+	)
+	return append(creationCode, runtime...)
+}
+
+type testRewardCalculatorArtifact struct {
+	abi                 []map[string]interface{}
+	bytecode            string
+	deployedBytecode    string
+	immutableReferences map[string]interface{}
+}
+
+func newTestRewardCalculatorArtifact() testRewardCalculatorArtifact {
+	runtime := testRewardCalculatorRuntime(calculateRewardSignature, testReward)
+	return testRewardCalculatorArtifact{
+		abi:              testRewardCalculatorABI("calculateReward", []string{"uint256", "uint64", "uint64", "uint64", "uint64"}, "view"),
+		bytecode:         hexutil.Encode(testCreationCode(nil, runtime)),
+		deployedBytecode: hexutil.Encode(runtime),
+	}
+}
+
+func testRewardCalculatorABI(name string, inputTypes []string, stateMutability string) []map[string]interface{} {
+	inputs := []map[string]string{}
+	for _, inputType := range inputTypes {
+		inputs = append(inputs, map[string]string{"name": "", "type": inputType, "internalType": inputType})
+	}
+	return []map[string]interface{}{
+		{
+			"type":            "function",
+			"name":            name,
+			"inputs":          inputs,
+			"outputs":         []map[string]string{{"name": "", "type": "uint256", "internalType": "uint256"}},
+			"stateMutability": stateMutability,
+		},
+	}
+}
+
+// writes [artifact] in forge format if [forge], or else in hardhat format
+func (a testRewardCalculatorArtifact) write(t *testing.T, forge bool) string {
+	artifact := map[string]interface{}{
+		"bytecode":         a.bytecode,
+		"deployedBytecode": a.deployedBytecode,
+	}
+	if a.abi != nil {
+		artifact["abi"] = a.abi
+	}
+	if forge {
+		immutableReferences := a.immutableReferences
+		if immutableReferences == nil {
+			immutableReferences = map[string]interface{}{}
+		}
+		artifact["bytecode"] = map[string]interface{}{"object": a.bytecode, "linkReferences": map[string]interface{}{}}
+		artifact["deployedBytecode"] = map[string]interface{}{
+			"object":              a.deployedBytecode,
+			"linkReferences":      map[string]interface{}{},
+			"immutableReferences": immutableReferences,
+		}
+	}
+	artifactBytes, err := json.Marshal(artifact)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "RewardCalculator.json")
+	require.NoError(t, os.WriteFile(path, artifactBytes, 0o600))
+	return path
+}
+
+func TestLoadRewardCalculatorArtifact(t *testing.T) {
+	runtime := testRewardCalculatorRuntime(calculateRewardSignature, testReward)
+	tests := []struct {
+		name        string
+		modify      func(*testRewardCalculatorArtifact)
+		errContains string
+	}{
+		{
+			name:   "valid",
+			modify: func(*testRewardCalculatorArtifact) {},
+		},
+		{
+			name: "missing method",
+			modify: func(a *testRewardCalculatorArtifact) {
+				a.abi = testRewardCalculatorABI("calculateRewards", []string{"uint256", "uint64", "uint64", "uint64", "uint64"}, "view")
+			},
+			errContains: "missing " + calculateRewardSignature,
+		},
+		{
+			name: "wrong signature",
+			modify: func(a *testRewardCalculatorArtifact) {
+				a.abi = testRewardCalculatorABI("calculateReward", []string{"uint256", "uint64", "uint64", "uint64"}, "view")
+			},
+			errContains: "expected " + calculateRewardSignature + ", found calculateReward(uint256,uint64,uint64,uint64)",
+		},
+		{
+			name: "non view",
+			modify: func(a *testRewardCalculatorArtifact) {
+				a.abi = testRewardCalculatorABI("calculateReward", []string{"uint256", "uint64", "uint64", "uint64", "uint64"}, "nonpayable")
+			},
+			errContains: "must be a view function",
+		},
+		{
+			name: "constructor arguments",
+			modify: func(a *testRewardCalculatorArtifact) {
+				a.abi = append(a.abi, map[string]interface{}{
+					"type":            "constructor",
+					"inputs":          []map[string]string{{"name": "rewardBasisPoints", "type": "uint64", "internalType": "uint64"}},
+					"stateMutability": "nonpayable",
+				})
+			},
+			errContains: "constructor arguments are not supported",
+		},
+		{
+			name: "method not dispatched",
+			modify: func(a *testRewardCalculatorArtifact) {
+				a.deployedBytecode = hexutil.Encode(testRewardCalculatorRuntime("calculateReward(uint256)", testReward))
+			},
+			errContains: "deployed bytecode does not dispatch",
+		},
+		{
+			name: "unlinked library",
+			modify: func(a *testRewardCalculatorArtifact) {
+				a.bytecode = hexutil.Encode(runtime) + "73__$0123456789abcdef0123456789abcdef01$__"
+			},
+			errContains: "unlinked libraries are not supported",
+		},
+		{
+			name: "empty bytecode",
+			modify: func(a *testRewardCalculatorArtifact) {
+				a.deployedBytecode = "0x"
+			},
+			errContains: "empty bytecode",
+		},
+		{
+			name: "no abi",
+			modify: func(a *testRewardCalculatorArtifact) {
+				a.abi = nil
+			},
+			errContains: "has no ABI",
+		},
+	}
+	for _, tt := range tests {
+		for _, forge := range []bool{true, false} {
+			name := tt.name + " hardhat"
+			if forge {
+				name = tt.name + " forge"
+			}
+			t.Run(name, func(t *testing.T) {
+				require := require.New(t)
+				artifact := newTestRewardCalculatorArtifact()
+				tt.modify(&artifact)
+				path := artifact.write(t, forge)
+				rewardCalculator, err := LoadRewardCalculatorArtifact(path)
+				if tt.errContains != "" {
+					require.ErrorContains(err, tt.errContains)
+					require.ErrorContains(err, path)
+					return
+				}
+				require.NoError(err)
+				require.Equal(path, rewardCalculator.Path)
+				require.Equal(runtime, rewardCalculator.DeployedBytecode)
+				require.Equal(testCreationCode(nil, runtime), rewardCalculator.Bytecode)
+				require.False(rewardCalculator.HasImmutables)
+				require.NoError(rewardCalculator.CheckConstructorFree())
+			})
+		}
+	}
+}
+
+func TestCheckConstructorFree(t *testing.T) {
+	runtime := testRewardCalculatorRuntime(calculateRewardSignature, testReward)
+	tests := []struct {
+		name        string
+		forge       bool
+		modify      func(*testRewardCalculatorArtifact)
+		errContains string
+	}{
+		{
+			name:   "no constructor",
+			modify: func(*testRewardCalculatorArtifact) {},
+		},
+		{
+			name:  "forge immutable references",
+			forge: true,
+			modify: func(a *testRewardCalculatorArtifact) {
+				a.immutableReferences = map[string]interface{}{
+					"7": []map[string]int{{"start": 22, "length": 32}},
+				}
+			},
+			errContains: "sets immutable variables",
+		},
+		{
+			// as hardhat artifacts do not give immutable references, they are found by
+			// comparing the constructor output with the deployed bytecode
+			name: "constructor output differs from deployed bytecode",
+			modify: func(a *testRewardCalculatorArtifact) {
+				a.deployedBytecode = hexutil.Encode(testRewardCalculatorRuntime(calculateRewardSignature, 0))
+			},
+			errContains: "modifies its deployed bytecode",
+		},
+		{
+			name: "storage initialization",
+			modify: func(a *testRewardCalculatorArtifact) {
+				// SSTORE(0, 1)
+				a.bytecode = hexutil.Encode(testCreationCode([]byte{0x60, 0x01, 0x60, 0x00, 0x55}, runtime))
+			},
+			errContains: "initializes storage",
+		},
+		{
+			name: "reverting constructor",
+			modify: func(a *testRewardCalculatorArtifact) {
+				// REVERT(0, 0)
+				a.bytecode = hexutil.Encode(testCreationCode([]byte{0x60, 0x00, 0x60, 0x00, 0xfd}, runtime))
+			},
+			errContains: "failure executing",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			artifact := newTestRewardCalculatorArtifact()
+			tt.modify(&artifact)
+			rewardCalculator, err := LoadRewardCalculatorArtifact(artifact.write(t, tt.forge))
+			require.NoError(err)
+			err = rewardCalculator.CheckConstructorFree()
+			allocs := core.GenesisAlloc{}
+			allocErr := AddCustomRewardCalculatorToAllocations(allocs, rewardCalculator)
+			if tt.errContains != "" {
+				require.ErrorContains(err, tt.errContains)
+				require.ErrorContains(allocErr, tt.errContains)
+				require.Empty(allocs)
+				return
+			}
+			require.NoError(err)
+			require.NoError(allocErr)
+			require.Equal(runtime, allocs[common.HexToAddress(validatorManagerSDK.RewardCalculatorAddress)].Code)
+		})
+	}
+}
+
+func TestCustomRewardCalculator(t *testing.T) {
+	require := testutils.SetupTest(t)
+	rewardCalculator, err := LoadRewardCalculatorArtifact(newTestRewardCalculatorArtifact().write(t, true))
+	require.NoError(err)
+	simEVM := testutils.NewSimulatedEVM(t, func(allocs core.GenesisAlloc, _ common.Address) {
+		require.NoError(AddCustomRewardCalculatorToAllocations(allocs, rewardCalculator))
+	})
+	stakeAmount := big.NewInt(1e18)
+	reward, err := EstimateReward(simEVM.RPCURL, common.HexToAddress(validatorManagerSDK.RewardCalculatorAddress), stakeAmount, 0, 0, 3600, 3600)
+	require.NoError(err)
+	require.Equal(big.NewInt(testReward), reward)
+
+	rewardCalculatorAddress, err := DeployCustomRewardCalculatorContract(simEVM.RPCURL, simEVM.PrivateKey, rewardCalculator)
+	require.NoError(err)
+	reward, err = EstimateReward(simEVM.RPCURL, rewardCalculatorAddress, stakeAmount, 0, 0, 3600, 3600)
+	require.NoError(err)
+	require.Equal(big.NewInt(testReward), reward)
+}
@@ -3,8 +3,10 @@
 package validatormanager
 
 import (
+	"bytes"
 	"context"
 	_ "embed"
+	"encoding/hex"
 	"math/big"
 	"strings"
 
@@ -107,12 +109,41 @@ func DeployPoSValidatorManagerContract(
 	)
 }
 
+// ERC20 token staking manager creation bytecode, as given by the icm-contracts v1.0.8
+// go bindings. See scripts/build_validator_manager_contracts.sh
+//
+//go:embed erc20_token_staking_manager_bytecode.txt
+var erc20PoSValidatorManagerBytecode []byte
+
+func DeployERC20PoSValidatorManagerContract(
+	rpcURL string,
+	privateKey string,
+) (common.Address, error) {
+	erc20PoSValidatorManagerString := strings.TrimSpace(string(erc20PoSValidatorManagerBytecode))
+	erc20PoSValidatorManagerString = fillValidatorMessagesAddressPlaceholder(erc20PoSValidatorManagerString)
+	erc20PoSValidatorManagerBytes := []byte(erc20PoSValidatorManagerString)
+	return contract.DeployContract(
+		rpcURL,
+		privateKey,
+		erc20PoSValidatorManagerBytes,
+		"(uint8)",
+		uint8(0),
+	)
+}
+
+// DeployAndRegisterPoSValidatorManagerContrac deploys a PoS validator manager and sets it
+// behind the proxy. The manager stakes [stakingTokenAddress] if given, or the native token
 func DeployAndRegisterPoSValidatorManagerContrac(
 	rpcURL string,
 	privateKey string,
 	proxyOwnerPrivateKey string,
+	stakingTokenAddress string,
 ) (common.Address, error) {
-	posValidatorManagerAddress, err := DeployPoSValidatorManagerContract(
+	deploy := DeployPoSValidatorManagerContract
+	if stakingTokenAddress != "" {
+		deploy = DeployERC20PoSValidatorManagerContract
+	}
+	posValidatorManagerAddress, err := deploy(
 		rpcURL,
 		privateKey,
 	)
@@ -177,17 +208,65 @@ func AddRewardCalculatorToAllocations(
 	allocs core.GenesisAlloc,
 	rewardBasisPoints uint64,
 ) {
-	deployedRewardCalculatorBytes := common.FromHex(strings.TrimSpace(string(deployedRewardCalculatorBytecode)))
 	allocs[common.HexToAddress(validatorManagerSDK.RewardCalculatorAddress)] = core.GenesisAccount{
 		Balance: big.NewInt(0),
-		Code:    deployedRewardCalculatorBytes,
+		Code:    deployedRewardCalculator(rewardBasisPoints),
 		Nonce:   1,
-		Storage: map[common.Hash]common.Hash{
-			common.HexToHash("0x0"): common.BigToHash(new(big.Int).SetUint64(rewardBasisPoints)),
-		},
 	}
 }
 
+// deployedRewardCalculator returns the deployed bytecode of the example reward calculator,
+// with its rewardBasisPoints immutable set to [rewardBasisPoints]. The immutable is
+// inlined by the compiler as the only PUSH32 operands of the bytecode, left zeroed
+func deployedRewardCalculator(rewardBasisPoints uint64) []byte {
+	bytecode := common.FromHex(strings.TrimSpace(string(deployedRewardCalculatorBytecode)))
+	const (
+		push1Opcode  = 0x60
+		push32Opcode = 0x7f
+	)
+	rewardBasisPointsBytes := common.BigToHash(new(big.Int).SetUint64(rewardBasisPoints)).Bytes()
+	for i := 0; i < len(bytecode); i++ {
+		opcode := bytecode[i]
+		if opcode == push32Opcode && i+32 < len(bytecode) && common.BytesToHash(bytecode[i+1:i+33]) == (common.Hash{}) {
+			copy(bytecode[i+1:], rewardBasisPointsBytes)
+		}
+		if opcode >= push1Opcode && opcode <= push32Opcode {
+			i += int(opcode-push1Opcode) + 1
+		}
+	}
+	return bytecode
+}
+
+// rewardCalculatorInitCode returns the init code of the example reward calculator.
+// Only the deployed bytecode is available, so the init code returns the deployed bytecode,
+// with [rewardBasisPoints] set, appended to it:
+// PUSH2 len DUP1 PUSH2 offset PUSH1 0 CODECOPY PUSH1 0 RETURN
+func rewardCalculatorInitCode(rewardBasisPoints uint64) []byte {
+	deployedRewardCalculatorBytes := deployedRewardCalculator(rewardBasisPoints)
+	const initCodeLen = 13
+	initCode := []byte{0x61, byte(len(deployedRewardCalculatorBytes) >> 8), byte(len(deployedRewardCalculatorBytes))}
+	initCode = append(initCode, 0x80)
+	initCode = append(initCode, 0x61, 0x00, initCodeLen)
+	initCode = append(initCode, 0x60, 0x00, 0x39, 0x60, 0x00, 0xf3)
+	return append(initCode, deployedRewardCalculatorBytes...)
+}
+
+// DeployRewardCalculatorContract deploys the example reward calculator set up on genesis by
+// AddRewardCalculatorToAllocations, for blockchains that did not get it on genesis
+func DeployRewardCalculatorContract(
+	rpcURL string,
+	privateKey string,
+	rewardBasisPoints uint64,
+) (common.Address, error) {
+	initCode := rewardCalculatorInitCode(rewardBasisPoints)
+	return contract.DeployContract(
+		rpcURL,
+		privateKey,
+		[]byte(hex.EncodeToString(initCode)),
+		"()",
+	)
+}
+
 // setups PoA manager after a successful execution of
 // ConvertSubnetToL1Tx on P-Chain
 // needs the list of validators for that tx,
@@ -243,3 +322,57 @@ func SetupPoS(
 		validatorManagerAddressStr,
 	)
 }
+
+// runtimeBytecode extracts the runtime bytecode out of solidity [creationBytecode], by looking
+// for the constructor epilogue that copies it to memory and returns it:
+// PUSH2 len DUP1 PUSH2 offset PUSH0 CODECOPY PUSH0 RETURN INVALID
+func runtimeBytecode(creationBytecode []byte) ([]byte, bool) {
+	epilogue := []byte{0x5f, 0x39, 0x5f, 0xf3, 0xfe}
+	for start := 0; ; {
+		i := bytes.Index(creationBytecode[start:], epilogue)
+		if i < 0 {
+			return nil, false
+		}
+		i += start
+		start = i + 1
+		if i < 7 || creationBytecode[i-7] != 0x61 || creationBytecode[i-4] != 0x80 || creationBytecode[i-3] != 0x61 {
+			continue
+		}
+		length := int(creationBytecode[i-6])<<8 | int(creationBytecode[i-5])
+		offset := int(creationBytecode[i-2])<<8 | int(creationBytecode[i-1])
+		if offset+length > len(creationBytecode) {
+			continue
+		}
+		return creationBytecode[offset : offset+length], true
+	}
+}
+
+// GetValidatorManagerRelease returns the name of the validator manager release deployed
+// by the CLI, whose runtime bytecode is [deployedBytecode]. Releases are matched both
+// against their genesis bytecode and against the runtime bytecode of their deployment
+func GetValidatorManagerRelease(deployedBytecode []byte) (string, bool) {
+	releases := []struct {
+		name     string
+		bytecode []byte
+		creation bool
+	}{
+		{"PoA Validator Manager v1.0.0", deployedPoAValidatorManagerBytecode, false},
+		{"Validator Manager (ACP99)", deployedPoAValidatorManagerACP99Bytecode, false},
+		{"Native Token Staking Manager", deployedPoSValidatorManagerBytecode, false},
+		{"Native Token Staking Manager", posValidatorManagerBytecode, true},
+		{"ERC20 Token Staking Manager", erc20PoSValidatorManagerBytecode, true},
+	}
+	for _, release := range releases {
+		releaseBytecode := common.FromHex(fillValidatorMessagesAddressPlaceholder(strings.TrimSpace(string(release.bytecode))))
+		if release.creation {
+			var ok bool
+			if releaseBytecode, ok = runtimeBytecode(releaseBytecode); !ok {
+				continue
+			}
+		}
+		if bytes.Equal(releaseBytecode, deployedBytecode) {
+			return release.name, true
+		}
+	}
+	return "", false
+}
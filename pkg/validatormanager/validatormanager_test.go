@@ -0,0 +1,189 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package validatormanager
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/internal/testutils"
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	"github.com/ava-labs/avalanche-cli/sdk/evm"
+	validatorManagerSDK "github.com/ava-labs/avalanche-cli/sdk/validatormanager"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/core"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestDeployERC20PoSValidatorManagerContract(t *testing.T) {
+	require := testutils.SetupTest(t)
+	simEVM := testutils.NewSimulatedEVM(t, func(allocs core.GenesisAlloc, _ common.Address) {
+		AddValidatorMessagesACP99ContractToAllocations(allocs)
+	})
+
+	tokenAddress, err := contract.DeployERC20(simEVM.RPCURL, simEVM.PrivateKey, "TST", simEVM.Address, testTokenSupply)
+	require.NoError(err)
+	managerAddress, err := DeployERC20PoSValidatorManagerContract(simEVM.RPCURL, simEVM.PrivateKey)
+	require.NoError(err)
+
+	posParams := testPoSParams
+	posParams.StakingTokenAddress = tokenAddress.Hex()
+	_, _, err = validatorManagerSDK.PoSValidatorManagerInitialize(
+		simEVM.RPCURL,
+		managerAddress,
+		simEVM.PrivateKey,
+		ids.GenerateTestID(),
+		posParams,
+	)
+	require.NoError(err)
+	out, err := contract.CallToMethod(simEVM.RPCURL, managerAddress, "erc20()->(address)")
+	require.NoError(err)
+	require.Equal(tokenAddress, out[0])
+
+	// initialization can't be repeated
+	_, _, err = validatorManagerSDK.PoSValidatorManagerInitialize(
+		simEVM.RPCURL,
+		managerAddress,
+		simEVM.PrivateKey,
+		ids.GenerateTestID(),
+		posParams,
+	)
+	require.Error(err)
+}
+
+func TestGetValidatorManagerRelease(t *testing.T) {
+	require := testutils.SetupTest(t)
+	genesisBytecode := func(bytecode []byte) []byte {
+		return common.FromHex(fillValidatorMessagesAddressPlaceholder(strings.TrimSpace(string(bytecode))))
+	}
+	tests := []struct {
+		name            string
+		bytecode        []byte
+		expectedRelease string
+		expectedFound   bool
+	}{
+		{
+			name:            "genesis PoA v1.0.0",
+			bytecode:        genesisBytecode(deployedPoAValidatorManagerBytecode),
+			expectedRelease: "PoA Validator Manager v1.0.0",
+			expectedFound:   true,
+		},
+		{
+			name:            "genesis ACP99",
+			bytecode:        genesisBytecode(deployedPoAValidatorManagerACP99Bytecode),
+			expectedRelease: "Validator Manager (ACP99)",
+			expectedFound:   true,
+		},
+		{
+			name:            "genesis native token staking manager",
+			bytecode:        genesisBytecode(deployedPoSValidatorManagerBytecode),
+			expectedRelease: "Native Token Staking Manager",
+			expectedFound:   true,
+		},
+		{
+			name:          "reward calculator",
+			bytecode:      genesisBytecode(deployedRewardCalculatorBytecode),
+			expectedFound: false,
+		},
+		{
+			name:          "empty",
+			bytecode:      nil,
+			expectedFound: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			release, found := GetValidatorManagerRelease(tt.bytecode)
+			require.Equal(tt.expectedFound, found)
+			require.Equal(tt.expectedRelease, release)
+		})
+	}
+}
+
+func TestGetValidatorManagerReleaseOfDeployment(t *testing.T) {
+	require := testutils.SetupTest(t)
+	simEVM := testutils.NewSimulatedEVM(t, func(allocs core.GenesisAlloc, _ common.Address) {
+		AddValidatorMessagesACP99ContractToAllocations(allocs)
+	})
+	client, err := evm.GetClient(simEVM.RPCURL)
+	require.NoError(err)
+	defer client.Close()
+	tests := []struct {
+		name            string
+		deploy          func(rpcURL string, privateKey string) (common.Address, error)
+		expectedRelease string
+	}{
+		{
+			name:            "native token staking manager",
+			deploy:          DeployPoSValidatorManagerContract,
+			expectedRelease: "Native Token Staking Manager",
+		},
+		{
+			name:            "ERC20 token staking manager",
+			deploy:          DeployERC20PoSValidatorManagerContract,
+			expectedRelease: "ERC20 Token Staking Manager",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address, err := tt.deploy(simEVM.RPCURL, simEVM.PrivateKey)
+			require.NoError(err)
+			bytecode, err := client.GetContractBytecode(address.Hex())
+			require.NoError(err)
+			release, found := GetValidatorManagerRelease(bytecode)
+			require.True(found)
+			require.Equal(tt.expectedRelease, release)
+		})
+	}
+}
+
+func TestDeployRewardCalculatorContract(t *testing.T) {
+	require := testutils.SetupTest(t)
+	simEVM := testutils.NewSimulatedEVM(t, nil)
+	rewardBasisPoints := uint64(450)
+
+	initCode := rewardCalculatorInitCode(rewardBasisPoints)
+	deployedBytecode := deployedRewardCalculator(rewardBasisPoints)
+	require.Equal(deployedBytecode, initCode[13:])
+
+	address, err := DeployRewardCalculatorContract(simEVM.RPCURL, simEVM.PrivateKey, rewardBasisPoints)
+	require.NoError(err)
+	code, err := simEVM.Backend.Client().CodeAt(context.Background(), address, nil)
+	require.NoError(err)
+	require.Equal(deployedBytecode, code)
+	out, err := contract.CallToMethod(simEVM.RPCURL, address, "rewardBasisPoints()->(uint64)")
+	require.NoError(err)
+	require.Equal([]interface{}{rewardBasisPoints}, out)
+	// a year of full uptime is rewarded 4.5%
+	out, err = contract.CallToMethod(
+		simEVM.RPCURL,
+		address,
+		"calculateReward(uint256,uint64,uint64,uint64,uint64)->(uint256)",
+		big.NewInt(1e18),
+		uint64(0),
+		uint64(0),
+		uint64(365*24*3600),
+		uint64(365*24*3600),
+	)
+	require.NoError(err)
+	reward, err := contract.GetSmartContractCallResult[*big.Int]("calculateReward", out)
+	require.NoError(err)
+	require.Equal(big.NewInt(45e15).String(), reward.String())
+}
+
+func TestAddRewardCalculatorToAllocations(t *testing.T) {
+	require := testutils.SetupTest(t)
+	simEVM := testutils.NewSimulatedEVM(t, func(allocs core.GenesisAlloc, _ common.Address) {
+		AddRewardCalculatorToAllocations(allocs, 200)
+	})
+	out, err := contract.CallToMethod(
+		simEVM.RPCURL,
+		common.HexToAddress(validatorManagerSDK.RewardCalculatorAddress),
+		"rewardBasisPoints()->(uint64)",
+	)
+	require.NoError(err)
+	require.Equal([]interface{}{uint64(200)}, out)
+}
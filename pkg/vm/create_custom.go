@@ -3,10 +3,16 @@
 package vm
 
 import (
+	"crypto/sha256"
+	"debug/elf"
+	"debug/macho"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 
 	"github.com/ava-labs/avalanche-cli/pkg/application"
 	"github.com/ava-labs/avalanche-cli/pkg/constants"
@@ -14,8 +20,11 @@ import (
 	"github.com/ava-labs/avalanche-cli/pkg/prompts"
 	"github.com/ava-labs/avalanche-cli/pkg/utils"
 	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	sdkUtils "github.com/ava-labs/avalanche-cli/sdk/utils"
 )
 
+var ErrCustomVMHashMismatch = errors.New("custom VM binary does not match the pinned build")
+
 func CreateCustomSidecar(
 	sc *models.Sidecar,
 	app *application.Avalanche,
@@ -145,48 +154,79 @@ func CheckGitIsInstalled() error {
 	return err
 }
 
+// BuildCustomVM builds the custom VM of [sc] into the blockchain custom VM path. The build is
+// pinned to the commit recorded on [sc], or if there is none, to the current commit of the
+// custom VM branch. If [sc] already records a binary hash, the build must reproduce it,
+// otherwise the hash of the new binary is recorded
 func BuildCustomVM(
 	app *application.Avalanche,
 	sc *models.Sidecar,
 ) error {
-	if err := CheckGitIsInstalled(); err != nil {
+	vmPath := app.GetCustomVMPath(sc.Name)
+	if err := BuildCustomVMBinary(app, sc, vmPath); err != nil {
+		return err
+	}
+	if sc.CustomVMBinarySHA256 != "" {
+		return VerifyCustomVMBinary(*sc, vmPath)
+	}
+	binaryHash, err := utils.GetSHA256FromDisk(vmPath)
+	if err != nil {
 		return err
 	}
+	sc.CustomVMBinarySHA256 = binaryHash
+	ux.Logger.PrintToUser("Custom VM pinned to commit %s with binary SHA-256 %s", sc.CustomVMCommit, binaryHash)
+	return nil
+}
 
-	// create repo dir
-	reposDir := app.GetReposDir()
-	repoDir := filepath.Join(reposDir, sc.Name)
-	_ = os.RemoveAll(repoDir)
-	if err := os.MkdirAll(repoDir, constants.DefaultPerms755); err != nil {
+// BuildCustomVMBinary builds the custom VM of [sc] into [vmPath], at the commit pinned on [sc].
+// If [sc] has no pinned commit, the current commit of its branch is built and pinned.
+// Builds run on a cached checkout of the custom VM repository
+func BuildCustomVMBinary(
+	app *application.Avalanche,
+	sc *models.Sidecar,
+	vmPath string,
+) error {
+	if err := CheckGitIsInstalled(); err != nil {
 		return err
 	}
-	// get branch from repo
-	cmd := exec.Command("git", "init", "-q")
-	cmd.Dir = repoDir
-	utils.SetupRealtimeCLIOutput(cmd, true, true)
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("could not init git directory on %s: %w", repoDir, err)
+
+	repoDir := getCustomVMBuildDir(app, sc.CustomVMRepoURL)
+	if !sdkUtils.DirExists(filepath.Join(repoDir, ".git")) {
+		if err := os.MkdirAll(repoDir, constants.DefaultPerms755); err != nil {
+			return err
+		}
+		if err := runGitCommand(repoDir, "init", "-q"); err != nil {
+			return fmt.Errorf("could not init git directory on %s: %w", repoDir, err)
+		}
+		if err := runGitCommand(repoDir, "remote", "add", "origin", sc.CustomVMRepoURL); err != nil {
+			return fmt.Errorf("could not add origin %s on git: %w", sc.CustomVMRepoURL, err)
+		}
 	}
-	cmd = exec.Command("git", "remote", "add", "origin", sc.CustomVMRepoURL)
-	cmd.Dir = repoDir
-	utils.SetupRealtimeCLIOutput(cmd, true, true)
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("could not add origin %s on git: %w", sc.CustomVMRepoURL, err)
+	ref := sc.CustomVMCommit
+	if ref == "" {
+		ref = sc.CustomVMBranch
 	}
-	cmd = exec.Command("git", "fetch", "--depth", "1", "origin", sc.CustomVMBranch, "-q")
-	cmd.Dir = repoDir
-	utils.SetupRealtimeCLIOutput(cmd, true, true)
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("could not fetch git branch/commit %s of repository %s: %w", sc.CustomVMBranch, sc.CustomVMRepoURL, err)
+	if err := runGitCommand(repoDir, "fetch", "--depth", "1", "origin", ref, "-q"); err != nil {
+		return fmt.Errorf("could not fetch git branch/commit %s of repository %s: %w", ref, sc.CustomVMRepoURL, err)
 	}
-	cmd = exec.Command("git", "checkout", sc.CustomVMBranch)
+	cmd := exec.Command("git", "rev-parse", "FETCH_HEAD")
 	cmd.Dir = repoDir
-	utils.SetupRealtimeCLIOutput(cmd, true, true)
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("could not checkout git branch %s of repository %s: %w", sc.CustomVMBranch, sc.CustomVMRepoURL, err)
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("could not resolve git branch/commit %s of repository %s: %w", ref, sc.CustomVMRepoURL, err)
+	}
+	commit := strings.TrimSpace(string(out))
+	if sc.CustomVMCommit != "" && commit != sc.CustomVMCommit {
+		return fmt.Errorf("fetched commit %s does not match pinned commit %s", commit, sc.CustomVMCommit)
+	}
+	if err := runGitCommand(repoDir, "checkout", "-q", "-f", "--detach", commit); err != nil {
+		return fmt.Errorf("could not checkout git commit %s of repository %s: %w", commit, sc.CustomVMRepoURL, err)
+	}
+	// start from a pristine source tree, while keeping the go build cache
+	if err := runGitCommand(repoDir, "clean", "-q", "-f", "-d", "-x"); err != nil {
+		return fmt.Errorf("could not clean git directory on %s: %w", repoDir, err)
 	}
 
-	vmPath := app.GetCustomVMPath(sc.Name)
 	_ = os.RemoveAll(vmPath)
 
 	// build
@@ -202,5 +242,96 @@ func BuildCustomVM(
 	if !utils.IsExecutable(vmPath) {
 		return fmt.Errorf("custom VM binary %s not executable. Expected build script to create an executable file", vmPath)
 	}
+	sc.CustomVMCommit = commit
+	return nil
+}
+
+// VerifyCustomVMBinary checks that [vmPath] has the binary SHA-256 pinned on [sc].
+// Sidecars without a pinned hash are not verified
+func VerifyCustomVMBinary(sc models.Sidecar, vmPath string) error {
+	if sc.CustomVMBinarySHA256 == "" {
+		return nil
+	}
+	binaryHash, err := utils.GetSHA256FromDisk(vmPath)
+	if err != nil {
+		return err
+	}
+	return CheckCustomVMBinaryHash(sc, binaryHash)
+}
+
+// CheckCustomVMBinaryHash checks that [binaryHash] is the binary SHA-256 pinned on [sc]
+func CheckCustomVMBinaryHash(sc models.Sidecar, binaryHash string) error {
+	if sc.CustomVMBinarySHA256 != "" && binaryHash != sc.CustomVMBinarySHA256 {
+		return fmt.Errorf(
+			"%w: got SHA-256 %s but blockchain %s is pinned to %s (commit %s)",
+			ErrCustomVMHashMismatch,
+			binaryHash,
+			sc.Name,
+			sc.CustomVMBinarySHA256,
+			sc.CustomVMCommit,
+		)
+	}
+	return nil
+}
+
+// GetCustomVMBinaryPlatform returns the OS and architecture, in go notation, of the
+// binary at [vmPath]
+func GetCustomVMBinaryPlatform(vmPath string) (string, string, error) {
+	if f, err := elf.Open(vmPath); err == nil {
+		defer f.Close()
+		switch f.Machine {
+		case elf.EM_X86_64:
+			return "linux", "amd64", nil
+		case elf.EM_AARCH64:
+			return "linux", "arm64", nil
+		default:
+			return "", "", fmt.Errorf("unsupported architecture %s on custom VM binary %s", f.Machine, vmPath)
+		}
+	}
+	if f, err := macho.Open(vmPath); err == nil {
+		defer f.Close()
+		switch f.Cpu {
+		case macho.CpuAmd64:
+			return "darwin", "amd64", nil
+		case macho.CpuArm64:
+			return "darwin", "arm64", nil
+		default:
+			return "", "", fmt.Errorf("unsupported architecture %s on custom VM binary %s", f.Cpu, vmPath)
+		}
+	}
+	return "", "", fmt.Errorf("custom VM binary %s is not a linux or darwin executable", vmPath)
+}
+
+// CheckCustomVMBinaryPlatform checks that the binary at [vmPath] can run on [goOS]/[goArch]
+func CheckCustomVMBinaryPlatform(vmPath string, goOS string, goArch string) error {
+	binaryOS, binaryArch, err := GetCustomVMBinaryPlatform(vmPath)
+	if err != nil {
+		return err
+	}
+	if binaryOS != goOS || binaryArch != goArch {
+		return fmt.Errorf(
+			"custom VM binary %s is built for %s/%s, but %s/%s is required. Rebuild it on a %s/%s machine with 'avalanche blockchain vm rebuild'",
+			vmPath,
+			binaryOS,
+			binaryArch,
+			goOS,
+			goArch,
+			goOS,
+			goArch,
+		)
+	}
 	return nil
 }
+
+// returns the cached build dir for the custom VM repository [repoURL]
+func getCustomVMBuildDir(app *application.Avalanche, repoURL string) string {
+	repoHash := sha256.Sum256([]byte(repoURL))
+	return filepath.Join(app.GetReposDir(), constants.CustomVMBuildsDir, hex.EncodeToString(repoHash[:8]))
+}
+
+func runGitCommand(repoDir string, args ...string) error {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = repoDir
+	utils.SetupRealtimeCLIOutput(cmd, true, true)
+	return cmd.Run()
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vm
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+)
+
+func TestVerifyCustomVMBinary(t *testing.T) {
+	require := setupTest(t)
+	vmPath := filepath.Join(t.TempDir(), "vm")
+	require.NoError(os.WriteFile(vmPath, []byte("custom vm binary"), 0o600))
+	binaryHash, err := utils.GetSHA256FromDisk(vmPath)
+	require.NoError(err)
+
+	tests := []struct {
+		name        string
+		pinnedHash  string
+		vmPath      string
+		expectedErr error
+		errContains string
+	}{
+		{
+			name:       "matching hash",
+			pinnedHash: binaryHash,
+			vmPath:     vmPath,
+		},
+		{
+			name:        "mismatching hash",
+			pinnedHash:  "0000000000000000000000000000000000000000000000000000000000000000",
+			vmPath:      vmPath,
+			expectedErr: ErrCustomVMHashMismatch,
+		},
+		{
+			name:   "no pinned hash",
+			vmPath: vmPath,
+		},
+		{
+			name:   "no pinned hash nor binary",
+			vmPath: filepath.Join(t.TempDir(), "missing"),
+		},
+		{
+			name:        "pinned hash without binary",
+			pinnedHash:  binaryHash,
+			vmPath:      filepath.Join(t.TempDir(), "missing"),
+			errContains: "failed looking up plugin binary",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := models.Sidecar{
+				Name:                 "testblockchain",
+				CustomVMCommit:       "0123456789abcdef0123456789abcdef01234567",
+				CustomVMBinarySHA256: tt.pinnedHash,
+			}
+			err := VerifyCustomVMBinary(sc, tt.vmPath)
+			switch {
+			case tt.expectedErr != nil:
+				require.ErrorIs(err, tt.expectedErr)
+			case tt.errContains != "":
+				require.ErrorContains(err, tt.errContains)
+			default:
+				require.NoError(err)
+			}
+		})
+	}
+}
+
+func TestCheckCustomVMBinaryHash(t *testing.T) {
+	require := setupTest(t)
+	sc := models.Sidecar{
+		Name:                 "testblockchain",
+		CustomVMCommit:       "0123456789abcdef0123456789abcdef01234567",
+		CustomVMBinarySHA256: "aa",
+	}
+	require.NoError(CheckCustomVMBinaryHash(sc, "aa"))
+	err := CheckCustomVMBinaryHash(sc, "bb")
+	require.ErrorIs(err, ErrCustomVMHashMismatch)
+	require.ErrorContains(err, "got SHA-256 bb but blockchain testblockchain is pinned to aa (commit 0123456789abcdef0123456789abcdef01234567)")
+	sc.CustomVMBinarySHA256 = ""
+	require.NoError(CheckCustomVMBinaryHash(sc, "bb"))
+}
+
+func TestCheckCustomVMBinaryPlatform(t *testing.T) {
+	require := setupTest(t)
+	if (runtime.GOOS != "linux" && runtime.GOOS != "darwin") || (runtime.GOARCH != "amd64" && runtime.GOARCH != "arm64") {
+		t.Skip("unsupported test platform")
+	}
+	// the test binary itself is built for the running platform
+	vmPath, err := os.Executable()
+	require.NoError(err)
+	goOS, goArch, err := GetCustomVMBinaryPlatform(vmPath)
+	require.NoError(err)
+	require.Equal(runtime.GOOS, goOS)
+	require.Equal(runtime.GOARCH, goArch)
+	require.NoError(CheckCustomVMBinaryPlatform(vmPath, runtime.GOOS, runtime.GOARCH))
+	require.ErrorContains(CheckCustomVMBinaryPlatform(vmPath, "plan9", runtime.GOARCH), "is built for")
+
+	scriptPath := filepath.Join(t.TempDir(), "build.sh")
+	require.NoError(os.WriteFile(scriptPath, []byte("#!/usr/bin/env bash\n"), 0o600))
+	_, _, err = GetCustomVMBinaryPlatform(scriptPath)
+	require.ErrorContains(err, "is not a linux or darwin executable")
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package vm
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+)
+
+// CreateDescriptorSidecar sets up [sc] for a blockchain running the VM described by [descriptor],
+// installing release [vmVersion] of it
+func CreateDescriptorSidecar(
+	sc *models.Sidecar,
+	app *application.Avalanche,
+	descriptor Descriptor,
+	blockchainName string,
+	vmVersion string,
+	tokenSymbol string,
+	sovereign bool,
+) (*models.Sidecar, error) {
+	ux.Logger.PrintToUser("creating %s blockchain %s", descriptor.Type(), blockchainName)
+
+	if sc == nil {
+		sc = &models.Sidecar{}
+	}
+
+	sc.Name = blockchainName
+	sc.VM = descriptor.Type()
+	sc.Subnet = blockchainName
+	if tokenSymbol != "" {
+		sc.TokenSymbol = tokenSymbol
+		sc.TokenName = tokenSymbol + " Token"
+	}
+
+	installedVersion, rpcVersion, err := InstallDescriptorVMRelease(app, descriptor, blockchainName, vmVersion)
+	if err != nil {
+		return nil, err
+	}
+	sc.VMVersion = installedVersion
+	sc.RPCVersion = rpcVersion
+	sc.Sovereign = sovereign
+	return sc, nil
+}
+
+// InstallDescriptorVMRelease downloads release [vmVersion] of the VM described by [descriptor], and
+// sets it as the VM binary of [blockchainName]. Returns the installed version and its RPC version
+func InstallDescriptorVMRelease(
+	app *application.Avalanche,
+	descriptor Descriptor,
+	blockchainName string,
+	vmVersion string,
+) (string, int, error) {
+	installedVersion, vmBin, err := descriptor.DownloadRelease(app, vmVersion)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to install %s: %w", descriptor.Type(), err)
+	}
+	// VMs other than Subnet-EVM run from the blockchain custom VM binary path
+	if descriptor.Type() != models.SubnetEvm {
+		if err := app.CopyVMBinary(vmBin, blockchainName); err != nil {
+			return "", 0, err
+		}
+	}
+	rpcVersion, err := GetVMBinaryProtocolVersion(vmBin)
+	if err != nil {
+		return "", 0, fmt.Errorf("unable to get RPC version: %w", err)
+	}
+	return installedVersion, rpcVersion, nil
+}
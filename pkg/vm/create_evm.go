@@ -86,6 +86,7 @@ func CreateEVMGenesis(
 	addICMRegistryToGenesis bool,
 	proxyOwner string,
 	rewardBasisPoints uint64,
+	rewardCalculator *validatormanager.RewardCalculatorArtifact,
 	useACP99 bool,
 ) ([]byte, error) {
 	feeConfig := getFeeConfig(params)
@@ -143,7 +144,13 @@ func CreateEVMGenesis(
 		validatormanager.AddTransparentProxyContractToAllocations(params.initialTokenAllocation, proxyOwner)
 		// valid for v1.0.0
 		validatormanager.AddValidatorMessagesACP99ContractToAllocations(params.initialTokenAllocation)
-		validatormanager.AddRewardCalculatorToAllocations(params.initialTokenAllocation, rewardBasisPoints)
+		if rewardCalculator != nil {
+			if err := validatormanager.AddCustomRewardCalculatorToAllocations(params.initialTokenAllocation, *rewardCalculator); err != nil {
+				return nil, err
+			}
+		} else {
+			validatormanager.AddRewardCalculatorToAllocations(params.initialTokenAllocation, rewardBasisPoints)
+		}
 		params.enableNativeMinterPrecompile = true
 	}
 
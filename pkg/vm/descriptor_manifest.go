@@ -0,0 +1,344 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package vm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/binutils"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	sdkUtils "github.com/ava-labs/avalanche-cli/sdk/utils"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	GenesisFieldString  = "string"
+	GenesisFieldUint64  = "uint64"
+	GenesisFieldBool    = "bool"
+	GenesisFieldAddress = "address"
+
+	defaultManifestRPCPath      = "rpc"
+	defaultManifestHealthMethod = "health"
+	manifestReleaseAssetExt     = ".tar.gz"
+)
+
+// DescriptorManifest describes a third party VM, so its blockchains can be managed the
+// same way as Subnet-EVM ones. Manifests are JSON files placed on the app VM descriptors dir
+type DescriptorManifest struct {
+	// VM type recorded on the sidecar of the VM blockchains
+	Name string `json:"name"`
+	// github org and repo the VM releases are published on
+	ReleaseOrg  string `json:"org"`
+	ReleaseRepo string `json:"repo"`
+	// name of the release tar.gz asset. It is a go template accepting
+	// {{.Version}}, {{.VersionNumber}} (version without v prefix), {{.OS}} and {{.Arch}}
+	ReleaseAsset string `json:"releaseAsset"`
+	// path of the VM binary inside the release asset
+	Binary string `json:"binary"`
+	// RPC path relative to /ext/bc/<blockchainID>. Defaults to rpc
+	RPCPath string `json:"rpcPath,omitempty"`
+	// JSON-RPC method that must succeed for the blockchain to be healthy. If empty,
+	// any JSON-RPC answer from the blockchain RPC is considered healthy
+	HealthMethod string `json:"healthMethod,omitempty"`
+	// default genesis, as a JSON object
+	Genesis json.RawMessage `json:"genesis"`
+	// top level genesis fields to be prompted for, and required on user provided genesis
+	GenesisFields []GenesisField `json:"genesisFields,omitempty"`
+	// default chain config files, for regular and debug setups
+	ChainConfig      json.RawMessage `json:"chainConfig,omitempty"`
+	DebugChainConfig json.RawMessage `json:"debugChainConfig,omitempty"`
+}
+
+// GenesisField is a top level genesis field of a manifest described VM
+type GenesisField struct {
+	Key    string `json:"key"`
+	Prompt string `json:"prompt"`
+	// one of string, uint64, bool or address
+	Type string `json:"type"`
+}
+
+var (
+	_ Descriptor      = DescriptorManifest{}
+	_ GenesisPrompter = DescriptorManifest{}
+)
+
+// LoadDescriptorManifests reads and validates all the manifests on the app VM descriptors dir
+func LoadDescriptorManifests(app *application.Avalanche) ([]DescriptorManifest, error) {
+	dir := app.GetVMDescriptorsDir()
+	if !sdkUtils.DirExists(dir) {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	manifests := []DescriptorManifest{}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		manifestBytes, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		manifest, err := ParseDescriptorManifest(manifestBytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid VM descriptor manifest %s: %w", path, err)
+		}
+		manifests = append(manifests, manifest)
+	}
+	return manifests, nil
+}
+
+// ParseDescriptorManifest parses and validates [manifestBytes]
+func ParseDescriptorManifest(manifestBytes []byte) (DescriptorManifest, error) {
+	var manifest DescriptorManifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return DescriptorManifest{}, err
+	}
+	switch manifest.Name {
+	case "":
+		return DescriptorManifest{}, fmt.Errorf("missing VM name")
+	case models.SubnetEvm, models.CustomVM:
+		return DescriptorManifest{}, fmt.Errorf("VM name %s is reserved", manifest.Name)
+	}
+	if manifest.ReleaseOrg == "" || manifest.ReleaseRepo == "" {
+		return DescriptorManifest{}, fmt.Errorf("missing release org or repo")
+	}
+	if manifest.Binary == "" {
+		return DescriptorManifest{}, fmt.Errorf("missing VM binary path")
+	}
+	if !strings.HasSuffix(manifest.ReleaseAsset, manifestReleaseAssetExt) {
+		return DescriptorManifest{}, fmt.Errorf("release asset %q is not a %s file", manifest.ReleaseAsset, manifestReleaseAssetExt)
+	}
+	if _, err := manifest.releaseAssetName("v0.0.0", "linux", "amd64"); err != nil {
+		return DescriptorManifest{}, err
+	}
+	if _, err := decodeJSONObject(manifest.Genesis); err != nil {
+		return DescriptorManifest{}, fmt.Errorf("invalid default genesis: %w", err)
+	}
+	keys := map[string]bool{}
+	for _, field := range manifest.GenesisFields {
+		if field.Key == "" {
+			return DescriptorManifest{}, fmt.Errorf("missing genesis field key")
+		}
+		if keys[field.Key] {
+			return DescriptorManifest{}, fmt.Errorf("duplicated genesis field %s", field.Key)
+		}
+		keys[field.Key] = true
+		switch field.Type {
+		case GenesisFieldString, GenesisFieldUint64, GenesisFieldBool, GenesisFieldAddress:
+		default:
+			return DescriptorManifest{}, fmt.Errorf("invalid type %q for genesis field %s", field.Type, field.Key)
+		}
+	}
+	return manifest, nil
+}
+
+func (m DescriptorManifest) Type() models.VMType {
+	return models.VMType(m.Name)
+}
+
+func (m DescriptorManifest) Repo() (string, string) {
+	return m.ReleaseOrg, m.ReleaseRepo
+}
+
+// PromptGenesis starts from the manifest default genesis, and prompts the user for
+// each of the manifest genesis fields
+func (m DescriptorManifest) PromptGenesis(
+	app *application.Avalanche,
+	_ *models.Sidecar,
+	_ string,
+) ([]byte, error) {
+	genesis, err := decodeJSONObject(m.Genesis)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range m.GenesisFields {
+		prompt := field.Prompt
+		if prompt == "" {
+			prompt = field.Key
+		}
+		var value interface{}
+		switch field.Type {
+		case GenesisFieldString:
+			value, err = app.Prompt.CaptureString(prompt)
+		case GenesisFieldUint64:
+			value, err = app.Prompt.CaptureUint64(prompt)
+		case GenesisFieldBool:
+			value, err = app.Prompt.CaptureYesNo(prompt)
+		case GenesisFieldAddress:
+			var address common.Address
+			address, err = app.Prompt.CaptureAddress(prompt)
+			value = address.Hex()
+		}
+		if err != nil {
+			return nil, err
+		}
+		genesis[field.Key] = value
+	}
+	return json.MarshalIndent(genesis, "", "  ")
+}
+
+// ValidateGenesis checks that [genesis] is a JSON object containing all the
+// manifest genesis fields, with their expected types
+func (m DescriptorManifest) ValidateGenesis(genesis []byte) error {
+	genesisMap, err := decodeJSONObject(genesis)
+	if err != nil {
+		return fmt.Errorf("invalid %s genesis: %w", m.Name, err)
+	}
+	for _, field := range m.GenesisFields {
+		value, ok := genesisMap[field.Key]
+		if !ok {
+			return fmt.Errorf("invalid %s genesis: missing field %s", m.Name, field.Key)
+		}
+		valid := false
+		switch field.Type {
+		case GenesisFieldString:
+			_, valid = value.(string)
+		case GenesisFieldBool:
+			_, valid = value.(bool)
+		case GenesisFieldAddress:
+			var s string
+			s, valid = value.(string)
+			valid = valid && common.IsHexAddress(s)
+		case GenesisFieldUint64:
+			var n json.Number
+			n, valid = value.(json.Number)
+			if valid {
+				_, err := strconv.ParseUint(n.String(), 10, 64)
+				valid = err == nil
+			}
+		}
+		if !valid {
+			return fmt.Errorf("invalid %s genesis: field %s is not of type %s", m.Name, field.Key, field.Type)
+		}
+	}
+	return nil
+}
+
+func (m DescriptorManifest) DefaultChainConfig(debug bool) []byte {
+	if debug && len(m.DebugChainConfig) > 0 {
+		return m.DebugChainConfig
+	}
+	if len(m.ChainConfig) > 0 {
+		return m.ChainConfig
+	}
+	return nil
+}
+
+func (m DescriptorManifest) RPCURLSuffix(blockchainID string) string {
+	rpcPath := strings.TrimPrefix(m.RPCPath, "/")
+	if rpcPath == "" {
+		rpcPath = defaultManifestRPCPath
+	}
+	return fmt.Sprintf("/ext/bc/%s/%s", blockchainID, rpcPath)
+}
+
+func (m DescriptorManifest) HealthProbe(ctx context.Context, rpcURL string) error {
+	if m.HealthMethod == "" {
+		return probeJSONRPC(ctx, rpcURL, defaultManifestHealthMethod, true)
+	}
+	return probeJSONRPC(ctx, rpcURL, m.HealthMethod, false)
+}
+
+func (m DescriptorManifest) DownloadRelease(app *application.Avalanche, version string) (string, string, error) {
+	binDir := app.GetVMBinDir(m.ReleaseRepo)
+	binPrefix := m.ReleaseRepo + "-"
+	installedVersion, vmDir, err := binutils.InstallBinary(
+		app,
+		version,
+		binDir,
+		filepath.Join(binDir, binPrefix+version),
+		binPrefix,
+		m.ReleaseOrg,
+		m.ReleaseRepo,
+		"",
+		manifestDownloader{manifest: m},
+		binutils.NewInstaller(),
+	)
+	if err != nil {
+		return "", "", err
+	}
+	binaryPath := filepath.Join(vmDir, m.Binary)
+	if !utils.IsExecutable(binaryPath) {
+		return "", "", fmt.Errorf("VM binary %s not found on %s release %s", m.Binary, m.Name, installedVersion)
+	}
+	return installedVersion, binaryPath, nil
+}
+
+// SetupBinary returns the blockchain custom VM binary, as releases of manifest described
+// VMs are copied there when installed for [blockchainName]
+func (DescriptorManifest) SetupBinary(app *application.Avalanche, blockchainName string, _ string) (string, error) {
+	return binutils.SetupCustomBin(app, blockchainName), nil
+}
+
+// renders the release asset name for [version], [goos] and [goarch]
+func (m DescriptorManifest) releaseAssetName(version string, goos string, goarch string) (string, error) {
+	tmpl, err := template.New("releaseAsset").Option("missingkey=error").Parse(m.ReleaseAsset)
+	if err != nil {
+		return "", fmt.Errorf("invalid release asset template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, struct {
+		Version       string
+		VersionNumber string
+		OS            string
+		Arch          string
+	}{
+		Version:       version,
+		VersionNumber: strings.TrimPrefix(version, "v"),
+		OS:            goos,
+		Arch:          goarch,
+	}); err != nil {
+		return "", fmt.Errorf("invalid release asset template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// decodes [objectBytes] as a JSON object, keeping numbers as json.Number
+func decodeJSONObject(objectBytes []byte) (map[string]interface{}, error) {
+	var object map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(objectBytes))
+	decoder.UseNumber()
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	if object == nil {
+		return nil, fmt.Errorf("expected a JSON object")
+	}
+	return object, nil
+}
+
+// manifestDownloader gets the URL of the manifest VM release assets
+type manifestDownloader struct {
+	manifest DescriptorManifest
+}
+
+var _ binutils.GithubDownloader = manifestDownloader{}
+
+func (d manifestDownloader) GetDownloadURL(version string, installer binutils.Installer) (string, string, error) {
+	goarch, goos := installer.GetArch()
+	assetName, err := d.manifest.releaseAssetName(version, goos, goarch)
+	if err != nil {
+		return "", "", err
+	}
+	return fmt.Sprintf(
+		"https://github.com/%s/%s/releases/download/%s/%s",
+		d.manifest.ReleaseOrg,
+		d.manifest.ReleaseRepo,
+		version,
+		assetName,
+	), strings.TrimPrefix(manifestReleaseAssetExt, "."), nil
+}
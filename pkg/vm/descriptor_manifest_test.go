@@ -0,0 +1,142 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package vm
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/internal/mocks"
+	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testDescriptorManifest = `{
+  "name": "Test VM",
+  "org": "test-org",
+  "repo": "testvm",
+  "releaseAsset": "testvm_{{.VersionNumber}}_{{.OS}}_{{.Arch}}.tar.gz",
+  "binary": "testvm",
+  "rpcPath": "/testvm",
+  "genesis": {"magic": 1, "owner": "", "note": "default"},
+  "genesisFields": [
+    {"key": "magic", "prompt": "Magic number", "type": "uint64"},
+    {"key": "owner", "prompt": "Owner address", "type": "address"}
+  ],
+  "chainConfig": {"log-level": "info"},
+  "debugChainConfig": {"log-level": "debug"}
+}`
+
+type testInstaller struct{}
+
+func (testInstaller) GetArch() (string, string) {
+	return "amd64", "linux"
+}
+
+func TestParseDescriptorManifest(t *testing.T) {
+	manifest, err := ParseDescriptorManifest([]byte(testDescriptorManifest))
+	require.NoError(t, err)
+	require.Equal(t, models.VMType("Test VM"), manifest.Type())
+	require.Equal(t, "/ext/bc/abc/testvm", manifest.RPCURLSuffix("abc"))
+	require.JSONEq(t, `{"log-level": "debug"}`, string(manifest.DefaultChainConfig(true)))
+	require.JSONEq(t, `{"log-level": "info"}`, string(manifest.DefaultChainConfig(false)))
+
+	url, ext, err := manifestDownloader{manifest: manifest}.GetDownloadURL("v1.2.3", testInstaller{})
+	require.NoError(t, err)
+	require.Equal(t, "https://github.com/test-org/testvm/releases/download/v1.2.3/testvm_1.2.3_linux_amd64.tar.gz", url)
+	require.Equal(t, "tar.gz", ext)
+
+	for _, tc := range []struct {
+		old         string
+		new         string
+		expectedErr string
+	}{
+		{old: `"name": "Test VM"`, new: `"name": ""`, expectedErr: "missing VM name"},
+		{old: `"name": "Test VM"`, new: `"name": "` + models.SubnetEvm + `"`, expectedErr: "is reserved"},
+		{old: `"binary": "testvm"`, new: `"binary": ""`, expectedErr: "missing VM binary path"},
+		{old: `_{{.Arch}}.tar.gz`, new: `_{{.Arch}}.zip`, expectedErr: "is not a .tar.gz file"},
+		{old: `{{.OS}}`, new: `{{.Platform}}`, expectedErr: "invalid release asset template"},
+		{old: `{"magic": 1, "owner": "", "note": "default"}`, new: `[]`, expectedErr: "invalid default genesis"},
+		{old: `"type": "address"`, new: `"type": "float"`, expectedErr: "invalid type"},
+		{old: `"key": "owner"`, new: `"key": "magic"`, expectedErr: "duplicated genesis field"},
+	} {
+		_, err := ParseDescriptorManifest([]byte(strings.Replace(testDescriptorManifest, tc.old, tc.new, 1)))
+		require.ErrorContains(t, err, tc.expectedErr)
+	}
+}
+
+func TestDescriptorManifestGenesis(t *testing.T) {
+	manifest, err := ParseDescriptorManifest([]byte(testDescriptorManifest))
+	require.NoError(t, err)
+
+	owner := common.HexToAddress("0x8db97c7cece249c2b98bdc0226cc4c2a57bf52fc")
+	app := application.New()
+	mockPrompt := &mocks.Prompter{}
+	app.Prompt = mockPrompt
+	mockPrompt.On("CaptureUint64", "Magic number").Return(uint64(42), nil)
+	mockPrompt.On("CaptureAddress", "Owner address").Return(owner, nil)
+	genesis, err := manifest.PromptGenesis(app, &models.Sidecar{}, "test")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"magic": 42, "owner": "`+owner.Hex()+`", "note": "default"}`, string(genesis))
+	require.NoError(t, manifest.ValidateGenesis(genesis))
+	mockPrompt.AssertCalled(t, "CaptureUint64", mock.Anything)
+
+	require.ErrorContains(t, manifest.ValidateGenesis([]byte(`{"magic": 42}`)), "missing field owner")
+	require.ErrorContains(t, manifest.ValidateGenesis([]byte(`{"magic": -1, "owner": "`+owner.Hex()+`"}`)), "field magic is not of type uint64")
+	require.ErrorContains(t, manifest.ValidateGenesis([]byte(`{"magic": 1, "owner": "0x1234"}`)), "field owner is not of type address")
+	require.Error(t, manifest.ValidateGenesis([]byte(`[]`)))
+}
+
+func TestGetDescriptor(t *testing.T) {
+	app := application.New()
+	app.Setup(t.TempDir(), logging.NoLog{}, nil, "", nil, nil, nil)
+
+	descriptor, err := GetDescriptor(app, models.SubnetEvm)
+	require.NoError(t, err)
+	require.Equal(t, "/ext/bc/abc/rpc", descriptor.RPCURLSuffix("abc"))
+	// Subnet-EVM genesis is created by the blockchain create wizard
+	_, ok := descriptor.(GenesisPrompter)
+	require.False(t, ok)
+
+	_, err = GetDescriptor(app, models.CustomVM)
+	require.ErrorIs(t, err, ErrDescriptorNotFound)
+
+	require.NoError(t, os.MkdirAll(app.GetVMDescriptorsDir(), constants.DefaultPerms755))
+	require.NoError(t, os.WriteFile(filepath.Join(app.GetVMDescriptorsDir(), "testvm.json"), []byte(testDescriptorManifest), constants.WriteReadReadPerms))
+	t.Cleanup(func() {
+		descriptorsLock.Lock()
+		defer descriptorsLock.Unlock()
+		delete(descriptors, "Test VM")
+	})
+	descriptor, err = GetDescriptor(app, "Test VM")
+	require.NoError(t, err)
+	org, repo := descriptor.Repo()
+	require.Equal(t, "test-org", org)
+	require.Equal(t, "testvm", repo)
+	_, ok = descriptor.(GenesisPrompter)
+	require.True(t, ok)
+
+	// manifest described VMs, as custom VMs, run the binary copied for the blockchain
+	for _, vmType := range []models.VMType{models.CustomVM, "Test VM"} {
+		binaryPath, err := SetupVMBinary(app, vmType, "testchain", "v1.2.3")
+		require.NoError(t, err)
+		require.Equal(t, app.GetCustomVMPath("testchain"), binaryPath)
+	}
+	_, err = SetupVMBinary(app, "Unknown VM", "testchain", "v1.2.3")
+	require.ErrorIs(t, err, ErrDescriptorNotFound)
+
+	require.ErrorContains(t, RegisterDescriptor(descriptor), "already registered")
+
+	var manifest DescriptorManifest
+	require.NoError(t, json.Unmarshal([]byte(testDescriptorManifest), &manifest))
+	manifest.Name = models.CustomVM
+	require.ErrorContains(t, RegisterDescriptor(manifest), "reserved")
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/binutils"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+)
+
+// subnetEVMDescriptor is the built in descriptor of Subnet-EVM blockchains
+type subnetEVMDescriptor struct{}
+
+var _ Descriptor = subnetEVMDescriptor{}
+
+func (subnetEVMDescriptor) Type() models.VMType {
+	return models.SubnetEvm
+}
+
+func (subnetEVMDescriptor) Repo() (string, string) {
+	return constants.AvaLabsOrg, constants.SubnetEVMRepoName
+}
+
+func (subnetEVMDescriptor) ValidateGenesis(genesis []byte) error {
+	if _, err := utils.ByteSliceToSubnetEvmGenesis(genesis); err != nil {
+		return fmt.Errorf("failed to validate SubnetEVM genesis format: %w", err)
+	}
+	return nil
+}
+
+func (subnetEVMDescriptor) DefaultChainConfig(debug bool) []byte {
+	if debug {
+		return EvmDebugConfig
+	}
+	return EvmNonDebugConfig
+}
+
+func (subnetEVMDescriptor) RPCURLSuffix(blockchainID string) string {
+	return fmt.Sprintf("/ext/bc/%s/rpc", blockchainID)
+}
+
+func (subnetEVMDescriptor) HealthProbe(ctx context.Context, rpcURL string) error {
+	return probeJSONRPC(ctx, rpcURL, "eth_blockNumber", false)
+}
+
+func (subnetEVMDescriptor) DownloadRelease(app *application.Avalanche, version string) (string, string, error) {
+	return binutils.SetupSubnetEVM(app, version)
+}
+
+func (subnetEVMDescriptor) SetupBinary(app *application.Avalanche, _ string, version string) (string, error) {
+	_, binaryPath, err := binutils.SetupSubnetEVM(app, version)
+	if err != nil {
+		return "", fmt.Errorf("failed to install subnet-evm: %w", err)
+	}
+	return binaryPath, nil
+}
@@ -4,10 +4,195 @@
 package vm
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
 	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/binutils"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
 	"github.com/ava-labs/avalanche-cli/pkg/ux"
 )
 
+// Descriptor defines how the CLI creates, deploys and upgrades blockchains running a given VM
+type Descriptor interface {
+	// VM type recorded on the blockchain sidecar
+	Type() models.VMType
+	// github org and repo the VM releases are published on
+	Repo() (string, string)
+	// verifies [genesis] can be used to start the VM
+	ValidateGenesis(genesis []byte) error
+	// chain config file to be used when the user does not provide one. may be nil
+	DefaultChainConfig(debug bool) []byte
+	// path to be appended to a node endpoint to reach the RPC of [blockchainID]
+	RPCURLSuffix(blockchainID string) string
+	// checks that the blockchain RPC at [rpcURL] is serving requests
+	HealthProbe(ctx context.Context, rpcURL string) error
+	// installs release [version] of the VM, returning the installed version and the binary path
+	DownloadRelease(app *application.Avalanche, version string) (string, string, error)
+	// returns the path of the binary of release [version] used by [blockchainName], installing it if needed
+	SetupBinary(app *application.Avalanche, blockchainName string, version string) (string, error)
+}
+
+// GenesisPrompter is implemented by descriptors that can interactively create the
+// genesis of a new blockchain
+type GenesisPrompter interface {
+	// prompts the user for the genesis of a new blockchain
+	PromptGenesis(app *application.Avalanche, sc *models.Sidecar, blockchainName string) ([]byte, error)
+}
+
+var ErrDescriptorNotFound = errors.New("VM descriptor not found")
+
+var (
+	descriptorsLock sync.Mutex
+	descriptors     = map[models.VMType]Descriptor{
+		models.SubnetEvm: subnetEVMDescriptor{},
+	}
+)
+
+// RegisterDescriptor makes [descriptor] available for blockchains of its VM type
+func RegisterDescriptor(descriptor Descriptor) error {
+	descriptorsLock.Lock()
+	defer descriptorsLock.Unlock()
+	vmType := descriptor.Type()
+	if vmType == models.CustomVM {
+		return fmt.Errorf("VM type %s is reserved for blockchains without descriptor", vmType)
+	}
+	if _, ok := descriptors[vmType]; ok {
+		return fmt.Errorf("a descriptor for VM type %s is already registered", vmType)
+	}
+	descriptors[vmType] = descriptor
+	return nil
+}
+
+// GetDescriptor returns the descriptor for [vmType], looking into the descriptor manifests
+// installed by the user if it is not yet registered
+func GetDescriptor(app *application.Avalanche, vmType models.VMType) (Descriptor, error) {
+	if err := loadDescriptorManifests(app); err != nil {
+		return nil, err
+	}
+	descriptorsLock.Lock()
+	defer descriptorsLock.Unlock()
+	descriptor, ok := descriptors[vmType]
+	if !ok {
+		return nil, fmt.Errorf("%w for VM type %s", ErrDescriptorNotFound, vmType)
+	}
+	return descriptor, nil
+}
+
+// GetDescriptors returns all registered descriptors, sorted by VM type
+func GetDescriptors(app *application.Avalanche) ([]Descriptor, error) {
+	if err := loadDescriptorManifests(app); err != nil {
+		return nil, err
+	}
+	descriptorsLock.Lock()
+	defer descriptorsLock.Unlock()
+	result := make([]Descriptor, 0, len(descriptors))
+	for _, descriptor := range descriptors {
+		result = append(result, descriptor)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Type() < result[j].Type()
+	})
+	return result, nil
+}
+
+// SetupVMBinary returns the path of the binary of release [version] of the VM [vmType] used
+// by [blockchainName], installing it if needed. Custom VMs use the binary already copied
+// to the blockchain custom VM path
+func SetupVMBinary(
+	app *application.Avalanche,
+	vmType models.VMType,
+	blockchainName string,
+	version string,
+) (string, error) {
+	if vmType == models.CustomVM {
+		return binutils.SetupCustomBin(app, blockchainName), nil
+	}
+	descriptor, err := GetDescriptor(app, vmType)
+	if err != nil {
+		return "", err
+	}
+	return descriptor.SetupBinary(app, blockchainName, version)
+}
+
+// SetupBlockchainVMBinary returns the path of the binary of the VM release used by [blockchainName],
+// installing it if needed
+func SetupBlockchainVMBinary(app *application.Avalanche, blockchainName string) (string, error) {
+	sc, err := app.LoadSidecar(blockchainName)
+	if err != nil {
+		return "", err
+	}
+	vmBinaryPath, err := SetupVMBinary(app, sc.VM, blockchainName, sc.VMVersion)
+	if err != nil {
+		return "", fmt.Errorf("failed to setup VM binary: %w", err)
+	}
+	return vmBinaryPath, nil
+}
+
+// registers the descriptor manifests found on the app descriptors dir that are not yet registered
+func loadDescriptorManifests(app *application.Avalanche) error {
+	manifests, err := LoadDescriptorManifests(app)
+	if err != nil {
+		return err
+	}
+	for _, manifest := range manifests {
+		descriptorsLock.Lock()
+		_, ok := descriptors[manifest.Type()]
+		descriptorsLock.Unlock()
+		if ok {
+			continue
+		}
+		if err := RegisterDescriptor(manifest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// calls JSON-RPC [method] on [rpcURL]. if [allowRPCError] is set, a JSON-RPC level error
+// is accepted as a proof that the endpoint is serving requests
+func probeJSONRPC(ctx context.Context, rpcURL string, method string, allowRPCError bool) error {
+	requestBody, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  method,
+		"params":  []interface{}{},
+	})
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, rpcURL, bytes.NewReader(requestBody))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("failure calling %s on %s: %w", method, rpcURL, err)
+	}
+	defer response.Body.Close()
+	var rpcResponse struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&rpcResponse); err != nil {
+		return fmt.Errorf("unexpected response from %s (status %d): %w", rpcURL, response.StatusCode, err)
+	}
+	if rpcResponse.Error != nil && !allowRPCError {
+		return fmt.Errorf("%s failed on %s: %s", method, rpcURL, rpcResponse.Error.Message)
+	}
+	return nil
+}
+
 func getTokenSymbol(app *application.Avalanche, subnetEVMTokenSymbol string) (string, error) {
 	if subnetEVMTokenSymbol != "" {
 		return subnetEVMTokenSymbol, nil
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"strings"
 
 	"github.com/ava-labs/avalanche-cli/pkg/application"
 	"github.com/ava-labs/avalanche-cli/pkg/constants"
@@ -112,7 +113,19 @@ func PromptVMType(
 	}
 	subnetEvmOption := "Subnet-EVM"
 	customVMOption := "Custom VM"
-	options := []string{subnetEvmOption, customVMOption, explainOption}
+	// VMs registered through descriptor manifests
+	registered, err := GetDescriptors(app)
+	if err != nil {
+		return "", err
+	}
+	manifestVMs := []string{}
+	for _, descriptor := range registered {
+		if descriptor.Type() != models.SubnetEvm {
+			manifestVMs = append(manifestVMs, string(descriptor.Type()))
+		}
+	}
+	options := append([]string{subnetEvmOption}, manifestVMs...)
+	options = append(options, customVMOption, explainOption)
 	var subnetTypeStr string
 	for {
 		option, err := app.Prompt.CaptureList(
@@ -133,7 +146,13 @@ func PromptVMType(
 			ux.Logger.PrintToUser("Subnet-EVM is an EVM-compatible virtual machine that supports smart contract development in Solidity. This VM is an out-of-the-box solution for Blockchain deployers who want a dApp development experience that is nearly identical to Ethereum, without having to manage or create a custom virtual machine. For more information, please visit: https://github.com/ava-labs/subnet-evm")
 			ux.Logger.PrintToUser("")
 			ux.Logger.PrintToUser("Custom VMs are virtual machines created using SDKs such as Precompile-EVM, HyperSDK, Rust-SDK. For more information please visit: https://docs.avax.network/protocol/virtual-machines.")
+			if len(manifestVMs) > 0 {
+				ux.Logger.PrintToUser("")
+				ux.Logger.PrintToUser("%s are third party VMs registered through descriptor manifests at %s.", strings.Join(manifestVMs, ", "), app.GetVMDescriptorsDir())
+			}
 			continue
+		default:
+			return models.VMType(option), nil
 		}
 		break
 	}
@@ -166,6 +185,7 @@ func PromptSubnetEVMGenesisParams(
 	defaultsKind DefaultsKind,
 	useWarp bool,
 	useExternalGasToken bool,
+	initialAllocations core.GenesisAlloc,
 ) (SubnetEVMGenesisParams, string, error) {
 	var (
 		err    error
@@ -208,7 +228,7 @@ func PromptSubnetEVMGenesisParams(
 
 	// Native Gas Details
 	if !params.UseExternalGasToken {
-		params, tokenSymbol, err = promptNativeGasToken(app, version, tokenSymbol, blockchainName, defaultsKind, params)
+		params, tokenSymbol, err = promptNativeGasToken(app, version, tokenSymbol, blockchainName, defaultsKind, initialAllocations, params)
 		if err != nil {
 			return SubnetEVMGenesisParams{}, "", err
 		}
@@ -533,6 +553,7 @@ func promptNativeGasToken(
 	tokenSymbol string,
 	blockchainName string,
 	defaultsKind DefaultsKind,
+	initialAllocations core.GenesisAlloc,
 	params SubnetEVMGenesisParams,
 ) (SubnetEVMGenesisParams, string, error) {
 	var err error
@@ -541,21 +562,32 @@ func promptNativeGasToken(
 		return SubnetEVMGenesisParams{}, "", err
 	}
 
-	if defaultsKind == TestDefaults {
+	switch {
+	case len(initialAllocations) > 0:
+		// allocations given by the user replace the default or prompted ones
+		ux.Logger.PrintToUser("using %d given initial token allocations", len(initialAllocations))
+		for address, account := range initialAllocations {
+			params.initialTokenAllocation[address] = account
+		}
+	case defaultsKind == TestDefaults:
 		ux.Logger.PrintToUser("prefunding address %s with balance %s", PrefundedEwoqAddress, defaultEVMAirdropAmount)
 		addEwoqAllocation(params.initialTokenAllocation)
-		return params, tokenSymbol, nil
+	case defaultsKind == ProductionDefaults:
+		if err := addNewKeyAllocation(params.initialTokenAllocation, app, blockchainName); err != nil {
+			return SubnetEVMGenesisParams{}, "", err
+		}
+	default:
+		// No defaults case. Prompt for initial token allocation
+		if err := getNativeGasTokenAllocationConfig(params.initialTokenAllocation, app, blockchainName, tokenSymbol); err != nil {
+			return SubnetEVMGenesisParams{}, "", err
+		}
 	}
 
-	if defaultsKind == ProductionDefaults {
-		err = addNewKeyAllocation(params.initialTokenAllocation, app, blockchainName)
-		return params, tokenSymbol, err
+	if defaultsKind != NoDefaults {
+		return params, tokenSymbol, nil
 	}
 
-	// No defaults case. Prompt for initial token allocation and native minter precompile options.
-	if err := getNativeGasTokenAllocationConfig(params.initialTokenAllocation, app, blockchainName, tokenSymbol); err != nil {
-		return SubnetEVMGenesisParams{}, "", err
-	}
+	// No defaults case. Prompt for native minter precompile options.
 
 	allowList, nativeMinterEnabled, err := getNativeMinterPrecompileConfig(
 		app,
@@ -0,0 +1,132 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package vm
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/subnet-evm/core"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	AllocationUnitTokens = "tokens"
+	AllocationUnitWei    = "wei"
+)
+
+// ParseGenesisAllocations parses [allocations], given as address to amount in whole token units
+func ParseGenesisAllocations(allocations map[string]string) (core.GenesisAlloc, error) {
+	alloc := core.GenesisAlloc{}
+	for addressStr, amountStr := range allocations {
+		address, err := parseAllocationAddress(addressStr)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := parseAllocationAmount(amountStr, AllocationUnitTokens)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s", err, addressStr)
+		}
+		alloc[address] = core.GenesisAccount{
+			Balance: amount,
+		}
+	}
+	return alloc, nil
+}
+
+// LoadGenesisAllocationsFile reads the CSV file at [path], with one allocation per row in
+// the format address,amount[,unit]. Unit is either "tokens" (whole token units, the default)
+// or "wei". A header row is accepted. Addresses with mixed case must have a valid checksum.
+// Duplicated addresses are merged by adding up their amounts
+func LoadGenesisAllocationsFile(path string) (core.GenesisAlloc, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseGenesisAllocationsCSV(f)
+}
+
+func parseGenesisAllocationsCSV(r io.Reader) (core.GenesisAlloc, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+	alloc := core.GenesisAlloc{}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "address") {
+			continue
+		}
+		if len(record) < 2 || len(record) > 3 {
+			return nil, fmt.Errorf("line %d: expected address,amount[,unit] but found %d fields", line, len(record))
+		}
+		address, err := parseAllocationAddress(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		unit := AllocationUnitTokens
+		if len(record) == 3 {
+			unit = strings.ToLower(strings.TrimSpace(record[2]))
+		}
+		amount, err := parseAllocationAmount(record[1], unit)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if account, ok := alloc[address]; ok {
+			ux.Logger.PrintToUser("line %d: merging duplicated allocation for %s", line, address.Hex())
+			amount = new(big.Int).Add(account.Balance, amount)
+		}
+		alloc[address] = core.GenesisAccount{
+			Balance: amount,
+		}
+	}
+	if len(alloc) == 0 {
+		return nil, fmt.Errorf("no allocations found")
+	}
+	return alloc, nil
+}
+
+// parses [addressStr], verifying its EIP-55 checksum if it has mixed case
+func parseAllocationAddress(addressStr string) (common.Address, error) {
+	addressStr = strings.TrimSpace(addressStr)
+	if !common.IsHexAddress(addressStr) {
+		return common.Address{}, fmt.Errorf("invalid allocation address %q", addressStr)
+	}
+	address := common.HexToAddress(addressStr)
+	hexPart := strings.TrimPrefix(strings.TrimPrefix(addressStr, "0x"), "0X")
+	if hexPart != strings.ToLower(hexPart) && hexPart != strings.ToUpper(hexPart) && address.Hex() != "0x"+hexPart {
+		return common.Address{}, fmt.Errorf("invalid checksum for allocation address %q, expected %s", addressStr, address.Hex())
+	}
+	if address == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("invalid allocation to the zero address")
+	}
+	return address, nil
+}
+
+// parses a positive integer [amountStr] in [unit], returning it in wei
+func parseAllocationAmount(amountStr string, unit string) (*big.Int, error) {
+	amount, ok := new(big.Int).SetString(strings.TrimSpace(amountStr), 10)
+	if !ok || amount.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid allocation amount %q", amountStr)
+	}
+	switch unit {
+	case AllocationUnitTokens:
+		return amount.Mul(amount, OneAvax), nil
+	case AllocationUnitWei:
+		return amount, nil
+	}
+	return nil, fmt.Errorf("invalid allocation unit %q, expected %s or %s", unit, AllocationUnitTokens, AllocationUnitWei)
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package vm
+
+import (
+	"io"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/internal/testutils"
+	"github.com/ava-labs/avalanche-cli/pkg/ux"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+const checksummedAddress = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+func TestParseGenesisAllocationsCSV(t *testing.T) {
+	ux.NewUserLog(logging.NoLog{}, io.Discard)
+	address := common.HexToAddress(checksummedAddress)
+	otherAddress := common.HexToAddress("0x8db97c7cece249c2b98bdc0226cc4c2a57bf52fc")
+
+	alloc, err := parseGenesisAllocationsCSV(strings.NewReader(`address,amount,unit
+# airdrop
+` + checksummedAddress + `,10
+0x8db97c7cece249c2b98bdc0226cc4c2a57bf52fc, 5, wei
+` + strings.ToLower(checksummedAddress) + `,1,tokens
+`))
+	require.NoError(t, err)
+	require.Len(t, alloc, 2)
+	require.Equal(t, new(big.Int).Mul(big.NewInt(11), OneAvax), alloc[address].Balance)
+	require.Equal(t, big.NewInt(5), alloc[otherAddress].Balance)
+
+	_, err = parseGenesisAllocationsCSV(strings.NewReader("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD,10\n"))
+	require.ErrorContains(t, err, "line 1: invalid checksum")
+
+	_, err = parseGenesisAllocationsCSV(strings.NewReader(checksummedAddress + ",10\n0x1234,10\n"))
+	require.ErrorContains(t, err, "line 2: invalid allocation address")
+
+	_, err = parseGenesisAllocationsCSV(strings.NewReader(checksummedAddress + ",1.5\n"))
+	require.ErrorContains(t, err, "invalid allocation amount")
+
+	_, err = parseGenesisAllocationsCSV(strings.NewReader(checksummedAddress + ",10,gwei\n"))
+	require.ErrorContains(t, err, "invalid allocation unit")
+
+	_, err = parseGenesisAllocationsCSV(strings.NewReader("address,amount\n"))
+	require.ErrorContains(t, err, "no allocations found")
+}
+
+func TestParseGenesisAllocations(t *testing.T) {
+	addrs, err := testutils.GenerateEthAddrs(1)
+	require.NoError(t, err)
+	alloc, err := ParseGenesisAllocations(map[string]string{addrs[0].Hex(): "10"})
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Mul(big.NewInt(10), OneAvax), alloc[addrs[0]].Balance)
+
+	_, err = ParseGenesisAllocations(map[string]string{"0x1234": "10"})
+	require.ErrorContains(t, err, "invalid allocation address")
+
+	_, err = ParseGenesisAllocations(map[string]string{addrs[0].Hex(): "-1"})
+	require.ErrorContains(t, err, "invalid allocation amount")
+}
@@ -0,0 +1,406 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package vm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ava-labs/avalanche-cli/pkg/contract"
+	icmgenesis "github.com/ava-labs/avalanche-cli/pkg/interchain/genesis"
+	"github.com/ava-labs/avalanche-cli/pkg/models"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	validatorManagerSDK "github.com/ava-labs/avalanche-cli/sdk/validatormanager"
+	"github.com/ava-labs/subnet-evm/core"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	DiffSectionGenesis     = "genesis"
+	DiffSectionChainConfig = "chain config"
+	DiffSectionFeeConfig   = "fee config"
+	DiffSectionPrecompiles = "precompiles"
+	DiffSectionAlloc       = "allocations"
+	DiffSectionContracts   = "contracts"
+	DiffSectionSidecar     = "sidecar"
+	DiffSectionChainFile   = "chain config file"
+
+	diffAbsent = "-"
+)
+
+// sidecar fields that identify a configuration instead of describing it
+var sidecarDiffExcludedFields = []string{"Name", "Subnet", "Networks"}
+
+// ConfigDifference is a setting with different values on two blockchain configurations.
+// For list settings, A and B hold the elements only found on each side
+type ConfigDifference struct {
+	Section string
+	Field   string
+	A       string
+	B       string
+}
+
+// known contracts that the CLI adds to genesis
+var genesisContracts = []struct {
+	Name    string
+	Address string
+}{
+	{"ICM Messenger", icmgenesis.MessengerContractAddress},
+	{"ICM Registry", icmgenesis.RegistryContractAddress},
+	{"Validator Messages Lib", validatorManagerSDK.ValidatorMessagesContractAddress},
+	{"Validator Manager", validatorManagerSDK.ValidatorContractAddress},
+	{"Transparent Proxy", validatorManagerSDK.ProxyContractAddress},
+	{"Proxy Admin", validatorManagerSDK.ProxyAdminContractAddress},
+	{"Reward Calculator", validatorManagerSDK.RewardCalculatorAddress},
+}
+
+// DiffSubnetEVMGenesis semantically compares two Subnet-EVM genesis: chain config, fee config,
+// precompile configs and allow lists, allocations and well known genesis contracts
+func DiffSubnetEVMGenesis(genesisBytesA []byte, genesisBytesB []byte) ([]ConfigDifference, error) {
+	genesisA, err := utils.ByteSliceToSubnetEvmGenesis(genesisBytesA)
+	if err != nil {
+		return nil, fmt.Errorf("first genesis is not a Subnet-EVM genesis: %w", err)
+	}
+	genesisB, err := utils.ByteSliceToSubnetEvmGenesis(genesisBytesB)
+	if err != nil {
+		return nil, fmt.Errorf("second genesis is not a Subnet-EVM genesis: %w", err)
+	}
+	if genesisA.Config == nil || genesisB.Config == nil {
+		return nil, fmt.Errorf("genesis chain config not found")
+	}
+	diffs := []ConfigDifference{}
+
+	// genesis block fields
+	genesisDiffs, err := diffGenesisBlock(genesisA, genesisB)
+	if err != nil {
+		return nil, err
+	}
+	diffs = append(diffs, genesisDiffs...)
+
+	// chain config, excluding fee config and precompiles which are compared on their own
+	excludedChainConfigKeys := []string{"feeConfig"}
+	for precompileKey := range genesisA.Config.GenesisPrecompiles {
+		excludedChainConfigKeys = append(excludedChainConfigKeys, precompileKey)
+	}
+	for precompileKey := range genesisB.Config.GenesisPrecompiles {
+		excludedChainConfigKeys = append(excludedChainConfigKeys, precompileKey)
+	}
+	chainConfigDiffs, err := DiffJSONObjects(DiffSectionChainConfig, genesisA.Config, genesisB.Config, excludedChainConfigKeys...)
+	if err != nil {
+		return nil, err
+	}
+	diffs = append(diffs, chainConfigDiffs...)
+
+	feeConfigDiffs, err := DiffJSONObjects(DiffSectionFeeConfig, genesisA.Config.FeeConfig, genesisB.Config.FeeConfig)
+	if err != nil {
+		return nil, err
+	}
+	diffs = append(diffs, feeConfigDiffs...)
+
+	// precompiles
+	precompileKeys := map[string]struct{}{}
+	for precompileKey := range genesisA.Config.GenesisPrecompiles {
+		precompileKeys[precompileKey] = struct{}{}
+	}
+	for precompileKey := range genesisB.Config.GenesisPrecompiles {
+		precompileKeys[precompileKey] = struct{}{}
+	}
+	for _, precompileKey := range sortedStrings(precompileKeys) {
+		configA, okA := genesisA.Config.GenesisPrecompiles[precompileKey]
+		configB, okB := genesisB.Config.GenesisPrecompiles[precompileKey]
+		if !okA || !okB {
+			diffs = append(diffs, ConfigDifference{
+				Section: DiffSectionPrecompiles,
+				Field:   precompileKey,
+				A:       enabledDesc(okA),
+				B:       enabledDesc(okB),
+			})
+			continue
+		}
+		precompileDiffs, err := DiffJSONObjects(DiffSectionPrecompiles, configA, configB)
+		if err != nil {
+			return nil, err
+		}
+		for i := range precompileDiffs {
+			precompileDiffs[i].Field = precompileKey + "." + precompileDiffs[i].Field
+		}
+		diffs = append(diffs, precompileDiffs...)
+	}
+
+	diffs = append(diffs, diffAllocations(genesisA.Alloc, genesisB.Alloc)...)
+
+	// well known contracts
+	for _, genesisContract := range genesisContracts {
+		address := common.HexToAddress(genesisContract.Address)
+		foundA, err := contract.ContractAddressIsInGenesisData(genesisBytesA, address)
+		if err != nil {
+			return nil, err
+		}
+		foundB, err := contract.ContractAddressIsInGenesisData(genesisBytesB, address)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case foundA != foundB:
+			diffs = append(diffs, ConfigDifference{
+				Section: DiffSectionContracts,
+				Field:   genesisContract.Name,
+				A:       presentDesc(foundA),
+				B:       presentDesc(foundB),
+			})
+		case foundA:
+			codeHashA := crypto.Keccak256Hash(genesisA.Alloc[address].Code).Hex()
+			codeHashB := crypto.Keccak256Hash(genesisB.Alloc[address].Code).Hex()
+			if codeHashA != codeHashB {
+				diffs = append(diffs, ConfigDifference{
+					Section: DiffSectionContracts,
+					Field:   genesisContract.Name + " code hash",
+					A:       codeHashA,
+					B:       codeHashB,
+				})
+			}
+		}
+	}
+	return diffs, nil
+}
+
+// DiffSidecars compares the settings of two sidecars, ignoring names and deployment information
+func DiffSidecars(sidecarA models.Sidecar, sidecarB models.Sidecar) ([]ConfigDifference, error) {
+	return DiffJSONObjects(DiffSectionSidecar, sidecarA, sidecarB, sidecarDiffExcludedFields...)
+}
+
+// DiffJSONObjects compares the JSON encodings of [a] and [b] field by field, ignoring top level
+// [excludedKeys]. Nested fields are named by their dot separated path, and lists are compared as sets
+func DiffJSONObjects(section string, a interface{}, b interface{}, excludedKeys ...string) ([]ConfigDifference, error) {
+	fieldsA, err := flattenJSONObject(a, excludedKeys)
+	if err != nil {
+		return nil, err
+	}
+	fieldsB, err := flattenJSONObject(b, excludedKeys)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]struct{}{}
+	for field := range fieldsA {
+		fields[field] = struct{}{}
+	}
+	for field := range fieldsB {
+		fields[field] = struct{}{}
+	}
+	diffs := []ConfigDifference{}
+	for _, field := range sortedStrings(fields) {
+		valueA, okA := fieldsA[field]
+		valueB, okB := fieldsB[field]
+		listA, isListA := valueA.([]interface{})
+		listB, isListB := valueB.([]interface{})
+		if isListA || isListB {
+			onlyA, onlyB := diffLists(listA, listB)
+			if len(onlyA) > 0 || len(onlyB) > 0 {
+				diffs = append(diffs, ConfigDifference{
+					Section: section,
+					Field:   field,
+					A:       strings.Join(onlyA, "\n"),
+					B:       strings.Join(onlyB, "\n"),
+				})
+			}
+			continue
+		}
+		descA, descB := jsonValueDesc(valueA, okA), jsonValueDesc(valueB, okB)
+		if descA != descB {
+			diffs = append(diffs, ConfigDifference{
+				Section: section,
+				Field:   field,
+				A:       descA,
+				B:       descB,
+			})
+		}
+	}
+	return diffs, nil
+}
+
+// compares the genesis block fields, leaving out chain config and allocations
+func diffGenesisBlock(genesisA core.Genesis, genesisB core.Genesis) ([]ConfigDifference, error) {
+	genesisA.Config, genesisB.Config = nil, nil
+	genesisA.Alloc, genesisB.Alloc = nil, nil
+	return DiffJSONObjects(DiffSectionGenesis, genesisA, genesisB, "config", "alloc")
+}
+
+func diffAllocations(allocA core.GenesisAlloc, allocB core.GenesisAlloc) []ConfigDifference {
+	addresses := map[string]struct{}{}
+	for address := range allocA {
+		addresses[address.Hex()] = struct{}{}
+	}
+	for address := range allocB {
+		addresses[address.Hex()] = struct{}{}
+	}
+	diffs := []ConfigDifference{}
+	addDiff := func(field string, a string, b string) {
+		if a != b {
+			diffs = append(diffs, ConfigDifference{
+				Section: DiffSectionAlloc,
+				Field:   field,
+				A:       a,
+				B:       b,
+			})
+		}
+	}
+	for _, addressStr := range sortedStrings(addresses) {
+		address := common.HexToAddress(addressStr)
+		accountA, okA := allocA[address]
+		accountB, okB := allocB[address]
+		if !okA || !okB {
+			addDiff(addressStr, accountDesc(accountA, okA), accountDesc(accountB, okB))
+			continue
+		}
+		addDiff(addressStr+".balance", bigIntDesc(accountA.Balance), bigIntDesc(accountB.Balance))
+		addDiff(addressStr+".nonce", fmt.Sprintf("%d", accountA.Nonce), fmt.Sprintf("%d", accountB.Nonce))
+		addDiff(addressStr+".code hash", codeHashDesc(accountA.Code), codeHashDesc(accountB.Code))
+		slots := map[string]struct{}{}
+		for slot := range accountA.Storage {
+			slots[slot.Hex()] = struct{}{}
+		}
+		for slot := range accountB.Storage {
+			slots[slot.Hex()] = struct{}{}
+		}
+		for _, slotStr := range sortedStrings(slots) {
+			slot := common.HexToHash(slotStr)
+			valueA, okA := accountA.Storage[slot]
+			valueB, okB := accountB.Storage[slot]
+			addDiff(addressStr+".storage."+slotStr, hashDesc(valueA, okA), hashDesc(valueB, okB))
+		}
+	}
+	return diffs
+}
+
+// flattens the JSON encoding of [obj] into a map from dot separated paths to leaf values
+func flattenJSONObject(obj interface{}, excludedKeys []string) (map[string]interface{}, error) {
+	objBytes, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var objMap map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(objBytes))
+	decoder.UseNumber()
+	if err := decoder.Decode(&objMap); err != nil {
+		return nil, err
+	}
+	for _, key := range excludedKeys {
+		delete(objMap, key)
+	}
+	fields := map[string]interface{}{}
+	flattenJSONValue("", objMap, fields)
+	return fields, nil
+}
+
+func flattenJSONValue(path string, value interface{}, fields map[string]interface{}) {
+	valueMap, ok := value.(map[string]interface{})
+	if !ok {
+		fields[path] = value
+		return
+	}
+	for key, subValue := range valueMap {
+		subPath := key
+		if path != "" {
+			subPath = path + "." + key
+		}
+		flattenJSONValue(subPath, subValue, fields)
+	}
+}
+
+// returns the elements only found in [a], and the ones only found in [b]
+func diffLists(a []interface{}, b []interface{}) ([]string, []string) {
+	setA := map[string]struct{}{}
+	for _, v := range a {
+		setA[jsonValueDesc(v, true)] = struct{}{}
+	}
+	setB := map[string]struct{}{}
+	for _, v := range b {
+		setB[jsonValueDesc(v, true)] = struct{}{}
+	}
+	onlyA, onlyB := []string{}, []string{}
+	for _, v := range sortedStrings(setA) {
+		if _, ok := setB[v]; !ok {
+			onlyA = append(onlyA, v)
+		}
+	}
+	for _, v := range sortedStrings(setB) {
+		if _, ok := setA[v]; !ok {
+			onlyB = append(onlyB, v)
+		}
+	}
+	return onlyA, onlyB
+}
+
+func jsonValueDesc(value interface{}, found bool) string {
+	if !found || value == nil {
+		return diffAbsent
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(valueBytes)
+}
+
+func accountDesc(account core.GenesisAccount, found bool) string {
+	if !found {
+		return diffAbsent
+	}
+	desc := "balance " + bigIntDesc(account.Balance)
+	if len(account.Code) > 0 {
+		desc += ", contract"
+	}
+	return desc
+}
+
+func bigIntDesc(n *big.Int) string {
+	if n == nil {
+		return "0"
+	}
+	return n.String()
+}
+
+func codeHashDesc(code []byte) string {
+	if len(code) == 0 {
+		return diffAbsent
+	}
+	return crypto.Keccak256Hash(code).Hex()
+}
+
+func hashDesc(hash common.Hash, found bool) string {
+	if !found {
+		return diffAbsent
+	}
+	return hash.Hex()
+}
+
+func enabledDesc(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+func presentDesc(present bool) string {
+	if present {
+		return "present"
+	}
+	return "absent"
+}
+
+func sortedStrings(set map[string]struct{}) []string {
+	strs := make([]string, 0, len(set))
+	for s := range set {
+		strs = append(strs, s)
+	}
+	sort.Strings(strs)
+	return strs
+}
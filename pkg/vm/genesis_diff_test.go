@@ -0,0 +1,119 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package vm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const diffTestGenesis = `{
+  "config": {
+    "chainId": 99999,
+    "homesteadBlock": 0,
+    "eip150Block": 0,
+    "eip155Block": 0,
+    "eip158Block": 0,
+    "byzantiumBlock": 0,
+    "constantinopleBlock": 0,
+    "petersburgBlock": 0,
+    "istanbulBlock": 0,
+    "muirGlacierBlock": 0,
+    "feeConfig": {
+      "gasLimit": 8000000,
+      "targetBlockRate": 2,
+      "minBaseFee": 25000000000,
+      "targetGas": 15000000,
+      "baseFeeChangeDenominator": 36,
+      "minBlockGasCost": 0,
+      "maxBlockGasCost": 1000000,
+      "blockGasCostStep": 200000
+    },
+    "txAllowListConfig": {
+      "blockTimestamp": 0,
+      "adminAddresses": ["0x8db97c7cece249c2b98bdc0226cc4c2a57bf52fc"]
+    }
+  },
+  "nonce": "0x0",
+  "timestamp": "0x0",
+  "extraData": "0x",
+  "gasLimit": "0x7a1200",
+  "difficulty": "0x0",
+  "mixHash": "0x0000000000000000000000000000000000000000000000000000000000000000",
+  "coinbase": "0x0000000000000000000000000000000000000000",
+  "alloc": {
+    "8db97c7cece249c2b98bdc0226cc4c2a57bf52fc": {
+      "balance": "0xd3c21bcecceda1000000"
+    }
+  },
+  "number": "0x0",
+  "gasUsed": "0x0",
+  "parentHash": "0x0000000000000000000000000000000000000000000000000000000000000000"
+}`
+
+func TestDiffSubnetEVMGenesis(t *testing.T) {
+	diffs, err := DiffSubnetEVMGenesis([]byte(diffTestGenesis), []byte(diffTestGenesis))
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+
+	other := strings.NewReplacer(
+		`"chainId": 99999`, `"chainId": 88888`,
+		`"minBaseFee": 25000000000`, `"minBaseFee": 1000000000`,
+		`"adminAddresses": ["0x8db97c7cece249c2b98bdc0226cc4c2a57bf52fc"]`, `"adminAddresses": ["0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"]`,
+		`"balance": "0xd3c21bcecceda1000000"`, `"balance": "0x1"`,
+	).Replace(diffTestGenesis)
+	diffs, err = DiffSubnetEVMGenesis([]byte(diffTestGenesis), []byte(other))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []ConfigDifference{
+		{Section: DiffSectionChainConfig, Field: "chainId", A: "99999", B: "88888"},
+		{Section: DiffSectionFeeConfig, Field: "minBaseFee", A: "25000000000", B: "1000000000"},
+		{
+			Section: DiffSectionPrecompiles,
+			Field:   "txAllowListConfig.adminAddresses",
+			A:       "0x8db97c7cece249c2b98bdc0226cc4c2a57bf52fc",
+			B:       "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		},
+		{
+			Section: DiffSectionAlloc,
+			Field:   "0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC.balance",
+			A:       "1000000000000000000000000",
+			B:       "1",
+		},
+	}, diffs)
+
+	disabled := strings.Replace(diffTestGenesis, `"txAllowListConfig"`, `"unusedConfig"`, 1)
+	diffs, err = DiffSubnetEVMGenesis([]byte(diffTestGenesis), []byte(disabled))
+	require.NoError(t, err)
+	require.Contains(t, diffs, ConfigDifference{Section: DiffSectionPrecompiles, Field: "txAllowListConfig", A: "enabled", B: "disabled"})
+
+	_, err = DiffSubnetEVMGenesis([]byte(diffTestGenesis), []byte("{}"))
+	require.Error(t, err)
+}
+
+func TestDiffJSONObjects(t *testing.T) {
+	a := map[string]interface{}{
+		"name":   "a",
+		"nested": map[string]interface{}{"value": 1, "same": true},
+		"list":   []string{"x", "y"},
+		"only":   "a",
+	}
+	b := map[string]interface{}{
+		"name":   "b",
+		"nested": map[string]interface{}{"value": 2, "same": true},
+		"list":   []string{"y", "z"},
+	}
+	diffs, err := DiffJSONObjects("section", a, b, "name")
+	require.NoError(t, err)
+	require.Equal(t, []ConfigDifference{
+		{Section: "section", Field: "list", A: "x", B: "z"},
+		{Section: "section", Field: "nested.value", A: "1", B: "2"},
+		{Section: "section", Field: "only", A: "a", B: diffAbsent},
+	}, diffs)
+
+	// list order is not relevant
+	diffs, err = DiffJSONObjects("section", map[string][]int{"l": {1, 2}}, map[string][]int{"l": {2, 1}})
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+}
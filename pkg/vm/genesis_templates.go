@@ -0,0 +1,366 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package vm
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/ava-labs/avalanche-cli/pkg/application"
+	"github.com/ava-labs/avalanche-cli/pkg/constants"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	sdkUtils "github.com/ava-labs/avalanche-cli/sdk/utils"
+	"github.com/ava-labs/subnet-evm/core"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	bundledGenesisTemplatesDir = "genesis_templates"
+	genesisTemplateExtension   = ".json"
+)
+
+//go:embed genesis_templates/*.json
+var bundledGenesisTemplates embed.FS
+
+var (
+	genesisTemplateNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+
+	ErrGenesisTemplateNotFound = errors.New("genesis template not found")
+)
+
+// GenesisTemplate is a reusable Subnet-EVM genesis, either bundled with the CLI or saved
+// by the user from an existing blockchain. Chain ID, token symbol and allocations
+// are set when the template is applied
+type GenesisTemplate struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	TokenSymbol string          `json:"tokenSymbol"`
+	VMVersion   string          `json:"vmVersion,omitempty"`
+	Genesis     json.RawMessage `json:"genesis"`
+	// bundled templates are shipped with the CLI and can't be modified
+	Bundled bool `json:"-"`
+}
+
+// GenesisTemplateParams are the values a template is instantiated with
+type GenesisTemplateParams struct {
+	ChainID uint64
+	// if not empty, replaces the template allocations
+	Allocations core.GenesisAlloc
+	// genesis block timestamp, also used to activate the genesis precompiles
+	Timestamp uint64
+}
+
+func ValidateGenesisTemplateName(name string) error {
+	if !genesisTemplateNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid template name %q: only letters, numbers, '-' and '_' are allowed", name)
+	}
+	return nil
+}
+
+func getUserGenesisTemplatePath(app *application.Avalanche, name string) string {
+	return filepath.Join(app.GetGenesisTemplatesDir(), name+genesisTemplateExtension)
+}
+
+func GetBundledGenesisTemplates() ([]GenesisTemplate, error) {
+	entries, err := bundledGenesisTemplates.ReadDir(bundledGenesisTemplatesDir)
+	if err != nil {
+		return nil, err
+	}
+	templates := []GenesisTemplate{}
+	for _, entry := range entries {
+		templateBytes, err := bundledGenesisTemplates.ReadFile(bundledGenesisTemplatesDir + "/" + entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		template, err := parseGenesisTemplate(templateBytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bundled genesis template %s: %w", entry.Name(), err)
+		}
+		template.Bundled = true
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+func getUserGenesisTemplates(app *application.Avalanche) ([]GenesisTemplate, error) {
+	templatesDir := app.GetGenesisTemplatesDir()
+	if !sdkUtils.DirExists(templatesDir) {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(templatesDir)
+	if err != nil {
+		return nil, err
+	}
+	templates := []GenesisTemplate{}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != genesisTemplateExtension {
+			continue
+		}
+		templateBytes, err := os.ReadFile(filepath.Join(templatesDir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		template, err := parseGenesisTemplate(templateBytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid genesis template %s: %w", entry.Name(), err)
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+// GetGenesisTemplates returns all bundled and user defined templates, sorted by name
+func GetGenesisTemplates(app *application.Avalanche) ([]GenesisTemplate, error) {
+	templates, err := GetBundledGenesisTemplates()
+	if err != nil {
+		return nil, err
+	}
+	userTemplates, err := getUserGenesisTemplates(app)
+	if err != nil {
+		return nil, err
+	}
+	templates = append(templates, userTemplates...)
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+	return templates, nil
+}
+
+func GetGenesisTemplate(app *application.Avalanche, name string) (GenesisTemplate, error) {
+	templates, err := GetGenesisTemplates(app)
+	if err != nil {
+		return GenesisTemplate{}, err
+	}
+	for _, template := range templates {
+		if template.Name == name {
+			return template, nil
+		}
+	}
+	return GenesisTemplate{}, fmt.Errorf("%w: %s", ErrGenesisTemplateNotFound, name)
+}
+
+// SaveGenesisTemplate stores [template] as a user defined template, overwriting
+// a previous user template with the same name if [force] is set
+func SaveGenesisTemplate(app *application.Avalanche, template GenesisTemplate, force bool) error {
+	if err := ValidateGenesisTemplateName(template.Name); err != nil {
+		return err
+	}
+	existing, err := GetGenesisTemplate(app, template.Name)
+	switch {
+	case err == nil && existing.Bundled:
+		return fmt.Errorf("template %s is bundled with the CLI and can't be overwritten", template.Name)
+	case err == nil && !force:
+		return fmt.Errorf("template %s already exists", template.Name)
+	case err != nil && !errors.Is(err, ErrGenesisTemplateNotFound):
+		return err
+	}
+	if err := os.MkdirAll(app.GetGenesisTemplatesDir(), constants.DefaultPerms755); err != nil {
+		return err
+	}
+	templateBytes, err := json.MarshalIndent(template, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(getUserGenesisTemplatePath(app, template.Name), templateBytes, constants.WriteReadReadPerms)
+}
+
+func DeleteGenesisTemplate(app *application.Avalanche, name string) error {
+	template, err := GetGenesisTemplate(app, name)
+	if err != nil {
+		return err
+	}
+	if template.Bundled {
+		return fmt.Errorf("template %s is bundled with the CLI and can't be deleted", name)
+	}
+	return os.Remove(getUserGenesisTemplatePath(app, name))
+}
+
+// NewGenesisTemplateFromBlockchain creates a template out of the genesis and sidecar of
+// [blockchainName]. The chain ID is cleared, as it must be set for each new blockchain
+func NewGenesisTemplateFromBlockchain(
+	app *application.Avalanche,
+	blockchainName string,
+	templateName string,
+	description string,
+) (GenesisTemplate, error) {
+	sc, err := app.LoadSidecar(blockchainName)
+	if err != nil {
+		return GenesisTemplate{}, err
+	}
+	genesisBytes, err := app.LoadRawGenesis(blockchainName)
+	if err != nil {
+		return GenesisTemplate{}, err
+	}
+	if !utils.ByteSliceIsSubnetEvmGenesis(genesisBytes) {
+		return GenesisTemplate{}, fmt.Errorf("blockchain %s does not have a Subnet-EVM genesis", blockchainName)
+	}
+	genesisMap, config, err := decodeGenesisMap(genesisBytes)
+	if err != nil {
+		return GenesisTemplate{}, err
+	}
+	config["chainId"] = 0
+	genesisBytes, err = json.Marshal(genesisMap)
+	if err != nil {
+		return GenesisTemplate{}, err
+	}
+	if description == "" {
+		description = fmt.Sprintf("saved from blockchain %s", blockchainName)
+	}
+	return GenesisTemplate{
+		Name:        templateName,
+		Description: description,
+		TokenSymbol: sc.TokenSymbol,
+		VMVersion:   sc.VMVersion,
+		Genesis:     genesisBytes,
+	}, nil
+}
+
+// Allocations returns the allocations defined on the template genesis
+func (t GenesisTemplate) Allocations() (core.GenesisAlloc, error) {
+	genesis, err := utils.ByteSliceToSubnetEvmGenesis(t.Genesis)
+	if err != nil {
+		return nil, err
+	}
+	return genesis.Alloc, nil
+}
+
+// Apply instantiates the template genesis with [params]. Allow list precompiles that have
+// no address on the template get the allocated addresses as admins, so that the
+// funded accounts are the ones that can operate the blockchain
+func (t GenesisTemplate) Apply(params GenesisTemplateParams) ([]byte, error) {
+	if params.ChainID == 0 {
+		return nil, fmt.Errorf("a chain ID is needed to apply genesis template %s", t.Name)
+	}
+	genesisMap, config, err := decodeGenesisMap(t.Genesis)
+	if err != nil {
+		return nil, err
+	}
+	allocations := params.Allocations
+	if len(allocations) == 0 {
+		allocations, err = t.Allocations()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(allocations) == 0 {
+		return nil, fmt.Errorf("genesis template %s has no allocations and none were given", t.Name)
+	}
+	admins := make([]string, 0, len(allocations))
+	for address := range allocations {
+		admins = append(admins, address.Hex())
+	}
+	sort.Strings(admins)
+	config["chainId"] = params.ChainID
+	genesisMap["alloc"] = allocations
+	genesisMap["timestamp"] = hexutil.Uint64(params.Timestamp)
+	for _, value := range config {
+		precompileConfig, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := precompileConfig["blockTimestamp"]; !ok {
+			continue
+		}
+		precompileConfig["blockTimestamp"] = params.Timestamp
+		if _, ok := precompileConfig["adminAddresses"]; ok && allowListIsEmpty(precompileConfig) {
+			precompileConfig["adminAddresses"] = admins
+		}
+	}
+	genesisBytes, err := json.MarshalIndent(genesisMap, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := utils.ByteSliceToSubnetEvmGenesis(genesisBytes); err != nil {
+		return nil, fmt.Errorf("genesis template %s produced an invalid genesis: %w", t.Name, err)
+	}
+	return genesisBytes, nil
+}
+
+// PromptGenesisTemplateParams gets the parameters to apply [template] with, prompting for the chain ID
+// and token symbol if not given. Allocations are prompted for if none are given and the template has none
+func PromptGenesisTemplateParams(
+	app *application.Avalanche,
+	template GenesisTemplate,
+	chainID uint64,
+	tokenSymbol string,
+	allocations core.GenesisAlloc,
+	blockchainName string,
+) (GenesisTemplateParams, string, error) {
+	var err error
+	if chainID == 0 {
+		chainID, err = app.Prompt.CaptureUint64("Chain ID")
+		if err != nil {
+			return GenesisTemplateParams{}, "", err
+		}
+	}
+	if tokenSymbol == "" {
+		tokenSymbol = template.TokenSymbol
+	}
+	tokenSymbol, err = PromptTokenSymbol(app, tokenSymbol)
+	if err != nil {
+		return GenesisTemplateParams{}, "", err
+	}
+	if len(allocations) == 0 {
+		templateAllocations, err := template.Allocations()
+		if err != nil {
+			return GenesisTemplateParams{}, "", err
+		}
+		if len(templateAllocations) == 0 {
+			allocations = core.GenesisAlloc{}
+			if err := getNativeGasTokenAllocationConfig(allocations, app, blockchainName, tokenSymbol); err != nil {
+				return GenesisTemplateParams{}, "", err
+			}
+		}
+	}
+	return GenesisTemplateParams{
+		ChainID:     chainID,
+		Allocations: allocations,
+		Timestamp:   uint64(time.Now().Unix()),
+	}, tokenSymbol, nil
+}
+
+func parseGenesisTemplate(templateBytes []byte) (GenesisTemplate, error) {
+	var template GenesisTemplate
+	if err := json.Unmarshal(templateBytes, &template); err != nil {
+		return GenesisTemplate{}, err
+	}
+	if err := ValidateGenesisTemplateName(template.Name); err != nil {
+		return GenesisTemplate{}, err
+	}
+	if !utils.ByteSliceIsSubnetEvmGenesis(template.Genesis) {
+		return GenesisTemplate{}, fmt.Errorf("template %s has no proper Subnet-EVM genesis", template.Name)
+	}
+	return template, nil
+}
+
+// decodes [genesisBytes] preserving number precision, also returning its chain config
+func decodeGenesisMap(genesisBytes []byte) (map[string]interface{}, map[string]interface{}, error) {
+	var genesisMap map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(genesisBytes))
+	decoder.UseNumber()
+	if err := decoder.Decode(&genesisMap); err != nil {
+		return nil, nil, err
+	}
+	config, ok := genesisMap["config"].(map[string]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("config field not found on genesis")
+	}
+	return genesisMap, config, nil
+}
+
+func allowListIsEmpty(allowListConfig map[string]interface{}) bool {
+	for _, role := range []string{"adminAddresses", "managerAddresses", "enabledAddresses"} {
+		if addresses, ok := allowListConfig[role].([]interface{}); ok && len(addresses) > 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/avalanche-cli/internal/testutils"
+	"github.com/ava-labs/avalanche-cli/pkg/utils"
+	"github.com/ava-labs/subnet-evm/core"
+	"github.com/ava-labs/subnet-evm/precompile/contracts/txallowlist"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func getBundledGenesisTemplate(t *testing.T, name string) GenesisTemplate {
+	templates, err := GetBundledGenesisTemplates()
+	require.NoError(t, err)
+	for _, template := range templates {
+		if template.Name == name {
+			require.True(t, template.Bundled)
+			return template
+		}
+	}
+	require.FailNow(t, "bundled template not found", name)
+	return GenesisTemplate{}
+}
+
+func TestBundledGenesisTemplates(t *testing.T) {
+	templates, err := GetBundledGenesisTemplates()
+	require.NoError(t, err)
+	names := []string{}
+	for _, template := range templates {
+		names = append(names, template.Name)
+		require.NotEmpty(t, template.Description)
+		require.NotEmpty(t, template.TokenSymbol)
+	}
+	require.ElementsMatch(t, []string{"defi", "enterprise-permissioned", "gaming"}, names)
+}
+
+func TestGenesisTemplateApply(t *testing.T) {
+	addrs, err := testutils.GenerateEthAddrs(2)
+	require.NoError(t, err)
+	allocations := core.GenesisAlloc{
+		addrs[0]: {Balance: big.NewInt(1)},
+		addrs[1]: {Balance: big.NewInt(2)},
+	}
+	template := getBundledGenesisTemplate(t, "enterprise-permissioned")
+
+	_, err = template.Apply(GenesisTemplateParams{Allocations: allocations})
+	require.ErrorContains(t, err, "chain ID")
+
+	_, err = template.Apply(GenesisTemplateParams{ChainID: 1234})
+	require.ErrorContains(t, err, "no allocations")
+
+	genesisBytes, err := template.Apply(GenesisTemplateParams{
+		ChainID:     1234,
+		Allocations: allocations,
+		Timestamp:   1000,
+	})
+	require.NoError(t, err)
+	genesis, err := utils.ByteSliceToSubnetEvmGenesis(genesisBytes)
+	require.NoError(t, err)
+	require.Zero(t, big.NewInt(1234).Cmp(genesis.Config.ChainID))
+	require.Equal(t, uint64(1000), genesis.Timestamp)
+	require.Len(t, genesis.Alloc, len(allocations))
+	for address, account := range allocations {
+		require.Zero(t, account.Balance.Cmp(genesis.Alloc[address].Balance))
+	}
+	txAllowList, ok := genesis.Config.GenesisPrecompiles[txallowlist.ConfigKey].(*txallowlist.Config)
+	require.True(t, ok)
+	require.ElementsMatch(t, addrs, txAllowList.AdminAddresses)
+	require.Equal(t, uint64(1000), *txAllowList.Timestamp())
+
+	// template allocations are used when none are given
+	template.Genesis, err = template.Apply(GenesisTemplateParams{
+		ChainID:     1234,
+		Allocations: core.GenesisAlloc{addrs[0]: {Balance: big.NewInt(1)}},
+	})
+	require.NoError(t, err)
+	genesisBytes, err = template.Apply(GenesisTemplateParams{ChainID: 5678})
+	require.NoError(t, err)
+	genesis, err = utils.ByteSliceToSubnetEvmGenesis(genesisBytes)
+	require.NoError(t, err)
+	require.Zero(t, big.NewInt(5678).Cmp(genesis.Config.ChainID))
+	require.Len(t, genesis.Alloc, 1)
+	txAllowList, ok = genesis.Config.GenesisPrecompiles[txallowlist.ConfigKey].(*txallowlist.Config)
+	require.True(t, ok)
+	require.Equal(t, []common.Address{addrs[0]}, txAllowList.AdminAddresses)
+}
+
+func TestValidateGenesisTemplateName(t *testing.T) {
+	require.NoError(t, ValidateGenesisTemplateName("my-template_1"))
+	require.Error(t, ValidateGenesisTemplateName(""))
+	require.Error(t, ValidateGenesisTemplateName("-template"))
+	require.Error(t, ValidateGenesisTemplateName("../template"))
+}
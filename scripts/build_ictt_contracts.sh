@@ -0,0 +1,28 @@
+#!/usr/bin/env bash
+# builds the ICTT contracts for the given version and copies bytecode and abi into
+# pkg/ictt/contracts/<version>, to be embedded on the CLI
+set -e
+if [ $# -ne 1 ]; then
+    echo "usage: $0 <ictt version>"
+    exit 1
+fi
+version=$1
+base_dir=$(cd $(dirname $0)/.. && pwd)
+dst_dir=$base_dir/pkg/ictt/contracts/$version
+contracts="ERC20TokenHome NativeTokenHome ERC20TokenRemote NativeTokenRemote WrappedNativeToken"
+# prepare sources
+src_dir=$(mktemp -d)
+trap "rm -rf $src_dir" EXIT
+git clone https://github.com/ava-labs/icm-contracts $src_dir --recurse-submodules --shallow-submodules
+cd $src_dir
+git checkout $version
+git submodule update --init --recursive --single-branch
+# build
+cd contracts
+forge build --extra-output-files bin
+# copy artifacts
+mkdir -p $dst_dir
+for contract in $contracts; do
+    cp out/$contract.sol/$contract.bin $dst_dir/$contract.bin
+    jq .abi out/$contract.sol/$contract.json > $dst_dir/$contract.abi.json
+done
@@ -0,0 +1,12 @@
+#!/usr/bin/env bash
+# copies the ERC20 token staking manager creation bytecode from the icm-contracts go
+# bindings into pkg/validatormanager, to be embedded on the CLI
+set -e
+version=v1.0.8
+base_dir=$(cd $(dirname $0)/.. && pwd)
+dst_file=$base_dir/pkg/validatormanager/erc20_token_staking_manager_bytecode.txt
+# download bindings
+src_dir=$(cd $(mktemp -d) && go mod download -json github.com/ava-labs/icm-contracts@$version | jq -r .Dir)
+bindings_file=$src_dir/abi-bindings/go/validator-manager/ERC20TokenStakingManager/ERC20TokenStakingManager.go
+# copy creation bytecode (first Bin entry, the second one being the ValidatorMessages library)
+sed -n 's/.*Bin: *"\(0x[^"]*\)".*/\1/p' $bindings_file | head -n 1 > $dst_file
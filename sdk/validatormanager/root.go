@@ -164,6 +164,8 @@ type PoSParams struct {
 	WeightToValueFactor     *big.Int
 	RewardCalculatorAddress string
 	UptimeBlockchainID      ids.ID
+	// ERC20 token to stake. Native token if empty
+	StakingTokenAddress string
 }
 
 func (p PoSParams) Verify() error {
@@ -182,6 +184,9 @@ func (p PoSParams) Verify() error {
 	if p.RewardCalculatorAddress == "" {
 		return fmt.Errorf("reward calculator address cannot be empty")
 	}
+	if p.StakingTokenAddress != "" && !common.IsHexAddress(p.StakingTokenAddress) {
+		return fmt.Errorf("invalid staking token address %q", p.StakingTokenAddress)
+	}
 	return nil
 }
 
@@ -14,7 +14,9 @@ import (
 )
 
 // initializes contract [managerAddress] at [rpcURL], to
-// manage validators on [subnetID] using PoS specific settings
+// manage validators on [subnetID] using PoS specific settings.
+// If [posParams] has a staking token, [managerAddress] is expected to be an ERC20 token
+// staking manager
 func PoSValidatorManagerInitialize(
 	rpcURL string,
 	managerAddress common.Address,
@@ -22,9 +24,44 @@ func PoSValidatorManagerInitialize(
 	subnetID [32]byte,
 	posParams PoSParams,
 ) (*types.Transaction, *types.Receipt, error) {
-	if err := posParams.Verify(); err != nil {
+	description, methodSpec, params, err := posInitializeMethod(subnetID, posParams)
+	if err != nil {
 		return nil, nil, err
 	}
+	return contract.TxToMethod(
+		rpcURL,
+		false,
+		common.Address{},
+		privateKey,
+		managerAddress,
+		nil,
+		description,
+		ErrorSignatureToError,
+		methodSpec,
+		params...,
+	)
+}
+
+// PoSValidatorManagerInitializeCallData returns the call data used by
+// PoSValidatorManagerInitialize, for the initialization to be made by another contract
+func PoSValidatorManagerInitializeCallData(
+	subnetID [32]byte,
+	posParams PoSParams,
+) ([]byte, error) {
+	_, methodSpec, params, err := posInitializeMethod(subnetID, posParams)
+	if err != nil {
+		return nil, err
+	}
+	return contract.PackMethodCall(methodSpec, params...)
+}
+
+func posInitializeMethod(
+	subnetID [32]byte,
+	posParams PoSParams,
+) (string, string, []interface{}, error) {
+	if err := posParams.Verify(); err != nil {
+		return "", "", nil, err
+	}
 	var (
 		defaultChurnPeriodSeconds     = uint64(0) // no churn period
 		defaultMaximumChurnPercentage = uint8(20) // 20% of the validator set can be churned per churn period
@@ -48,18 +85,16 @@ func PoSValidatorManagerInitialize(
 		UptimeBlockchainID:       posParams.UptimeBlockchainID,
 	}
 
-	return contract.TxToMethod(
-		rpcURL,
-		false,
-		common.Address{},
-		privateKey,
-		managerAddress,
-		nil,
-		"initialize Native Token PoS manager",
-		ErrorSignatureToError,
+	if posParams.StakingTokenAddress != "" {
+		return "initialize ERC20 Token PoS manager",
+			"initialize(((bytes32,uint64,uint8),uint256,uint256,uint64,uint16,uint8,uint256,address,bytes32),address)",
+			[]interface{}{params, common.HexToAddress(posParams.StakingTokenAddress)},
+			nil
+	}
+	return "initialize Native Token PoS manager",
 		"initialize(((bytes32,uint64,uint8),uint256,uint256,uint64,uint16,uint8,uint256,address,bytes32))",
-		params,
-	)
+		[]interface{}{params},
+		nil
 }
 
 func PoSWeightToValue(